@@ -21,8 +21,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -119,19 +121,23 @@ func (a adminAPIHandlers) SRPeerBucketOps(w http.ResponseWriter, r *http.Request
 			createdAt = timeSentinel
 		}
 
+		pinnedPool, _ := strconv.Atoi(r.Form.Get("pinnedPool"))
+
 		opts := MakeBucketOptions{
 			LockEnabled:       r.Form.Get("lockEnabled") == "true",
 			VersioningEnabled: r.Form.Get("versioningEnabled") == "true",
 			ForceCreate:       r.Form.Get("forceCreate") == "true",
 			CreatedAt:         createdAt,
+			PinnedPool:        pinnedPool,
 		}
 		err = globalSiteReplicationSys.PeerBucketMakeWithVersioningHandler(ctx, bucket, opts)
 	case madmin.ConfigureReplBktOp:
 		err = globalSiteReplicationSys.PeerBucketConfigureReplHandler(ctx, bucket)
 	case madmin.DeleteBucketBktOp, madmin.ForceDeleteBucketBktOp:
 		err = globalSiteReplicationSys.PeerBucketDeleteHandler(ctx, bucket, DeleteBucketOptions{
-			Force:      operation == madmin.ForceDeleteBucketBktOp,
-			SRDeleteOp: getSRBucketDeleteOp(true),
+			Force:         operation == madmin.ForceDeleteBucketBktOp,
+			CascadeConfig: r.Form.Get("cascadeConfig") == "true",
+			SRDeleteOp:    getSRBucketDeleteOp(true),
 		})
 	case madmin.PurgeDeletedBucketOp:
 		globalSiteReplicationSys.purgeDeletedBucket(ctx, objectAPI, bucket)
@@ -214,6 +220,19 @@ func (a adminAPIHandlers) SRPeerReplicateBucketItem(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// Reject updates that are not the immediate successor of the local
+	// config epoch, last-write-wins on UpdatedAt is subject to clock skew
+	// when two sites update the same bucket concurrently. The sender's
+	// failure is reconciled by the healing routine instead.
+	var epoch uint64
+	item.Type, epoch = srBucketMetaTypeSplitEpoch(item.Type)
+	if cur := globalBucketMetadataSys.ConfigEpoch(item.Bucket); !srBucketMetaEpochOK(epoch, cur) {
+		globalSiteReplicationSys.kickHealRoutine()
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errSRInvalidRequest(
+			fmt.Errorf("bucket meta config epoch mismatch on %s: got %d, expected %d", item.Bucket, epoch, cur+1))), r.URL)
+		return
+	}
+
 	var err error
 	switch item.Type {
 	default:
@@ -251,6 +270,20 @@ func (a adminAPIHandlers) SRPeerReplicateBucketItem(w http.ResponseWriter, r *ht
 		err = globalSiteReplicationSys.PeerBucketVersioningHandler(ctx, item.Bucket, item.Versioning, item.UpdatedAt)
 	case madmin.SRBucketMetaTypeTags:
 		err = globalSiteReplicationSys.PeerBucketTaggingHandler(ctx, item.Bucket, item.Tags, item.UpdatedAt)
+	case srBucketMetaTypeDefaultTags:
+		err = globalSiteReplicationSys.PeerBucketDefaultTagsHandler(ctx, item.Bucket, item.Tags, item.UpdatedAt)
+	case srBucketMetaTypeNoncurrentVersions:
+		err = globalSiteReplicationSys.PeerBucketNoncurrentVersionsHandler(ctx, item.Bucket, item.Tags, item.UpdatedAt)
+	case srBucketMetaTypeMaxObjectSize:
+		err = globalSiteReplicationSys.PeerBucketMaxObjectSizeHandler(ctx, item.Bucket, item.Tags, item.UpdatedAt)
+	case srBucketMetaTypeMaxVersions:
+		err = globalSiteReplicationSys.PeerBucketMaxVersionsHandler(ctx, item.Bucket, item.Tags, item.UpdatedAt)
+	case srBucketMetaTypeBulkDelete:
+		err = globalSiteReplicationSys.PeerBucketBulkDeleteHandler(ctx, item.Bucket, item.Tags, item.UpdatedAt)
+	case srBucketMetaTypeFanOutRouting:
+		err = globalSiteReplicationSys.PeerBucketFanOutRoutingHandler(ctx, item.Bucket, item.Tags, item.UpdatedAt)
+	case srBucketMetaTypeBucketEvent:
+		err = globalSiteReplicationSys.PeerBucketEventHandler(ctx, item.Tags)
 	case madmin.SRBucketMetaTypeObjectLockConfig:
 		err = globalSiteReplicationSys.PeerBucketObjectLockConfigHandler(ctx, item.Bucket, item.ObjectLockConfig, item.UpdatedAt)
 	case madmin.SRBucketMetaTypeSSEConfig:
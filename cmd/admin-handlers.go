@@ -48,6 +48,7 @@ import (
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/madmin-go/v2/estream"
 	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/minio/internal/config/dns"
 	"github.com/minio/minio/internal/dsync"
 	"github.com/minio/minio/internal/handlers"
 	xhttp "github.com/minio/minio/internal/http"
@@ -372,6 +373,88 @@ func (a adminAPIHandlers) StorageInfoHandler(w http.ResponseWriter, r *http.Requ
 	writeSuccessResponseJSON(w, jsonBytes)
 }
 
+// federationDNSEntry is a single bucket entry in a federationDNSReport.
+type federationDNSEntry struct {
+	Bucket   string   `json:"bucket"`
+	DNSHosts []string `json:"dnsHosts,omitempty"`
+}
+
+// federationDNSReport is a consistency report of local buckets against
+// the federated DNS store.
+type federationDNSReport struct {
+	DomainIPs    []string             `json:"domainIPs"`
+	MissingInDNS []federationDNSEntry `json:"missingInDNS"`
+	StaleInDNS   []federationDNSEntry `json:"staleInDNS"`
+	Conflicts    []federationDNSEntry `json:"conflicts"`
+	Healthy      []federationDNSEntry `json:"healthy"`
+}
+
+// FederationDNSReportHandler - GET /minio/admin/v3/federation/dns-report
+// ----------
+// Returns a consistency report of local buckets against the federated
+// DNS store - the same comparison initFederatorBackend reconciles -
+// without mutating anything, so operators can audit before letting the
+// reconciler fix things.
+func (a adminAPIHandlers) FederationDNSReportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "FederationDNSReport")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Report is only meaningful for federated deployments.
+	if globalDNSConfig == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	buckets, err := objectAPI.ListBuckets(ctx, BucketOptions{})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	dnsBuckets, err := globalDNSConfig.List()
+	if err != nil && !IsErrIgnored(err, dns.ErrNoEntriesFound, dns.ErrNotImplemented, dns.ErrDomainMissing) {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	comparison := compareFederationDNS(buckets, dnsBuckets, globalDomainIPs, err == dns.ErrDomainMissing)
+
+	toEntries := func(s set.StringSet) []federationDNSEntry {
+		entries := make([]federationDNSEntry, 0, len(s))
+		for _, bucket := range s.ToSlice() {
+			var hosts []string
+			if records, ok := dnsBuckets[bucket]; ok {
+				hosts = getHostsSlice(records)
+			}
+			entries = append(entries, federationDNSEntry{Bucket: bucket, DNSHosts: hosts})
+		}
+		return entries
+	}
+
+	healthy := comparison.bucketsSet.Difference(comparison.bucketsToBeUpdated).Difference(comparison.bucketsInConflict)
+	report := federationDNSReport{
+		DomainIPs:    globalDomainIPs.ToSlice(),
+		MissingInDNS: toEntries(comparison.bucketsToBeUpdated),
+		StaleInDNS:   toEntries(comparison.staleDNSBuckets),
+		Conflicts:    toEntries(comparison.bucketsInConflict),
+		Healthy:      toEntries(healthy),
+	}
+
+	jsonBytes, err := json.Marshal(report)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, jsonBytes)
+}
+
 // MetricsHandler - GET /minio/admin/v3/metrics
 // ----------
 // Get realtime server metrics
@@ -2443,11 +2526,18 @@ func fetchLambdaInfo() []map[string][]madmin.TargetIDStatus {
 		targetIDStatus := make(map[string]madmin.Status)
 		active, _ := tgt.IsActive()
 		targetID := tgt.ID()
+		status := string(madmin.ItemOffline)
 		if active {
-			targetIDStatus[targetID.ID] = madmin.Status{Status: string(madmin.ItemOnline)}
-		} else {
-			targetIDStatus[targetID.ID] = madmin.Status{Status: string(madmin.ItemOffline)}
+			status = string(madmin.ItemOnline)
 		}
+		if globalEventNotifier != nil {
+			if check, ok := globalEventNotifier.targetList.LastCheck(targetID); ok {
+				// Carry the time of the most recent connectivity check
+				// performed while storing a notification configuration.
+				status = fmt.Sprintf("%s (last validated %s)", status, check.LastCheck.UTC().Format(time.RFC3339))
+			}
+		}
+		targetIDStatus[targetID.ID] = madmin.Status{Status: status}
 		list := lambdaMap[targetID.Name]
 		list = append(list, targetIDStatus)
 		lambdaMap[targetID.Name] = list
@@ -2793,6 +2883,13 @@ func (a adminAPIHandlers) InspectDataHandler(w http.ResponseWriter, r *http.Requ
 			return nil
 		}
 		header.Method = zip.Deflate
+		if si.NumVersions > 0 {
+			// Surface the version summary the server derived from the
+			// metadata headers, avoids reading each version back out of
+			// the archive to learn it.
+			header.Comment = fmt.Sprintf("versions=%d,latest=%s,latest-modtime=%s",
+				si.NumVersions, si.LatestVersionID, si.LatestModTime.UTC().Format(time.RFC3339Nano))
+		}
 		zwriter, zerr := inspectZipW.CreateHeader(header)
 		if zerr != nil {
 			logger.LogIf(ctx, zerr)
@@ -68,6 +68,8 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/datausageinfo").HandlerFunc(gz(httpTraceAll(adminAPI.DataUsageInfoHandler)))
 		// Metrics operation
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/metrics").HandlerFunc(gz(httpTraceAll(adminAPI.MetricsHandler)))
+		// Federation DNS consistency report
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/federation/dns-report").HandlerFunc(gz(httpTraceAll(adminAPI.FederationDNSReportHandler)))
 
 		if globalIsDistErasure || globalIsErasure {
 			// Heal operations
@@ -240,6 +242,11 @@ func registerAdminRouter(router *mux.Router, enableConfigOps bool) {
 		adminRouter.Methods(http.MethodDelete).Path(adminVersion + "/cancel-job").HandlerFunc(
 			gz(httpTraceHdrs(adminAPI.CancelBatchJob)))
 
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/list-job-schedules").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.ListBatchJobSchedules)))
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/pause-job-schedule").HandlerFunc(
+			gz(httpTraceHdrs(adminAPI.PauseBatchJobSchedule)))
+
 		// Bucket migration operations
 		// ExportBucketMetaHandler
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/export-bucket-metadata").HandlerFunc(
@@ -20,18 +20,58 @@ package cmd
 import (
 	"encoding/xml"
 	"time"
+
+	"github.com/minio/minio/internal/crypto"
 )
 
 // DeletedObject objects deleted
 type DeletedObject struct {
-	DeleteMarker          bool   `xml:"DeleteMarker,omitempty"`
-	DeleteMarkerVersionID string `xml:"DeleteMarkerVersionId,omitempty"`
-	ObjectName            string `xml:"Key,omitempty"`
-	VersionID             string `xml:"VersionId,omitempty"`
+	DeleteMarker          bool   `xml:"DeleteMarker,omitempty" json:"DeleteMarker,omitempty"`
+	DeleteMarkerVersionID string `xml:"DeleteMarkerVersionId,omitempty" json:"DeleteMarkerVersionId,omitempty"`
+	ObjectName            string `xml:"Key,omitempty" json:"Key,omitempty"`
+	VersionID             string `xml:"VersionId,omitempty" json:"VersionId,omitempty"`
 	// MTime of DeleteMarker on source that needs to be propagated to replica
-	DeleteMarkerMTime DeleteMarkerMTime `xml:"-"`
+	DeleteMarkerMTime DeleteMarkerMTime `xml:"-" json:"-"`
 	// MinIO extensions to support delete marker replication
-	ReplicationState ReplicationState `xml:"-"`
+	ReplicationState ReplicationState `xml:"-" json:"-"`
+	// MinIO extension attributes recording the object's state at the
+	// moment of deletion, filled when requested via x-minio-delete-audit
+	ETag         string `xml:"ETag,omitempty" json:"ETag,omitempty"`
+	Size         int64  `xml:"Size,omitempty" json:"Size,omitempty"`
+	SSEAlgorithm string `xml:"SSEAlgorithm,omitempty" json:"SSEAlgorithm,omitempty"`
+	// MinIO extension attribute reporting how many versions of the
+	// object remain after a version specific delete, filled when
+	// requested via x-minio-delete-remaining-versions
+	RemainingVersions *int64 `xml:"RemainingVersions,omitempty" json:"RemainingVersions,omitempty"`
+}
+
+// setDeleteAudit records the object's state at the moment of deletion on
+// the Deleted element. When the stat failed the attributes are simply
+// omitted, the delete itself has still happened.
+func (d *DeletedObject) setDeleteAudit(goi ObjectInfo, gerr error) {
+	if gerr != nil {
+		return
+	}
+	d.ETag = goi.ETag
+	d.Size = goi.Size
+	if kind, ok := crypto.IsEncrypted(goi.UserDefined); ok {
+		d.SSEAlgorithm = kind.String()
+	}
+}
+
+// setRemainingVersions reports how many versions of the object survive a
+// version specific delete, computed from the stat taken before the delete
+// went through. Stat failures and versionless deletes leave the field
+// unset.
+func (d *DeletedObject) setRemainingVersions(goi ObjectInfo, gerr error) {
+	if gerr != nil || d.VersionID == "" {
+		return
+	}
+	remaining := int64(goi.NumVersions) - 1
+	if remaining < 0 {
+		remaining = 0
+	}
+	d.RemainingVersions = &remaining
 }
 
 // DeleteMarkerMTime is an embedded type containing time.Time for XML marshal
@@ -50,21 +90,21 @@ func (t DeleteMarkerMTime) MarshalXML(e *xml.Encoder, startElement xml.StartElem
 
 // ObjectV object version key/versionId
 type ObjectV struct {
-	ObjectName string `xml:"Key"`
-	VersionID  string `xml:"VersionId"`
+	ObjectName string `xml:"Key" json:"Key"`
+	VersionID  string `xml:"VersionId" json:"VersionId,omitempty"`
 }
 
 // ObjectToDelete carries key name for the object to delete.
 type ObjectToDelete struct {
 	ObjectV
 	// Replication status of DeleteMarker
-	DeleteMarkerReplicationStatus string `xml:"DeleteMarkerReplicationStatus"`
+	DeleteMarkerReplicationStatus string `xml:"DeleteMarkerReplicationStatus" json:"-"`
 	// Status of versioned delete (of object or DeleteMarker)
-	VersionPurgeStatus VersionPurgeStatusType `xml:"VersionPurgeStatus"`
+	VersionPurgeStatus VersionPurgeStatusType `xml:"VersionPurgeStatus" json:"-"`
 	// VersionPurgeStatuses holds the internal
-	VersionPurgeStatuses string `xml:"VersionPurgeStatuses"`
+	VersionPurgeStatuses string `xml:"VersionPurgeStatuses" json:"-"`
 	// ReplicateDecisionStr stringified representation of replication decision
-	ReplicateDecisionStr string `xml:"-"`
+	ReplicateDecisionStr string `xml:"-" json:"-"`
 }
 
 // createBucketConfiguration container for bucket configuration request from client.
@@ -77,7 +117,16 @@ type createBucketLocationConfiguration struct {
 // DeleteObjectsRequest - xml carrying the object key names which needs to be deleted.
 type DeleteObjectsRequest struct {
 	// Element to enable quiet mode for the request
-	Quiet bool
+	Quiet bool `json:"Quiet,omitempty"`
 	// List of objects to be deleted
-	Objects []ObjectToDelete `xml:"Object"`
+	Objects []ObjectToDelete `xml:"Object" json:"Objects"`
+
+	// MinIO extension: when set, the Objects list must be empty and the
+	// server enumerates delete markers under this prefix and removes
+	// them, like a client issuing versioned deletes for each marker.
+	DeleteMarkersOnly string `xml:"DeleteMarkersOnly,omitempty" json:"DeleteMarkersOnly,omitempty"`
+	// Continuation markers for a DeleteMarkersOnly request, copied from
+	// the previous response's truncation headers.
+	KeyMarker       string `xml:"KeyMarker,omitempty" json:"KeyMarker,omitempty"`
+	VersionIDMarker string `xml:"VersionIdMarker,omitempty" json:"VersionIdMarker,omitempty"`
 }
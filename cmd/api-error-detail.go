@@ -0,0 +1,127 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+// maxErrorDetailSize caps the size of the x-minio-error-detail response
+// header, details that would exceed it are dropped.
+const maxErrorDetailSize = 512
+
+// APIErrorDetail is a compact machine-readable description of a failure,
+// returned in the x-minio-error-detail header when the client opts in
+// with 'x-minio-verbose-errors: true'.
+type APIErrorDetail struct {
+	Code      string `json:"code"`
+	Subsystem string `json:"subsystem,omitempty"`
+	Retriable bool   `json:"retriable"`
+	Resource  string `json:"resource,omitempty"`
+}
+
+type errorDetailCtxKey struct{}
+
+// errorDetailHolder collects the optional structured detail for a request
+// that opted into verbose errors.
+type errorDetailHolder struct {
+	mu     sync.Mutex
+	detail *APIErrorDetail
+}
+
+// contextWithErrorDetail enables structured error details on the request
+// context when the client asked for them.
+func contextWithErrorDetail(ctx context.Context, r *http.Request) context.Context {
+	if v := r.Header.Get(xhttp.MinIOVerboseErrors); v != "" {
+		if ok, _ := strconv.ParseBool(v); ok {
+			return context.WithValue(ctx, errorDetailCtxKey{}, &errorDetailHolder{})
+		}
+	}
+	return ctx
+}
+
+// setErrorDetail records a structured detail for the next error response
+// written on this request, a no-op unless the client opted in.
+func setErrorDetail(ctx context.Context, detail APIErrorDetail) {
+	h, ok := ctx.Value(errorDetailCtxKey{}).(*errorDetailHolder)
+	if !ok {
+		return
+	}
+	h.mu.Lock()
+	h.detail = &detail
+	h.mu.Unlock()
+}
+
+// sanitizeErrorDetailValue strips characters that are not printable ASCII
+// so the detail is always safe to carry in a response header.
+func sanitizeErrorDetailValue(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// retriableAPIError reports whether retrying the request may succeed.
+func retriableAPIError(err APIError) bool {
+	switch err.Code {
+	case "SlowDown", "XMinioServerNotInitialized", "XMinioReadQuorum", "XMinioWriteQuorum", "OperationTimedOut":
+		return true
+	}
+	return err.HTTPStatusCode == http.StatusServiceUnavailable
+}
+
+// writeErrorDetail sets the x-minio-error-detail response header when the
+// request opted in with x-minio-verbose-errors. The detail is sanitized
+// and capped at maxErrorDetailSize.
+func writeErrorDetail(ctx context.Context, w http.ResponseWriter, err APIError) {
+	h, ok := ctx.Value(errorDetailCtxKey{}).(*errorDetailHolder)
+	if !ok {
+		return
+	}
+
+	h.mu.Lock()
+	var detail APIErrorDetail
+	if h.detail != nil {
+		detail = *h.detail
+	} else {
+		detail = APIErrorDetail{Retriable: retriableAPIError(err)}
+	}
+	h.mu.Unlock()
+
+	if detail.Code == "" {
+		detail.Code = err.Code
+	}
+	detail.Code = sanitizeErrorDetailValue(detail.Code)
+	detail.Subsystem = sanitizeErrorDetailValue(detail.Subsystem)
+	detail.Resource = sanitizeErrorDetailValue(detail.Resource)
+
+	buf, jerr := json.Marshal(detail)
+	if jerr != nil || len(buf) > maxErrorDetailSize {
+		return
+	}
+	w.Header().Set(xhttp.MinIOErrorDetail, string(buf))
+}
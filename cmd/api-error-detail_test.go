@@ -0,0 +1,81 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+// Tests that the x-minio-error-detail header is written only when the
+// client opted in with x-minio-verbose-errors and that handler supplied
+// details override the defaults derived from the APIError.
+func TestWriteErrorDetail(t *testing.T) {
+	apiErr := errorCodes.ToAPIErr(ErrSlowDown)
+
+	// Without the opt-in header the context carries no holder and no
+	// detail header may be written.
+	req := httptest.NewRequest(http.MethodGet, "/testbucket", nil)
+	ctx := contextWithErrorDetail(context.Background(), req)
+	rec := httptest.NewRecorder()
+	writeErrorDetail(ctx, rec, apiErr)
+	if got := rec.Header().Get(xhttp.MinIOErrorDetail); got != "" {
+		t.Fatalf("expected no error detail header, got %q", got)
+	}
+
+	// With the opt-in header the default detail carries the error code
+	// and its retriability.
+	req.Header.Set(xhttp.MinIOVerboseErrors, "true")
+	ctx = contextWithErrorDetail(context.Background(), req)
+	rec = httptest.NewRecorder()
+	writeErrorDetail(ctx, rec, apiErr)
+	got := rec.Header().Get(xhttp.MinIOErrorDetail)
+	if !strings.Contains(got, `"code":"SlowDown"`) || !strings.Contains(got, `"retriable":true`) {
+		t.Fatalf("unexpected default error detail %q", got)
+	}
+
+	// Handler supplied details take precedence and are sanitized to
+	// printable ASCII.
+	setErrorDetail(ctx, APIErrorDetail{
+		Subsystem: "dns",
+		Resource:  "testbucket\r\nInjected: yes",
+		Retriable: true,
+	})
+	rec = httptest.NewRecorder()
+	writeErrorDetail(ctx, rec, apiErr)
+	got = rec.Header().Get(xhttp.MinIOErrorDetail)
+	if !strings.Contains(got, `"subsystem":"dns"`) {
+		t.Fatalf("expected dns subsystem in error detail %q", got)
+	}
+	if !strings.Contains(got, `"resource":"testbucketInjected: yes"`) {
+		t.Fatalf("expected sanitized resource in error detail %q", got)
+	}
+
+	// Oversized details are dropped rather than truncated.
+	setErrorDetail(ctx, APIErrorDetail{Resource: strings.Repeat("a", maxErrorDetailSize)})
+	rec = httptest.NewRecorder()
+	writeErrorDetail(ctx, rec, apiErr)
+	if got := rec.Header().Get(xhttp.MinIOErrorDetail); got != "" {
+		t.Fatalf("expected oversized error detail to be dropped, got %q", got)
+	}
+}
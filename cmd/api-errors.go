@@ -180,6 +180,7 @@ const (
 	ErrBucketAlreadyOwnedByYou
 	ErrInvalidDuration
 	ErrBucketAlreadyExists
+	ErrBucketCaseConflict
 	ErrMetadataTooLarge
 	ErrUnsupportedMetadata
 	ErrMaximumExpires
@@ -196,6 +197,14 @@ const (
 	ErrPastObjectLockRetainDate
 	ErrUnknownWORMModeDirective
 	ErrBucketTaggingNotFound
+	ErrBucketScanConfigNotFound
+	ErrObjectScanFailed
+	ErrBucketMaxObjectSizeConfigNotFound
+	ErrBucketMaxVersionsConfigNotFound
+	ErrBucketBulkDeleteConfigNotFound
+	ErrBucketBulkDeleteDisabled
+	ErrBucketFanOutRoutingConfigNotFound
+	ErrBucketMetadataBundleInvalid
 	ErrObjectLockInvalidHeaders
 	ErrInvalidTagDirective
 	ErrPolicyAlreadyAttached
@@ -213,6 +222,7 @@ const (
 	ErrSSEEncryptedObject
 	ErrInvalidEncryptionParameters
 	ErrInvalidEncryptionParametersSSEC
+	ErrSSEEnforcementFailed
 
 	ErrInvalidSSECustomerAlgorithm
 	ErrInvalidSSECustomerKey
@@ -866,6 +876,11 @@ var errorCodes = errorCodeMap{
 		Description:    "Your previous request to create the named bucket succeeded and you already own it.",
 		HTTPStatusCode: http.StatusConflict,
 	},
+	ErrBucketCaseConflict: {
+		Code:           "BucketNameCaseConflict",
+		Description:    "A bucket whose name differs only by letter case is already registered in the federated DNS store. DNS names are case-insensitive, choose a distinct name.",
+		HTTPStatusCode: http.StatusConflict,
+	},
 	ErrInvalidDuration: {
 		Code:           "InvalidDuration",
 		Description:    "Duration provided in the request is invalid.",
@@ -881,6 +896,46 @@ var errorCodes = errorCodeMap{
 		Description:    "The TagSet does not exist",
 		HTTPStatusCode: http.StatusNotFound,
 	},
+	ErrBucketScanConfigNotFound: {
+		Code:           "XMinioBucketScanConfigNotFound",
+		Description:    "No scan configuration exists for this bucket",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrObjectScanFailed: {
+		Code:           "XMinioObjectScanFailed",
+		Description:    "The object could not be scanned and the bucket scan policy is fail-closed",
+		HTTPStatusCode: http.StatusServiceUnavailable,
+	},
+	ErrBucketMaxObjectSizeConfigNotFound: {
+		Code:           "XMinioBucketMaxObjectSizeConfigNotFound",
+		Description:    "No maximum object size configuration exists for this bucket",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrBucketMaxVersionsConfigNotFound: {
+		Code:           "XMinioBucketMaxVersionsConfigNotFound",
+		Description:    "No maximum versions configuration exists for this bucket",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrBucketBulkDeleteConfigNotFound: {
+		Code:           "XMinioBucketBulkDeleteConfigNotFound",
+		Description:    "No bulk delete configuration exists for this bucket",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrBucketBulkDeleteDisabled: {
+		Code:           "XMinioBulkDeleteDisabled",
+		Description:    "Bulk delete requests are disabled on this bucket",
+		HTTPStatusCode: http.StatusForbidden,
+	},
+	ErrBucketFanOutRoutingConfigNotFound: {
+		Code:           "XMinioBucketFanOutRoutingConfigNotFound",
+		Description:    "No fan-out routing configuration exists for this bucket",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrBucketMetadataBundleInvalid: {
+		Code:           "XMinioBucketMetadataBundleInvalid",
+		Description:    "The bucket metadata bundle is malformed, unsupported or its signature does not match this cluster's credentials",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
 	ErrObjectLockConfigurationNotFound: {
 		Code:           "ObjectLockConfigurationNotFoundError",
 		Description:    "Object Lock configuration does not exist for this bucket",
@@ -1143,6 +1198,11 @@ var errorCodes = errorCodeMap{
 		Description:    "SSE-C encryption parameters are not supported on replicated bucket.",
 		HTTPStatusCode: http.StatusBadRequest,
 	},
+	ErrSSEEnforcementFailed: {
+		Code:           "XMinioSSEEnforcementFailed",
+		Description:    "The encryption parameters of the request do not match the enforced bucket encryption configuration.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
 	ErrInvalidSSECustomerAlgorithm: {
 		Code:           "InvalidArgument",
 		Description:    "Requests specifying Server Side Encryption with Customer provided keys must provide a valid encryption algorithm.",
@@ -2224,6 +2284,20 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrNoSuchBucketSSEConfig
 	case BucketTaggingNotFound:
 		apiErr = ErrBucketTaggingNotFound
+	case BucketDefaultTagsNotFound:
+		apiErr = ErrBucketTaggingNotFound
+	case BucketNoncurrentVersionsConfigNotFound:
+		apiErr = ErrNoSuchLifecycleConfiguration
+	case BucketScanConfigNotFound:
+		apiErr = ErrBucketScanConfigNotFound
+	case BucketMaxObjectSizeConfigNotFound:
+		apiErr = ErrBucketMaxObjectSizeConfigNotFound
+	case BucketMaxVersionsConfigNotFound:
+		apiErr = ErrBucketMaxVersionsConfigNotFound
+	case BucketBulkDeleteConfigNotFound:
+		apiErr = ErrBucketBulkDeleteConfigNotFound
+	case BucketFanOutRoutingConfigNotFound:
+		apiErr = ErrBucketFanOutRoutingConfigNotFound
 	case BucketObjectLockConfigNotFound:
 		apiErr = ErrObjectLockConfigurationNotFound
 	case BucketQuotaConfigNotFound:
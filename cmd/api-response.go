@@ -166,10 +166,11 @@ type Part struct {
 	Size         int64
 
 	// Checksum values
-	ChecksumCRC32  string `xml:"ChecksumCRC32,omitempty"`
-	ChecksumCRC32C string `xml:"ChecksumCRC32C,omitempty"`
-	ChecksumSHA1   string `xml:"ChecksumSHA1,omitempty"`
-	ChecksumSHA256 string `xml:"ChecksumSHA256,omitempty"`
+	ChecksumCRC32     string `xml:"ChecksumCRC32,omitempty"`
+	ChecksumCRC32C    string `xml:"ChecksumCRC32C,omitempty"`
+	ChecksumCRC64NVME string `xml:"ChecksumCRC64NVME,omitempty"`
+	ChecksumSHA1      string `xml:"ChecksumSHA1,omitempty"`
+	ChecksumSHA256    string `xml:"ChecksumSHA256,omitempty"`
 }
 
 // ListPartsResponse - format for list parts response.
@@ -228,6 +229,11 @@ type ListBucketsResponse struct {
 	Buckets struct {
 		Buckets []Bucket `xml:"Bucket"`
 	} // Buckets are nested
+
+	// UsageUpdatedAt is a MinIO extension carrying the timestamp of the
+	// data usage snapshot the per-bucket usage elements were read from,
+	// only set when the listing includes usage.
+	UsageUpdatedAt string `xml:"UsageUpdatedAt,omitempty"`
 }
 
 // Upload container for in progress multipart upload
@@ -249,6 +255,14 @@ type CommonPrefix struct {
 type Bucket struct {
 	Name         string
 	CreationDate string // time string of format "2006-01-02T15:04:05.000Z"
+
+	// MinIO extensions, only set when the listing was asked to include
+	// usage via the x-minio-usage header. The values come from the
+	// latest data usage snapshot, -1 when the bucket has not been
+	// scanned yet.
+	Size          *int64 `xml:"Size,omitempty"`
+	ObjectsCount  *int64 `xml:"ObjectsCount,omitempty"`
+	VersionsCount *int64 `xml:"VersionsCount,omitempty"`
 }
 
 // ObjectVersion container for object version metadata
@@ -413,10 +427,11 @@ type CompleteMultipartUploadResponse struct {
 	Key      string
 	ETag     string
 
-	ChecksumCRC32  string `xml:"ChecksumCRC32,omitempty"`
-	ChecksumCRC32C string `xml:"ChecksumCRC32C,omitempty"`
-	ChecksumSHA1   string `xml:"ChecksumSHA1,omitempty"`
-	ChecksumSHA256 string `xml:"ChecksumSHA256,omitempty"`
+	ChecksumCRC32     string `xml:"ChecksumCRC32,omitempty"`
+	ChecksumCRC32C    string `xml:"ChecksumCRC32C,omitempty"`
+	ChecksumCRC64NVME string `xml:"ChecksumCRC64NVME,omitempty"`
+	ChecksumSHA1      string `xml:"ChecksumSHA1,omitempty"`
+	ChecksumSHA256    string `xml:"ChecksumSHA256,omitempty"`
 }
 
 // DeleteError structure.
@@ -424,18 +439,28 @@ type DeleteError struct {
 	Code      string
 	Message   string
 	Key       string
-	VersionID string `xml:"VersionId"`
+	VersionID string `xml:"VersionId" json:"VersionId,omitempty"`
+
+	// MinIO extensions carrying the object lock retention details when the
+	// delete was denied due to retention, so clients can tell when the
+	// object becomes deletable.
+	RetentionMode   string `xml:"MinIORetentionMode,omitempty" json:"MinIORetentionMode,omitempty"`
+	RetainUntilDate string `xml:"MinIORetainUntilDate,omitempty" json:"MinIORetainUntilDate,omitempty"`
 }
 
 // DeleteObjectsResponse container for multiple object deletes.
 type DeleteObjectsResponse struct {
 	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ DeleteResult" json:"-"`
 
+	// Set when the response reflects a dry run simulation, the listed
+	// outcomes are projections and nothing was deleted.
+	DryRun bool `xml:"DryRun,attr,omitempty" json:"DryRun,omitempty"`
+
 	// Collection of all deleted objects
-	DeletedObjects []DeletedObject `xml:"Deleted,omitempty"`
+	DeletedObjects []DeletedObject `xml:"Deleted,omitempty" json:"Deleted,omitempty"`
 
 	// Collection of errors deleting certain objects.
-	Errors []DeleteError `xml:"Error,omitempty"`
+	Errors []DeleteError `xml:"Error,omitempty" json:"Error,omitempty"`
 }
 
 // PostResponse container for POST object request when success_action_status is set to 201
@@ -444,6 +469,11 @@ type PostResponse struct {
 	Key      string
 	ETag     string
 	Location string
+
+	// MinIO extensions, set only when object-lock retention was
+	// applied to the uploaded object.
+	ObjectLockMode            string `xml:"ObjectLockMode,omitempty"`
+	ObjectLockRetainUntilDate string `xml:"ObjectLockRetainUntilDate,omitempty"`
 }
 
 // returns "https" if the tls boolean is true, "http" otherwise.
@@ -480,8 +510,9 @@ func getObjectLocation(r *http.Request, domains []string, bucket, object string)
 }
 
 // generates ListBucketsResponse from array of BucketInfo which can be
-// serialized to match XML and JSON API spec output.
-func generateListBucketsResponse(buckets []BucketInfo) ListBucketsResponse {
+// serialized to match XML and JSON API spec output. A non-nil dui
+// enriches every entry with its usage from that snapshot.
+func generateListBucketsResponse(buckets []BucketInfo, dui *DataUsageInfo) ListBucketsResponse {
 	listbuckets := make([]Bucket, 0, len(buckets))
 	data := ListBucketsResponse{}
 	owner := Owner{
@@ -490,14 +521,27 @@ func generateListBucketsResponse(buckets []BucketInfo) ListBucketsResponse {
 	}
 
 	for _, bucket := range buckets {
-		listbuckets = append(listbuckets, Bucket{
+		b := Bucket{
 			Name:         bucket.Name,
 			CreationDate: amztime.ISO8601Format(bucket.Created.UTC()),
-		})
+		}
+		if dui != nil {
+			size, objects, versions := int64(-1), int64(-1), int64(-1)
+			if usage, ok := dui.BucketsUsage[bucket.Name]; ok {
+				size = int64(usage.Size)
+				objects = int64(usage.ObjectsCount)
+				versions = int64(usage.VersionsCount)
+			}
+			b.Size, b.ObjectsCount, b.VersionsCount = &size, &objects, &versions
+		}
+		listbuckets = append(listbuckets, b)
 	}
 
 	data.Owner = owner
 	data.Buckets.Buckets = listbuckets
+	if dui != nil && !dui.LastUpdate.IsZero() {
+		data.UsageUpdatedAt = amztime.ISO8601Format(dui.LastUpdate.UTC())
+	}
 
 	return data
 }
@@ -766,11 +810,12 @@ func generateCompleteMultpartUploadResponse(bucket, key, location string, oi Obj
 		Bucket:   bucket,
 		Key:      key,
 		// AWS S3 quotes the ETag in XML, make sure we are compatible here.
-		ETag:           "\"" + oi.ETag + "\"",
-		ChecksumSHA1:   cs[hash.ChecksumSHA1.String()],
-		ChecksumSHA256: cs[hash.ChecksumSHA256.String()],
-		ChecksumCRC32:  cs[hash.ChecksumCRC32.String()],
-		ChecksumCRC32C: cs[hash.ChecksumCRC32C.String()],
+		ETag:              "\"" + oi.ETag + "\"",
+		ChecksumSHA1:      cs[hash.ChecksumSHA1.String()],
+		ChecksumSHA256:    cs[hash.ChecksumSHA256.String()],
+		ChecksumCRC32:     cs[hash.ChecksumCRC32.String()],
+		ChecksumCRC32C:    cs[hash.ChecksumCRC32C.String()],
+		ChecksumCRC64NVME: cs[hash.ChecksumCRC64NVME.String()],
 	}
 	return c
 }
@@ -808,6 +853,7 @@ func generateListPartsResponse(partsInfo ListPartsInfo, encodingType string) Lis
 		newPart.LastModified = amztime.ISO8601Format(part.LastModified.UTC())
 		newPart.ChecksumCRC32 = part.ChecksumCRC32
 		newPart.ChecksumCRC32C = part.ChecksumCRC32C
+		newPart.ChecksumCRC64NVME = part.ChecksumCRC64NVME
 		newPart.ChecksumSHA1 = part.ChecksumSHA1
 		newPart.ChecksumSHA256 = part.ChecksumSHA256
 		listPartsResponse.Parts[index] = newPart
@@ -846,8 +892,8 @@ func generateListMultipartUploadsResponse(bucket string, multipartsInfo ListMult
 }
 
 // generate multi objects delete response.
-func generateMultiDeleteResponse(quiet bool, deletedObjects []DeletedObject, errs []DeleteError) DeleteObjectsResponse {
-	deleteResp := DeleteObjectsResponse{}
+func generateMultiDeleteResponse(quiet, dryRun bool, deletedObjects []DeletedObject, errs []DeleteError) DeleteObjectsResponse {
+	deleteResp := DeleteObjectsResponse{DryRun: dryRun}
 	if !quiet {
 		deleteResp.DeletedObjects = deletedObjects
 	}
@@ -914,13 +960,38 @@ func writeSuccessResponseHeadersOnly(w http.ResponseWriter) {
 	writeResponse(w, http.StatusOK, nil, mimeNone)
 }
 
+// throttleBackoffHint computes how long a throttled client should wait
+// before retrying, scaled by how many requests are already queued for a
+// free API handler slot so the advertised backoff grows with the load.
+func throttleBackoffHint() time.Duration {
+	const (
+		minBackoff = time.Second
+		maxBackoff = 2 * time.Minute
+	)
+	backoff := minBackoff + time.Duration(globalHTTPStats.loadRequestsInQueue())*100*time.Millisecond
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// setThrottleHeaders attaches Retry-After and a millisecond granularity
+// backoff hint to throttling-class errors, without them SDKs retry
+// aggressively and make the overload worse.
+func setThrottleHeaders(w http.ResponseWriter, code string) {
+	switch code {
+	case "SlowDown", "RequestTimeout", "XMinioServerNotInitialized", "XMinioReadQuorum", "XMinioWriteQuorum":
+		backoff := throttleBackoffHint()
+		// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Retry-After
+		w.Header().Set(xhttp.RetryAfter, strconv.Itoa(int((backoff+time.Second-1)/time.Second)))
+		w.Header().Set(xhttp.MinIOBackoffHint, strconv.FormatInt(backoff.Milliseconds(), 10))
+	}
+}
+
 // writeErrorRespone writes error headers
 func writeErrorResponse(ctx context.Context, w http.ResponseWriter, err APIError, reqURL *url.URL) {
+	setThrottleHeaders(w, err.Code)
 	switch err.Code {
-	case "SlowDown", "XMinioServerNotInitialized", "XMinioReadQuorum", "XMinioWriteQuorum":
-		// Set retry-after header to indicate user-agents to retry request after 120secs.
-		// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Retry-After
-		w.Header().Set(xhttp.RetryAfter, "120")
 	case "InvalidRegion":
 		err.Description = fmt.Sprintf("Region does not match; expecting '%s'.", globalSite.Region)
 	case "AuthorizationHeaderMalformed":
@@ -933,6 +1004,9 @@ func writeErrorResponse(ctx context.Context, w http.ResponseWriter, err APIError
 		err.HTTPStatusCode = http.StatusInternalServerError
 	}
 
+	// Set the machine-readable error detail header when opted in.
+	writeErrorDetail(ctx, w, err)
+
 	// Generate error response.
 	errorResponse := getAPIErrorResponse(ctx, err, reqURL.Path,
 		w.Header().Get(xhttp.AmzRequestID), w.Header().Get(xhttp.AmzRequestHostID))
@@ -941,6 +1015,7 @@ func writeErrorResponse(ctx context.Context, w http.ResponseWriter, err APIError
 }
 
 func writeErrorResponseHeadersOnly(w http.ResponseWriter, err APIError) {
+	setThrottleHeaders(w, err.Code)
 	writeResponse(w, err.HTTPStatusCode, nil, mimeNone)
 }
 
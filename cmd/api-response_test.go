@@ -19,7 +19,11 @@ package cmd
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+
+	xhttp "github.com/minio/minio/internal/http"
 )
 
 // Tests object location.
@@ -123,3 +127,35 @@ func TestGetURLScheme(t *testing.T) {
 		t.Errorf("Expected %s, got %s", httpsScheme, gotScheme)
 	}
 }
+
+// Tests that throttling-class errors carry retry hints on both the
+// XML-body and headers-only error paths.
+func TestSetThrottleHeaders(t *testing.T) {
+	for _, code := range []string{"SlowDown", "RequestTimeout", "XMinioReadQuorum", "XMinioWriteQuorum"} {
+		rec := httptest.NewRecorder()
+		setThrottleHeaders(rec, code)
+		if rec.Header().Get(xhttp.RetryAfter) == "" {
+			t.Errorf("%s: expected a Retry-After header", code)
+		}
+		hint := rec.Header().Get(xhttp.MinIOBackoffHint)
+		if hint == "" {
+			t.Errorf("%s: expected a backoff hint header", code)
+		}
+		ms, err := strconv.Atoi(hint)
+		if err != nil || ms < 1000 {
+			t.Errorf("%s: expected a hint of at least 1000ms, got %q", code, hint)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	setThrottleHeaders(rec, "NoSuchKey")
+	if rec.Header().Get(xhttp.RetryAfter) != "" || rec.Header().Get(xhttp.MinIOBackoffHint) != "" {
+		t.Errorf("expected no retry hints on non-throttling errors")
+	}
+
+	rec = httptest.NewRecorder()
+	writeErrorResponseHeadersOnly(rec, errorCodes.ToAPIErr(ErrSlowDown))
+	if rec.Header().Get(xhttp.RetryAfter) == "" || rec.Header().Get(xhttp.MinIOBackoffHint) == "" {
+		t.Errorf("expected retry hints on the headers-only path")
+	}
+}
@@ -375,12 +375,60 @@ func registerAPIRouter(router *mux.Router) {
 		// GetBucketTaggingHandler
 		router.Methods(http.MethodGet).HandlerFunc(
 			collectAPIStats("getbuckettagging", maxClients(gz(httpTraceAll(api.GetBucketTaggingHandler))))).Queries("tagging", "")
+		// GetBucketTaggingHistoryHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbuckettagginghistory", maxClients(gz(httpTraceAll(api.GetBucketTaggingHistoryHandler))))).Queries("tagging-history", "")
+		// GetBucketDefaultTagsHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbucketdefaulttags", maxClients(gz(httpTraceAll(api.GetBucketDefaultTagsHandler))))).Queries("default-tags", "")
+		// GetBucketNoncurrentVersionsHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbucketnoncurrentversions", maxClients(gz(httpTraceAll(api.GetBucketNoncurrentVersionsHandler))))).Queries("noncurrent-versions", "")
+		// GetBucketScanConfigHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbucketscanconfig", maxClients(gz(httpTraceAll(api.GetBucketScanConfigHandler))))).Queries("scan", "")
+		// GetBucketMaxObjectSizeHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbucketmaxobjectsize", maxClients(gz(httpTraceAll(api.GetBucketMaxObjectSizeHandler))))).Queries("max-object-size", "")
+		// GetBucketMaxVersionsHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbucketmaxversions", maxClients(gz(httpTraceAll(api.GetBucketMaxVersionsHandler))))).Queries("max-versions", "")
+		// GetBucketBulkDeleteHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbucketbulkdelete", maxClients(gz(httpTraceAll(api.GetBucketBulkDeleteHandler))))).Queries("bulk-delete", "")
+		// GetBucketFanOutRoutingHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbucketfanoutrouting", maxClients(gz(httpTraceAll(api.GetBucketFanOutRoutingHandler))))).Queries("fanout-routing", "")
+		// GetBucketMetadataExportHandler
+		router.Methods(http.MethodGet).HandlerFunc(
+			collectAPIStats("getbucketmetadataexport", maxClients(gz(httpTraceAll(api.GetBucketMetadataExportHandler))))).Queries("metadata-export", "")
 		// DeleteBucketWebsiteHandler
 		router.Methods(http.MethodDelete).HandlerFunc(
 			collectAPIStats("deletebucketwebsite", maxClients(gz(httpTraceAll(api.DeleteBucketWebsiteHandler))))).Queries("website", "")
 		// DeleteBucketTaggingHandler
 		router.Methods(http.MethodDelete).HandlerFunc(
 			collectAPIStats("deletebuckettagging", maxClients(gz(httpTraceAll(api.DeleteBucketTaggingHandler))))).Queries("tagging", "")
+		// DeleteBucketDefaultTagsHandler
+		router.Methods(http.MethodDelete).HandlerFunc(
+			collectAPIStats("deletebucketdefaulttags", maxClients(gz(httpTraceAll(api.DeleteBucketDefaultTagsHandler))))).Queries("default-tags", "")
+		// DeleteBucketNoncurrentVersionsHandler
+		router.Methods(http.MethodDelete).HandlerFunc(
+			collectAPIStats("deletebucketnoncurrentversions", maxClients(gz(httpTraceAll(api.DeleteBucketNoncurrentVersionsHandler))))).Queries("noncurrent-versions", "")
+		// DeleteBucketScanConfigHandler
+		router.Methods(http.MethodDelete).HandlerFunc(
+			collectAPIStats("deletebucketscanconfig", maxClients(gz(httpTraceAll(api.DeleteBucketScanConfigHandler))))).Queries("scan", "")
+		// DeleteBucketMaxObjectSizeHandler
+		router.Methods(http.MethodDelete).HandlerFunc(
+			collectAPIStats("deletebucketmaxobjectsize", maxClients(gz(httpTraceAll(api.DeleteBucketMaxObjectSizeHandler))))).Queries("max-object-size", "")
+		// DeleteBucketMaxVersionsHandler
+		router.Methods(http.MethodDelete).HandlerFunc(
+			collectAPIStats("deletebucketmaxversions", maxClients(gz(httpTraceAll(api.DeleteBucketMaxVersionsHandler))))).Queries("max-versions", "")
+		// DeleteBucketBulkDeleteHandler
+		router.Methods(http.MethodDelete).HandlerFunc(
+			collectAPIStats("deletebucketbulkdelete", maxClients(gz(httpTraceAll(api.DeleteBucketBulkDeleteHandler))))).Queries("bulk-delete", "")
+		// DeleteBucketFanOutRoutingHandler
+		router.Methods(http.MethodDelete).HandlerFunc(
+			collectAPIStats("deletebucketfanoutrouting", maxClients(gz(httpTraceAll(api.DeleteBucketFanOutRoutingHandler))))).Queries("fanout-routing", "")
 
 		// ListMultipartUploads
 		router.Methods(http.MethodGet).HandlerFunc(
@@ -420,6 +468,30 @@ func registerAPIRouter(router *mux.Router) {
 		// PutBucketTaggingHandler
 		router.Methods(http.MethodPut).HandlerFunc(
 			collectAPIStats("putbuckettagging", maxClients(gz(httpTraceAll(api.PutBucketTaggingHandler))))).Queries("tagging", "")
+		// PutBucketDefaultTagsHandler
+		router.Methods(http.MethodPut).HandlerFunc(
+			collectAPIStats("putbucketdefaulttags", maxClients(gz(httpTraceAll(api.PutBucketDefaultTagsHandler))))).Queries("default-tags", "")
+		// PutBucketNoncurrentVersionsHandler
+		router.Methods(http.MethodPut).HandlerFunc(
+			collectAPIStats("putbucketnoncurrentversions", maxClients(gz(httpTraceAll(api.PutBucketNoncurrentVersionsHandler))))).Queries("noncurrent-versions", "")
+		// PutBucketScanConfigHandler
+		router.Methods(http.MethodPut).HandlerFunc(
+			collectAPIStats("putbucketscanconfig", maxClients(gz(httpTraceAll(api.PutBucketScanConfigHandler))))).Queries("scan", "")
+		// PutBucketMaxObjectSizeHandler
+		router.Methods(http.MethodPut).HandlerFunc(
+			collectAPIStats("putbucketmaxobjectsize", maxClients(gz(httpTraceAll(api.PutBucketMaxObjectSizeHandler))))).Queries("max-object-size", "")
+		// PutBucketMaxVersionsHandler
+		router.Methods(http.MethodPut).HandlerFunc(
+			collectAPIStats("putbucketmaxversions", maxClients(gz(httpTraceAll(api.PutBucketMaxVersionsHandler))))).Queries("max-versions", "")
+		// PutBucketBulkDeleteHandler
+		router.Methods(http.MethodPut).HandlerFunc(
+			collectAPIStats("putbucketbulkdelete", maxClients(gz(httpTraceAll(api.PutBucketBulkDeleteHandler))))).Queries("bulk-delete", "")
+		// PutBucketFanOutRoutingHandler
+		router.Methods(http.MethodPut).HandlerFunc(
+			collectAPIStats("putbucketfanoutrouting", maxClients(gz(httpTraceAll(api.PutBucketFanOutRoutingHandler))))).Queries("fanout-routing", "")
+		// PutBucketMetadataImportHandler
+		router.Methods(http.MethodPut).HandlerFunc(
+			collectAPIStats("putbucketmetadataimport", maxClients(gz(httpTraceAll(api.PutBucketMetadataImportHandler))))).Queries("metadata-import", "")
 		// PutBucketVersioning
 		router.Methods(http.MethodPut).HandlerFunc(
 			collectAPIStats("putbucketversioning", maxClients(gz(httpTraceAll(api.PutBucketVersioningHandler))))).Queries("versioning", "")
@@ -111,220 +111,230 @@ func _() {
 	_ = x[ErrBucketAlreadyOwnedByYou-100]
 	_ = x[ErrInvalidDuration-101]
 	_ = x[ErrBucketAlreadyExists-102]
-	_ = x[ErrMetadataTooLarge-103]
-	_ = x[ErrUnsupportedMetadata-104]
-	_ = x[ErrMaximumExpires-105]
-	_ = x[ErrSlowDown-106]
-	_ = x[ErrInvalidPrefixMarker-107]
-	_ = x[ErrBadRequest-108]
-	_ = x[ErrKeyTooLongError-109]
-	_ = x[ErrInvalidBucketObjectLockConfiguration-110]
-	_ = x[ErrObjectLockConfigurationNotFound-111]
-	_ = x[ErrObjectLockConfigurationNotAllowed-112]
-	_ = x[ErrNoSuchObjectLockConfiguration-113]
-	_ = x[ErrObjectLocked-114]
-	_ = x[ErrInvalidRetentionDate-115]
-	_ = x[ErrPastObjectLockRetainDate-116]
-	_ = x[ErrUnknownWORMModeDirective-117]
-	_ = x[ErrBucketTaggingNotFound-118]
-	_ = x[ErrObjectLockInvalidHeaders-119]
-	_ = x[ErrInvalidTagDirective-120]
-	_ = x[ErrPolicyAlreadyAttached-121]
-	_ = x[ErrPolicyNotAttached-122]
-	_ = x[ErrExcessData-123]
-	_ = x[ErrInvalidEncryptionMethod-124]
-	_ = x[ErrInvalidEncryptionKeyID-125]
-	_ = x[ErrInsecureSSECustomerRequest-126]
-	_ = x[ErrSSEMultipartEncrypted-127]
-	_ = x[ErrSSEEncryptedObject-128]
-	_ = x[ErrInvalidEncryptionParameters-129]
-	_ = x[ErrInvalidEncryptionParametersSSEC-130]
-	_ = x[ErrInvalidSSECustomerAlgorithm-131]
-	_ = x[ErrInvalidSSECustomerKey-132]
-	_ = x[ErrMissingSSECustomerKey-133]
-	_ = x[ErrMissingSSECustomerKeyMD5-134]
-	_ = x[ErrSSECustomerKeyMD5Mismatch-135]
-	_ = x[ErrInvalidSSECustomerParameters-136]
-	_ = x[ErrIncompatibleEncryptionMethod-137]
-	_ = x[ErrKMSNotConfigured-138]
-	_ = x[ErrKMSKeyNotFoundException-139]
-	_ = x[ErrKMSDefaultKeyAlreadyConfigured-140]
-	_ = x[ErrNoAccessKey-141]
-	_ = x[ErrInvalidToken-142]
-	_ = x[ErrEventNotification-143]
-	_ = x[ErrARNNotification-144]
-	_ = x[ErrRegionNotification-145]
-	_ = x[ErrOverlappingFilterNotification-146]
-	_ = x[ErrFilterNameInvalid-147]
-	_ = x[ErrFilterNamePrefix-148]
-	_ = x[ErrFilterNameSuffix-149]
-	_ = x[ErrFilterValueInvalid-150]
-	_ = x[ErrOverlappingConfigs-151]
-	_ = x[ErrUnsupportedNotification-152]
-	_ = x[ErrContentSHA256Mismatch-153]
-	_ = x[ErrContentChecksumMismatch-154]
-	_ = x[ErrStorageFull-155]
-	_ = x[ErrRequestBodyParse-156]
-	_ = x[ErrObjectExistsAsDirectory-157]
-	_ = x[ErrInvalidObjectName-158]
-	_ = x[ErrInvalidObjectNamePrefixSlash-159]
-	_ = x[ErrInvalidResourceName-160]
-	_ = x[ErrInvalidLifecycleQueryParameter-161]
-	_ = x[ErrServerNotInitialized-162]
-	_ = x[ErrOperationTimedOut-163]
-	_ = x[ErrClientDisconnected-164]
-	_ = x[ErrOperationMaxedOut-165]
-	_ = x[ErrInvalidRequest-166]
-	_ = x[ErrTransitionStorageClassNotFoundError-167]
-	_ = x[ErrInvalidStorageClass-168]
-	_ = x[ErrBackendDown-169]
-	_ = x[ErrMalformedJSON-170]
-	_ = x[ErrAdminNoSuchUser-171]
-	_ = x[ErrAdminNoSuchUserLDAPWarn-172]
-	_ = x[ErrAdminNoSuchGroup-173]
-	_ = x[ErrAdminGroupNotEmpty-174]
-	_ = x[ErrAdminGroupDisabled-175]
-	_ = x[ErrAdminNoSuchJob-176]
-	_ = x[ErrAdminNoSuchPolicy-177]
-	_ = x[ErrAdminPolicyChangeAlreadyApplied-178]
-	_ = x[ErrAdminInvalidArgument-179]
-	_ = x[ErrAdminInvalidAccessKey-180]
-	_ = x[ErrAdminInvalidSecretKey-181]
-	_ = x[ErrAdminConfigNoQuorum-182]
-	_ = x[ErrAdminConfigTooLarge-183]
-	_ = x[ErrAdminConfigBadJSON-184]
-	_ = x[ErrAdminNoSuchConfigTarget-185]
-	_ = x[ErrAdminConfigEnvOverridden-186]
-	_ = x[ErrAdminConfigDuplicateKeys-187]
-	_ = x[ErrAdminConfigInvalidIDPType-188]
-	_ = x[ErrAdminConfigLDAPNonDefaultConfigName-189]
-	_ = x[ErrAdminConfigLDAPValidation-190]
-	_ = x[ErrAdminConfigIDPCfgNameAlreadyExists-191]
-	_ = x[ErrAdminConfigIDPCfgNameDoesNotExist-192]
-	_ = x[ErrAdminCredentialsMismatch-193]
-	_ = x[ErrInsecureClientRequest-194]
-	_ = x[ErrObjectTampered-195]
-	_ = x[ErrSiteReplicationInvalidRequest-196]
-	_ = x[ErrSiteReplicationPeerResp-197]
-	_ = x[ErrSiteReplicationBackendIssue-198]
-	_ = x[ErrSiteReplicationServiceAccountError-199]
-	_ = x[ErrSiteReplicationBucketConfigError-200]
-	_ = x[ErrSiteReplicationBucketMetaError-201]
-	_ = x[ErrSiteReplicationIAMError-202]
-	_ = x[ErrSiteReplicationConfigMissing-203]
-	_ = x[ErrAdminRebalanceAlreadyStarted-204]
-	_ = x[ErrAdminRebalanceNotStarted-205]
-	_ = x[ErrAdminBucketQuotaExceeded-206]
-	_ = x[ErrAdminNoSuchQuotaConfiguration-207]
-	_ = x[ErrHealNotImplemented-208]
-	_ = x[ErrHealNoSuchProcess-209]
-	_ = x[ErrHealInvalidClientToken-210]
-	_ = x[ErrHealMissingBucket-211]
-	_ = x[ErrHealAlreadyRunning-212]
-	_ = x[ErrHealOverlappingPaths-213]
-	_ = x[ErrIncorrectContinuationToken-214]
-	_ = x[ErrEmptyRequestBody-215]
-	_ = x[ErrUnsupportedFunction-216]
-	_ = x[ErrInvalidExpressionType-217]
-	_ = x[ErrBusy-218]
-	_ = x[ErrUnauthorizedAccess-219]
-	_ = x[ErrExpressionTooLong-220]
-	_ = x[ErrIllegalSQLFunctionArgument-221]
-	_ = x[ErrInvalidKeyPath-222]
-	_ = x[ErrInvalidCompressionFormat-223]
-	_ = x[ErrInvalidFileHeaderInfo-224]
-	_ = x[ErrInvalidJSONType-225]
-	_ = x[ErrInvalidQuoteFields-226]
-	_ = x[ErrInvalidRequestParameter-227]
-	_ = x[ErrInvalidDataType-228]
-	_ = x[ErrInvalidTextEncoding-229]
-	_ = x[ErrInvalidDataSource-230]
-	_ = x[ErrInvalidTableAlias-231]
-	_ = x[ErrMissingRequiredParameter-232]
-	_ = x[ErrObjectSerializationConflict-233]
-	_ = x[ErrUnsupportedSQLOperation-234]
-	_ = x[ErrUnsupportedSQLStructure-235]
-	_ = x[ErrUnsupportedSyntax-236]
-	_ = x[ErrUnsupportedRangeHeader-237]
-	_ = x[ErrLexerInvalidChar-238]
-	_ = x[ErrLexerInvalidOperator-239]
-	_ = x[ErrLexerInvalidLiteral-240]
-	_ = x[ErrLexerInvalidIONLiteral-241]
-	_ = x[ErrParseExpectedDatePart-242]
-	_ = x[ErrParseExpectedKeyword-243]
-	_ = x[ErrParseExpectedTokenType-244]
-	_ = x[ErrParseExpected2TokenTypes-245]
-	_ = x[ErrParseExpectedNumber-246]
-	_ = x[ErrParseExpectedRightParenBuiltinFunctionCall-247]
-	_ = x[ErrParseExpectedTypeName-248]
-	_ = x[ErrParseExpectedWhenClause-249]
-	_ = x[ErrParseUnsupportedToken-250]
-	_ = x[ErrParseUnsupportedLiteralsGroupBy-251]
-	_ = x[ErrParseExpectedMember-252]
-	_ = x[ErrParseUnsupportedSelect-253]
-	_ = x[ErrParseUnsupportedCase-254]
-	_ = x[ErrParseUnsupportedCaseClause-255]
-	_ = x[ErrParseUnsupportedAlias-256]
-	_ = x[ErrParseUnsupportedSyntax-257]
-	_ = x[ErrParseUnknownOperator-258]
-	_ = x[ErrParseMissingIdentAfterAt-259]
-	_ = x[ErrParseUnexpectedOperator-260]
-	_ = x[ErrParseUnexpectedTerm-261]
-	_ = x[ErrParseUnexpectedToken-262]
-	_ = x[ErrParseUnexpectedKeyword-263]
-	_ = x[ErrParseExpectedExpression-264]
-	_ = x[ErrParseExpectedLeftParenAfterCast-265]
-	_ = x[ErrParseExpectedLeftParenValueConstructor-266]
-	_ = x[ErrParseExpectedLeftParenBuiltinFunctionCall-267]
-	_ = x[ErrParseExpectedArgumentDelimiter-268]
-	_ = x[ErrParseCastArity-269]
-	_ = x[ErrParseInvalidTypeParam-270]
-	_ = x[ErrParseEmptySelect-271]
-	_ = x[ErrParseSelectMissingFrom-272]
-	_ = x[ErrParseExpectedIdentForGroupName-273]
-	_ = x[ErrParseExpectedIdentForAlias-274]
-	_ = x[ErrParseUnsupportedCallWithStar-275]
-	_ = x[ErrParseNonUnaryAgregateFunctionCall-276]
-	_ = x[ErrParseMalformedJoin-277]
-	_ = x[ErrParseExpectedIdentForAt-278]
-	_ = x[ErrParseAsteriskIsNotAloneInSelectList-279]
-	_ = x[ErrParseCannotMixSqbAndWildcardInSelectList-280]
-	_ = x[ErrParseInvalidContextForWildcardInSelectList-281]
-	_ = x[ErrIncorrectSQLFunctionArgumentType-282]
-	_ = x[ErrValueParseFailure-283]
-	_ = x[ErrEvaluatorInvalidArguments-284]
-	_ = x[ErrIntegerOverflow-285]
-	_ = x[ErrLikeInvalidInputs-286]
-	_ = x[ErrCastFailed-287]
-	_ = x[ErrInvalidCast-288]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPattern-289]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbolForParsing-290]
-	_ = x[ErrEvaluatorTimestampFormatPatternDuplicateFields-291]
-	_ = x[ErrEvaluatorTimestampFormatPatternHourClockAmPmMismatch-292]
-	_ = x[ErrEvaluatorUnterminatedTimestampFormatPatternToken-293]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternToken-294]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbol-295]
-	_ = x[ErrEvaluatorBindingDoesNotExist-296]
-	_ = x[ErrMissingHeaders-297]
-	_ = x[ErrInvalidColumnIndex-298]
-	_ = x[ErrAdminConfigNotificationTargetsFailed-299]
-	_ = x[ErrAdminProfilerNotEnabled-300]
-	_ = x[ErrInvalidDecompressedSize-301]
-	_ = x[ErrAddUserInvalidArgument-302]
-	_ = x[ErrAdminResourceInvalidArgument-303]
-	_ = x[ErrAdminAccountNotEligible-304]
-	_ = x[ErrAccountNotEligible-305]
-	_ = x[ErrAdminServiceAccountNotFound-306]
-	_ = x[ErrPostPolicyConditionInvalidFormat-307]
-	_ = x[ErrInvalidChecksum-308]
-	_ = x[ErrLambdaARNInvalid-309]
-	_ = x[ErrLambdaARNNotFound-310]
-	_ = x[apiErrCodeEnd-311]
+	_ = x[ErrBucketCaseConflict-103]
+	_ = x[ErrMetadataTooLarge-104]
+	_ = x[ErrUnsupportedMetadata-105]
+	_ = x[ErrMaximumExpires-106]
+	_ = x[ErrSlowDown-107]
+	_ = x[ErrInvalidPrefixMarker-108]
+	_ = x[ErrBadRequest-109]
+	_ = x[ErrKeyTooLongError-110]
+	_ = x[ErrInvalidBucketObjectLockConfiguration-111]
+	_ = x[ErrObjectLockConfigurationNotFound-112]
+	_ = x[ErrObjectLockConfigurationNotAllowed-113]
+	_ = x[ErrNoSuchObjectLockConfiguration-114]
+	_ = x[ErrObjectLocked-115]
+	_ = x[ErrInvalidRetentionDate-116]
+	_ = x[ErrPastObjectLockRetainDate-117]
+	_ = x[ErrUnknownWORMModeDirective-118]
+	_ = x[ErrBucketTaggingNotFound-119]
+	_ = x[ErrBucketScanConfigNotFound-120]
+	_ = x[ErrObjectScanFailed-121]
+	_ = x[ErrBucketMaxObjectSizeConfigNotFound-122]
+	_ = x[ErrBucketMaxVersionsConfigNotFound-123]
+	_ = x[ErrBucketBulkDeleteConfigNotFound-124]
+	_ = x[ErrBucketBulkDeleteDisabled-125]
+	_ = x[ErrBucketFanOutRoutingConfigNotFound-126]
+	_ = x[ErrBucketMetadataBundleInvalid-127]
+	_ = x[ErrObjectLockInvalidHeaders-128]
+	_ = x[ErrInvalidTagDirective-129]
+	_ = x[ErrPolicyAlreadyAttached-130]
+	_ = x[ErrPolicyNotAttached-131]
+	_ = x[ErrExcessData-132]
+	_ = x[ErrInvalidEncryptionMethod-133]
+	_ = x[ErrInvalidEncryptionKeyID-134]
+	_ = x[ErrInsecureSSECustomerRequest-135]
+	_ = x[ErrSSEMultipartEncrypted-136]
+	_ = x[ErrSSEEncryptedObject-137]
+	_ = x[ErrInvalidEncryptionParameters-138]
+	_ = x[ErrInvalidEncryptionParametersSSEC-139]
+	_ = x[ErrSSEEnforcementFailed-140]
+	_ = x[ErrInvalidSSECustomerAlgorithm-141]
+	_ = x[ErrInvalidSSECustomerKey-142]
+	_ = x[ErrMissingSSECustomerKey-143]
+	_ = x[ErrMissingSSECustomerKeyMD5-144]
+	_ = x[ErrSSECustomerKeyMD5Mismatch-145]
+	_ = x[ErrInvalidSSECustomerParameters-146]
+	_ = x[ErrIncompatibleEncryptionMethod-147]
+	_ = x[ErrKMSNotConfigured-148]
+	_ = x[ErrKMSKeyNotFoundException-149]
+	_ = x[ErrKMSDefaultKeyAlreadyConfigured-150]
+	_ = x[ErrNoAccessKey-151]
+	_ = x[ErrInvalidToken-152]
+	_ = x[ErrEventNotification-153]
+	_ = x[ErrARNNotification-154]
+	_ = x[ErrRegionNotification-155]
+	_ = x[ErrOverlappingFilterNotification-156]
+	_ = x[ErrFilterNameInvalid-157]
+	_ = x[ErrFilterNamePrefix-158]
+	_ = x[ErrFilterNameSuffix-159]
+	_ = x[ErrFilterValueInvalid-160]
+	_ = x[ErrOverlappingConfigs-161]
+	_ = x[ErrUnsupportedNotification-162]
+	_ = x[ErrContentSHA256Mismatch-163]
+	_ = x[ErrContentChecksumMismatch-164]
+	_ = x[ErrStorageFull-165]
+	_ = x[ErrRequestBodyParse-166]
+	_ = x[ErrObjectExistsAsDirectory-167]
+	_ = x[ErrInvalidObjectName-168]
+	_ = x[ErrInvalidObjectNamePrefixSlash-169]
+	_ = x[ErrInvalidResourceName-170]
+	_ = x[ErrInvalidLifecycleQueryParameter-171]
+	_ = x[ErrServerNotInitialized-172]
+	_ = x[ErrOperationTimedOut-173]
+	_ = x[ErrClientDisconnected-174]
+	_ = x[ErrOperationMaxedOut-175]
+	_ = x[ErrInvalidRequest-176]
+	_ = x[ErrTransitionStorageClassNotFoundError-177]
+	_ = x[ErrInvalidStorageClass-178]
+	_ = x[ErrBackendDown-179]
+	_ = x[ErrMalformedJSON-180]
+	_ = x[ErrAdminNoSuchUser-181]
+	_ = x[ErrAdminNoSuchUserLDAPWarn-182]
+	_ = x[ErrAdminNoSuchGroup-183]
+	_ = x[ErrAdminGroupNotEmpty-184]
+	_ = x[ErrAdminGroupDisabled-185]
+	_ = x[ErrAdminNoSuchJob-186]
+	_ = x[ErrAdminNoSuchPolicy-187]
+	_ = x[ErrAdminPolicyChangeAlreadyApplied-188]
+	_ = x[ErrAdminInvalidArgument-189]
+	_ = x[ErrAdminInvalidAccessKey-190]
+	_ = x[ErrAdminInvalidSecretKey-191]
+	_ = x[ErrAdminConfigNoQuorum-192]
+	_ = x[ErrAdminConfigTooLarge-193]
+	_ = x[ErrAdminConfigBadJSON-194]
+	_ = x[ErrAdminNoSuchConfigTarget-195]
+	_ = x[ErrAdminConfigEnvOverridden-196]
+	_ = x[ErrAdminConfigDuplicateKeys-197]
+	_ = x[ErrAdminConfigInvalidIDPType-198]
+	_ = x[ErrAdminConfigLDAPNonDefaultConfigName-199]
+	_ = x[ErrAdminConfigLDAPValidation-200]
+	_ = x[ErrAdminConfigIDPCfgNameAlreadyExists-201]
+	_ = x[ErrAdminConfigIDPCfgNameDoesNotExist-202]
+	_ = x[ErrAdminCredentialsMismatch-203]
+	_ = x[ErrInsecureClientRequest-204]
+	_ = x[ErrObjectTampered-205]
+	_ = x[ErrSiteReplicationInvalidRequest-206]
+	_ = x[ErrSiteReplicationPeerResp-207]
+	_ = x[ErrSiteReplicationBackendIssue-208]
+	_ = x[ErrSiteReplicationServiceAccountError-209]
+	_ = x[ErrSiteReplicationBucketConfigError-210]
+	_ = x[ErrSiteReplicationBucketMetaError-211]
+	_ = x[ErrSiteReplicationIAMError-212]
+	_ = x[ErrSiteReplicationConfigMissing-213]
+	_ = x[ErrAdminRebalanceAlreadyStarted-214]
+	_ = x[ErrAdminRebalanceNotStarted-215]
+	_ = x[ErrAdminBucketQuotaExceeded-216]
+	_ = x[ErrAdminNoSuchQuotaConfiguration-217]
+	_ = x[ErrHealNotImplemented-218]
+	_ = x[ErrHealNoSuchProcess-219]
+	_ = x[ErrHealInvalidClientToken-220]
+	_ = x[ErrHealMissingBucket-221]
+	_ = x[ErrHealAlreadyRunning-222]
+	_ = x[ErrHealOverlappingPaths-223]
+	_ = x[ErrIncorrectContinuationToken-224]
+	_ = x[ErrEmptyRequestBody-225]
+	_ = x[ErrUnsupportedFunction-226]
+	_ = x[ErrInvalidExpressionType-227]
+	_ = x[ErrBusy-228]
+	_ = x[ErrUnauthorizedAccess-229]
+	_ = x[ErrExpressionTooLong-230]
+	_ = x[ErrIllegalSQLFunctionArgument-231]
+	_ = x[ErrInvalidKeyPath-232]
+	_ = x[ErrInvalidCompressionFormat-233]
+	_ = x[ErrInvalidFileHeaderInfo-234]
+	_ = x[ErrInvalidJSONType-235]
+	_ = x[ErrInvalidQuoteFields-236]
+	_ = x[ErrInvalidRequestParameter-237]
+	_ = x[ErrInvalidDataType-238]
+	_ = x[ErrInvalidTextEncoding-239]
+	_ = x[ErrInvalidDataSource-240]
+	_ = x[ErrInvalidTableAlias-241]
+	_ = x[ErrMissingRequiredParameter-242]
+	_ = x[ErrObjectSerializationConflict-243]
+	_ = x[ErrUnsupportedSQLOperation-244]
+	_ = x[ErrUnsupportedSQLStructure-245]
+	_ = x[ErrUnsupportedSyntax-246]
+	_ = x[ErrUnsupportedRangeHeader-247]
+	_ = x[ErrLexerInvalidChar-248]
+	_ = x[ErrLexerInvalidOperator-249]
+	_ = x[ErrLexerInvalidLiteral-250]
+	_ = x[ErrLexerInvalidIONLiteral-251]
+	_ = x[ErrParseExpectedDatePart-252]
+	_ = x[ErrParseExpectedKeyword-253]
+	_ = x[ErrParseExpectedTokenType-254]
+	_ = x[ErrParseExpected2TokenTypes-255]
+	_ = x[ErrParseExpectedNumber-256]
+	_ = x[ErrParseExpectedRightParenBuiltinFunctionCall-257]
+	_ = x[ErrParseExpectedTypeName-258]
+	_ = x[ErrParseExpectedWhenClause-259]
+	_ = x[ErrParseUnsupportedToken-260]
+	_ = x[ErrParseUnsupportedLiteralsGroupBy-261]
+	_ = x[ErrParseExpectedMember-262]
+	_ = x[ErrParseUnsupportedSelect-263]
+	_ = x[ErrParseUnsupportedCase-264]
+	_ = x[ErrParseUnsupportedCaseClause-265]
+	_ = x[ErrParseUnsupportedAlias-266]
+	_ = x[ErrParseUnsupportedSyntax-267]
+	_ = x[ErrParseUnknownOperator-268]
+	_ = x[ErrParseMissingIdentAfterAt-269]
+	_ = x[ErrParseUnexpectedOperator-270]
+	_ = x[ErrParseUnexpectedTerm-271]
+	_ = x[ErrParseUnexpectedToken-272]
+	_ = x[ErrParseUnexpectedKeyword-273]
+	_ = x[ErrParseExpectedExpression-274]
+	_ = x[ErrParseExpectedLeftParenAfterCast-275]
+	_ = x[ErrParseExpectedLeftParenValueConstructor-276]
+	_ = x[ErrParseExpectedLeftParenBuiltinFunctionCall-277]
+	_ = x[ErrParseExpectedArgumentDelimiter-278]
+	_ = x[ErrParseCastArity-279]
+	_ = x[ErrParseInvalidTypeParam-280]
+	_ = x[ErrParseEmptySelect-281]
+	_ = x[ErrParseSelectMissingFrom-282]
+	_ = x[ErrParseExpectedIdentForGroupName-283]
+	_ = x[ErrParseExpectedIdentForAlias-284]
+	_ = x[ErrParseUnsupportedCallWithStar-285]
+	_ = x[ErrParseNonUnaryAgregateFunctionCall-286]
+	_ = x[ErrParseMalformedJoin-287]
+	_ = x[ErrParseExpectedIdentForAt-288]
+	_ = x[ErrParseAsteriskIsNotAloneInSelectList-289]
+	_ = x[ErrParseCannotMixSqbAndWildcardInSelectList-290]
+	_ = x[ErrParseInvalidContextForWildcardInSelectList-291]
+	_ = x[ErrIncorrectSQLFunctionArgumentType-292]
+	_ = x[ErrValueParseFailure-293]
+	_ = x[ErrEvaluatorInvalidArguments-294]
+	_ = x[ErrIntegerOverflow-295]
+	_ = x[ErrLikeInvalidInputs-296]
+	_ = x[ErrCastFailed-297]
+	_ = x[ErrInvalidCast-298]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPattern-299]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbolForParsing-300]
+	_ = x[ErrEvaluatorTimestampFormatPatternDuplicateFields-301]
+	_ = x[ErrEvaluatorTimestampFormatPatternHourClockAmPmMismatch-302]
+	_ = x[ErrEvaluatorUnterminatedTimestampFormatPatternToken-303]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternToken-304]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbol-305]
+	_ = x[ErrEvaluatorBindingDoesNotExist-306]
+	_ = x[ErrMissingHeaders-307]
+	_ = x[ErrInvalidColumnIndex-308]
+	_ = x[ErrAdminConfigNotificationTargetsFailed-309]
+	_ = x[ErrAdminProfilerNotEnabled-310]
+	_ = x[ErrInvalidDecompressedSize-311]
+	_ = x[ErrAddUserInvalidArgument-312]
+	_ = x[ErrAdminResourceInvalidArgument-313]
+	_ = x[ErrAdminAccountNotEligible-314]
+	_ = x[ErrAccountNotEligible-315]
+	_ = x[ErrAdminServiceAccountNotFound-316]
+	_ = x[ErrPostPolicyConditionInvalidFormat-317]
+	_ = x[ErrInvalidChecksum-318]
+	_ = x[ErrLambdaARNInvalid-319]
+	_ = x[ErrLambdaARNNotFound-320]
+	_ = x[apiErrCodeEnd-321]
 }
 
-const _APIErrorCode_name = "NoneAccessDeniedBadDigestEntityTooSmallEntityTooLargePolicyTooLargeIncompleteBodyInternalErrorInvalidAccessKeyIDAccessKeyDisabledInvalidBucketNameInvalidDigestInvalidRangeInvalidRangePartNumberInvalidCopyPartRangeInvalidCopyPartRangeSourceInvalidMaxKeysInvalidEncodingMethodInvalidMaxUploadsInvalidMaxPartsInvalidPartNumberMarkerInvalidPartNumberInvalidRequestBodyInvalidCopySourceInvalidMetadataDirectiveInvalidCopyDestInvalidPolicyDocumentInvalidObjectStateMalformedXMLMissingContentLengthMissingContentMD5MissingRequestBodyErrorMissingSecurityHeaderNoSuchBucketNoSuchBucketPolicyNoSuchBucketLifecycleNoSuchLifecycleConfigurationInvalidLifecycleWithObjectLockNoSuchBucketSSEConfigNoSuchCORSConfigurationNoSuchWebsiteConfigurationReplicationConfigurationNotFoundErrorRemoteDestinationNotFoundErrorReplicationDestinationMissingLockRemoteTargetNotFoundErrorReplicationRemoteConnectionErrorReplicationBandwidthLimitErrorBucketRemoteIdenticalToSourceBucketRemoteAlreadyExistsBucketRemoteLabelInUseBucketRemoteArnTypeInvalidBucketRemoteArnInvalidBucketRemoteRemoveDisallowedRemoteTargetNotVersionedErrorReplicationSourceNotVersionedErrorReplicationNeedsVersioningErrorReplicationBucketNeedsVersioningErrorReplicationDenyEditErrorRemoteTargetDenyAddErrorReplicationNoExistingObjectsObjectRestoreAlreadyInProgressNoSuchKeyNoSuchUploadInvalidVersionIDNoSuchVersionNotImplementedPreconditionFailedRequestTimeTooSkewedSignatureDoesNotMatchMethodNotAllowedInvalidPartInvalidPartOrderAuthorizationHeaderMalformedMalformedPOSTRequestPOSTFileRequiredSignatureVersionNotSupportedBucketNotEmptyAllAccessDisabledPolicyInvalidVersionMissingFieldsMissingCredTagCredMalformedInvalidRegionInvalidServiceS3InvalidServiceSTSInvalidRequestVersionMissingSignTagMissingSignHeadersTagMalformedDateMalformedPresignedDateMalformedCredentialDateMalformedExpiresNegativeExpiresAuthHeaderEmptyExpiredPresignRequestRequestNotReadyYetUnsignedHeadersMissingDateHeaderInvalidQuerySignatureAlgoInvalidQueryParamsBucketAlreadyOwnedByYouInvalidDurationBucketAlreadyExistsMetadataTooLargeUnsupportedMetadataMaximumExpiresSlowDownInvalidPrefixMarkerBadRequestKeyTooLongErrorInvalidBucketObjectLockConfigurationObjectLockConfigurationNotFoundObjectLockConfigurationNotAllowedNoSuchObjectLockConfigurationObjectLockedInvalidRetentionDatePastObjectLockRetainDateUnknownWORMModeDirectiveBucketTaggingNotFoundObjectLockInvalidHeadersInvalidTagDirectivePolicyAlreadyAttachedPolicyNotAttachedExcessDataInvalidEncryptionMethodInvalidEncryptionKeyIDInsecureSSECustomerRequestSSEMultipartEncryptedSSEEncryptedObjectInvalidEncryptionParametersInvalidEncryptionParametersSSECInvalidSSECustomerAlgorithmInvalidSSECustomerKeyMissingSSECustomerKeyMissingSSECustomerKeyMD5SSECustomerKeyMD5MismatchInvalidSSECustomerParametersIncompatibleEncryptionMethodKMSNotConfiguredKMSKeyNotFoundExceptionKMSDefaultKeyAlreadyConfiguredNoAccessKeyInvalidTokenEventNotificationARNNotificationRegionNotificationOverlappingFilterNotificationFilterNameInvalidFilterNamePrefixFilterNameSuffixFilterValueInvalidOverlappingConfigsUnsupportedNotificationContentSHA256MismatchContentChecksumMismatchStorageFullRequestBodyParseObjectExistsAsDirectoryInvalidObjectNameInvalidObjectNamePrefixSlashInvalidResourceNameInvalidLifecycleQueryParameterServerNotInitializedOperationTimedOutClientDisconnectedOperationMaxedOutInvalidRequestTransitionStorageClassNotFoundErrorInvalidStorageClassBackendDownMalformedJSONAdminNoSuchUserAdminNoSuchUserLDAPWarnAdminNoSuchGroupAdminGroupNotEmptyAdminGroupDisabledAdminNoSuchJobAdminNoSuchPolicyAdminPolicyChangeAlreadyAppliedAdminInvalidArgumentAdminInvalidAccessKeyAdminInvalidSecretKeyAdminConfigNoQuorumAdminConfigTooLargeAdminConfigBadJSONAdminNoSuchConfigTargetAdminConfigEnvOverriddenAdminConfigDuplicateKeysAdminConfigInvalidIDPTypeAdminConfigLDAPNonDefaultConfigNameAdminConfigLDAPValidationAdminConfigIDPCfgNameAlreadyExistsAdminConfigIDPCfgNameDoesNotExistAdminCredentialsMismatchInsecureClientRequestObjectTamperedSiteReplicationInvalidRequestSiteReplicationPeerRespSiteReplicationBackendIssueSiteReplicationServiceAccountErrorSiteReplicationBucketConfigErrorSiteReplicationBucketMetaErrorSiteReplicationIAMErrorSiteReplicationConfigMissingAdminRebalanceAlreadyStartedAdminRebalanceNotStartedAdminBucketQuotaExceededAdminNoSuchQuotaConfigurationHealNotImplementedHealNoSuchProcessHealInvalidClientTokenHealMissingBucketHealAlreadyRunningHealOverlappingPathsIncorrectContinuationTokenEmptyRequestBodyUnsupportedFunctionInvalidExpressionTypeBusyUnauthorizedAccessExpressionTooLongIllegalSQLFunctionArgumentInvalidKeyPathInvalidCompressionFormatInvalidFileHeaderInfoInvalidJSONTypeInvalidQuoteFieldsInvalidRequestParameterInvalidDataTypeInvalidTextEncodingInvalidDataSourceInvalidTableAliasMissingRequiredParameterObjectSerializationConflictUnsupportedSQLOperationUnsupportedSQLStructureUnsupportedSyntaxUnsupportedRangeHeaderLexerInvalidCharLexerInvalidOperatorLexerInvalidLiteralLexerInvalidIONLiteralParseExpectedDatePartParseExpectedKeywordParseExpectedTokenTypeParseExpected2TokenTypesParseExpectedNumberParseExpectedRightParenBuiltinFunctionCallParseExpectedTypeNameParseExpectedWhenClauseParseUnsupportedTokenParseUnsupportedLiteralsGroupByParseExpectedMemberParseUnsupportedSelectParseUnsupportedCaseParseUnsupportedCaseClauseParseUnsupportedAliasParseUnsupportedSyntaxParseUnknownOperatorParseMissingIdentAfterAtParseUnexpectedOperatorParseUnexpectedTermParseUnexpectedTokenParseUnexpectedKeywordParseExpectedExpressionParseExpectedLeftParenAfterCastParseExpectedLeftParenValueConstructorParseExpectedLeftParenBuiltinFunctionCallParseExpectedArgumentDelimiterParseCastArityParseInvalidTypeParamParseEmptySelectParseSelectMissingFromParseExpectedIdentForGroupNameParseExpectedIdentForAliasParseUnsupportedCallWithStarParseNonUnaryAgregateFunctionCallParseMalformedJoinParseExpectedIdentForAtParseAsteriskIsNotAloneInSelectListParseCannotMixSqbAndWildcardInSelectListParseInvalidContextForWildcardInSelectListIncorrectSQLFunctionArgumentTypeValueParseFailureEvaluatorInvalidArgumentsIntegerOverflowLikeInvalidInputsCastFailedInvalidCastEvaluatorInvalidTimestampFormatPatternEvaluatorInvalidTimestampFormatPatternSymbolForParsingEvaluatorTimestampFormatPatternDuplicateFieldsEvaluatorTimestampFormatPatternHourClockAmPmMismatchEvaluatorUnterminatedTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternSymbolEvaluatorBindingDoesNotExistMissingHeadersInvalidColumnIndexAdminConfigNotificationTargetsFailedAdminProfilerNotEnabledInvalidDecompressedSizeAddUserInvalidArgumentAdminResourceInvalidArgumentAdminAccountNotEligibleAccountNotEligibleAdminServiceAccountNotFoundPostPolicyConditionInvalidFormatInvalidChecksumLambdaARNInvalidLambdaARNNotFoundapiErrCodeEnd"
+const _APIErrorCode_name = "NoneAccessDeniedBadDigestEntityTooSmallEntityTooLargePolicyTooLargeIncompleteBodyInternalErrorInvalidAccessKeyIDAccessKeyDisabledInvalidBucketNameInvalidDigestInvalidRangeInvalidRangePartNumberInvalidCopyPartRangeInvalidCopyPartRangeSourceInvalidMaxKeysInvalidEncodingMethodInvalidMaxUploadsInvalidMaxPartsInvalidPartNumberMarkerInvalidPartNumberInvalidRequestBodyInvalidCopySourceInvalidMetadataDirectiveInvalidCopyDestInvalidPolicyDocumentInvalidObjectStateMalformedXMLMissingContentLengthMissingContentMD5MissingRequestBodyErrorMissingSecurityHeaderNoSuchBucketNoSuchBucketPolicyNoSuchBucketLifecycleNoSuchLifecycleConfigurationInvalidLifecycleWithObjectLockNoSuchBucketSSEConfigNoSuchCORSConfigurationNoSuchWebsiteConfigurationReplicationConfigurationNotFoundErrorRemoteDestinationNotFoundErrorReplicationDestinationMissingLockRemoteTargetNotFoundErrorReplicationRemoteConnectionErrorReplicationBandwidthLimitErrorBucketRemoteIdenticalToSourceBucketRemoteAlreadyExistsBucketRemoteLabelInUseBucketRemoteArnTypeInvalidBucketRemoteArnInvalidBucketRemoteRemoveDisallowedRemoteTargetNotVersionedErrorReplicationSourceNotVersionedErrorReplicationNeedsVersioningErrorReplicationBucketNeedsVersioningErrorReplicationDenyEditErrorRemoteTargetDenyAddErrorReplicationNoExistingObjectsObjectRestoreAlreadyInProgressNoSuchKeyNoSuchUploadInvalidVersionIDNoSuchVersionNotImplementedPreconditionFailedRequestTimeTooSkewedSignatureDoesNotMatchMethodNotAllowedInvalidPartInvalidPartOrderAuthorizationHeaderMalformedMalformedPOSTRequestPOSTFileRequiredSignatureVersionNotSupportedBucketNotEmptyAllAccessDisabledPolicyInvalidVersionMissingFieldsMissingCredTagCredMalformedInvalidRegionInvalidServiceS3InvalidServiceSTSInvalidRequestVersionMissingSignTagMissingSignHeadersTagMalformedDateMalformedPresignedDateMalformedCredentialDateMalformedExpiresNegativeExpiresAuthHeaderEmptyExpiredPresignRequestRequestNotReadyYetUnsignedHeadersMissingDateHeaderInvalidQuerySignatureAlgoInvalidQueryParamsBucketAlreadyOwnedByYouInvalidDurationBucketAlreadyExistsBucketCaseConflictMetadataTooLargeUnsupportedMetadataMaximumExpiresSlowDownInvalidPrefixMarkerBadRequestKeyTooLongErrorInvalidBucketObjectLockConfigurationObjectLockConfigurationNotFoundObjectLockConfigurationNotAllowedNoSuchObjectLockConfigurationObjectLockedInvalidRetentionDatePastObjectLockRetainDateUnknownWORMModeDirectiveBucketTaggingNotFoundBucketScanConfigNotFoundObjectScanFailedBucketMaxObjectSizeConfigNotFoundBucketMaxVersionsConfigNotFoundBucketBulkDeleteConfigNotFoundBucketBulkDeleteDisabledBucketFanOutRoutingConfigNotFoundBucketMetadataBundleInvalidObjectLockInvalidHeadersInvalidTagDirectivePolicyAlreadyAttachedPolicyNotAttachedExcessDataInvalidEncryptionMethodInvalidEncryptionKeyIDInsecureSSECustomerRequestSSEMultipartEncryptedSSEEncryptedObjectInvalidEncryptionParametersInvalidEncryptionParametersSSECSSEEnforcementFailedInvalidSSECustomerAlgorithmInvalidSSECustomerKeyMissingSSECustomerKeyMissingSSECustomerKeyMD5SSECustomerKeyMD5MismatchInvalidSSECustomerParametersIncompatibleEncryptionMethodKMSNotConfiguredKMSKeyNotFoundExceptionKMSDefaultKeyAlreadyConfiguredNoAccessKeyInvalidTokenEventNotificationARNNotificationRegionNotificationOverlappingFilterNotificationFilterNameInvalidFilterNamePrefixFilterNameSuffixFilterValueInvalidOverlappingConfigsUnsupportedNotificationContentSHA256MismatchContentChecksumMismatchStorageFullRequestBodyParseObjectExistsAsDirectoryInvalidObjectNameInvalidObjectNamePrefixSlashInvalidResourceNameInvalidLifecycleQueryParameterServerNotInitializedOperationTimedOutClientDisconnectedOperationMaxedOutInvalidRequestTransitionStorageClassNotFoundErrorInvalidStorageClassBackendDownMalformedJSONAdminNoSuchUserAdminNoSuchUserLDAPWarnAdminNoSuchGroupAdminGroupNotEmptyAdminGroupDisabledAdminNoSuchJobAdminNoSuchPolicyAdminPolicyChangeAlreadyAppliedAdminInvalidArgumentAdminInvalidAccessKeyAdminInvalidSecretKeyAdminConfigNoQuorumAdminConfigTooLargeAdminConfigBadJSONAdminNoSuchConfigTargetAdminConfigEnvOverriddenAdminConfigDuplicateKeysAdminConfigInvalidIDPTypeAdminConfigLDAPNonDefaultConfigNameAdminConfigLDAPValidationAdminConfigIDPCfgNameAlreadyExistsAdminConfigIDPCfgNameDoesNotExistAdminCredentialsMismatchInsecureClientRequestObjectTamperedSiteReplicationInvalidRequestSiteReplicationPeerRespSiteReplicationBackendIssueSiteReplicationServiceAccountErrorSiteReplicationBucketConfigErrorSiteReplicationBucketMetaErrorSiteReplicationIAMErrorSiteReplicationConfigMissingAdminRebalanceAlreadyStartedAdminRebalanceNotStartedAdminBucketQuotaExceededAdminNoSuchQuotaConfigurationHealNotImplementedHealNoSuchProcessHealInvalidClientTokenHealMissingBucketHealAlreadyRunningHealOverlappingPathsIncorrectContinuationTokenEmptyRequestBodyUnsupportedFunctionInvalidExpressionTypeBusyUnauthorizedAccessExpressionTooLongIllegalSQLFunctionArgumentInvalidKeyPathInvalidCompressionFormatInvalidFileHeaderInfoInvalidJSONTypeInvalidQuoteFieldsInvalidRequestParameterInvalidDataTypeInvalidTextEncodingInvalidDataSourceInvalidTableAliasMissingRequiredParameterObjectSerializationConflictUnsupportedSQLOperationUnsupportedSQLStructureUnsupportedSyntaxUnsupportedRangeHeaderLexerInvalidCharLexerInvalidOperatorLexerInvalidLiteralLexerInvalidIONLiteralParseExpectedDatePartParseExpectedKeywordParseExpectedTokenTypeParseExpected2TokenTypesParseExpectedNumberParseExpectedRightParenBuiltinFunctionCallParseExpectedTypeNameParseExpectedWhenClauseParseUnsupportedTokenParseUnsupportedLiteralsGroupByParseExpectedMemberParseUnsupportedSelectParseUnsupportedCaseParseUnsupportedCaseClauseParseUnsupportedAliasParseUnsupportedSyntaxParseUnknownOperatorParseMissingIdentAfterAtParseUnexpectedOperatorParseUnexpectedTermParseUnexpectedTokenParseUnexpectedKeywordParseExpectedExpressionParseExpectedLeftParenAfterCastParseExpectedLeftParenValueConstructorParseExpectedLeftParenBuiltinFunctionCallParseExpectedArgumentDelimiterParseCastArityParseInvalidTypeParamParseEmptySelectParseSelectMissingFromParseExpectedIdentForGroupNameParseExpectedIdentForAliasParseUnsupportedCallWithStarParseNonUnaryAgregateFunctionCallParseMalformedJoinParseExpectedIdentForAtParseAsteriskIsNotAloneInSelectListParseCannotMixSqbAndWildcardInSelectListParseInvalidContextForWildcardInSelectListIncorrectSQLFunctionArgumentTypeValueParseFailureEvaluatorInvalidArgumentsIntegerOverflowLikeInvalidInputsCastFailedInvalidCastEvaluatorInvalidTimestampFormatPatternEvaluatorInvalidTimestampFormatPatternSymbolForParsingEvaluatorTimestampFormatPatternDuplicateFieldsEvaluatorTimestampFormatPatternHourClockAmPmMismatchEvaluatorUnterminatedTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternSymbolEvaluatorBindingDoesNotExistMissingHeadersInvalidColumnIndexAdminConfigNotificationTargetsFailedAdminProfilerNotEnabledInvalidDecompressedSizeAddUserInvalidArgumentAdminResourceInvalidArgumentAdminAccountNotEligibleAccountNotEligibleAdminServiceAccountNotFoundPostPolicyConditionInvalidFormatInvalidChecksumLambdaARNInvalidLambdaARNNotFoundapiErrCodeEnd"
 
-var _APIErrorCode_index = [...]uint16{0, 4, 16, 25, 39, 53, 67, 81, 94, 112, 129, 146, 159, 171, 193, 213, 239, 253, 274, 291, 306, 329, 346, 364, 381, 405, 420, 441, 459, 471, 491, 508, 531, 552, 564, 582, 603, 631, 661, 682, 705, 731, 768, 798, 831, 856, 888, 918, 947, 972, 994, 1020, 1042, 1070, 1099, 1133, 1164, 1201, 1225, 1249, 1277, 1307, 1316, 1328, 1344, 1357, 1371, 1389, 1409, 1430, 1446, 1457, 1473, 1501, 1521, 1537, 1565, 1579, 1596, 1616, 1629, 1643, 1656, 1669, 1685, 1702, 1723, 1737, 1758, 1771, 1793, 1816, 1832, 1847, 1862, 1883, 1901, 1916, 1933, 1958, 1976, 1999, 2014, 2033, 2049, 2068, 2082, 2090, 2109, 2119, 2134, 2170, 2201, 2234, 2263, 2275, 2295, 2319, 2343, 2364, 2388, 2407, 2428, 2445, 2455, 2478, 2500, 2526, 2547, 2565, 2592, 2623, 2650, 2671, 2692, 2716, 2741, 2769, 2797, 2813, 2836, 2866, 2877, 2889, 2906, 2921, 2939, 2968, 2985, 3001, 3017, 3035, 3053, 3076, 3097, 3120, 3131, 3147, 3170, 3187, 3215, 3234, 3264, 3284, 3301, 3319, 3336, 3350, 3385, 3404, 3415, 3428, 3443, 3466, 3482, 3500, 3518, 3532, 3549, 3580, 3600, 3621, 3642, 3661, 3680, 3698, 3721, 3745, 3769, 3794, 3829, 3854, 3888, 3921, 3945, 3966, 3980, 4009, 4032, 4059, 4093, 4125, 4155, 4178, 4206, 4234, 4258, 4282, 4311, 4329, 4346, 4368, 4385, 4403, 4423, 4449, 4465, 4484, 4505, 4509, 4527, 4544, 4570, 4584, 4608, 4629, 4644, 4662, 4685, 4700, 4719, 4736, 4753, 4777, 4804, 4827, 4850, 4867, 4889, 4905, 4925, 4944, 4966, 4987, 5007, 5029, 5053, 5072, 5114, 5135, 5158, 5179, 5210, 5229, 5251, 5271, 5297, 5318, 5340, 5360, 5384, 5407, 5426, 5446, 5468, 5491, 5522, 5560, 5601, 5631, 5645, 5666, 5682, 5704, 5734, 5760, 5788, 5821, 5839, 5862, 5897, 5937, 5979, 6011, 6028, 6053, 6068, 6085, 6095, 6106, 6144, 6198, 6244, 6296, 6344, 6387, 6431, 6459, 6473, 6491, 6527, 6550, 6573, 6595, 6623, 6646, 6664, 6691, 6723, 6738, 6754, 6771, 6784}
+var _APIErrorCode_index = [...]uint16{0, 4, 16, 25, 39, 53, 67, 81, 94, 112, 129, 146, 159, 171, 193, 213, 239, 253, 274, 291, 306, 329, 346, 364, 381, 405, 420, 441, 459, 471, 491, 508, 531, 552, 564, 582, 603, 631, 661, 682, 705, 731, 768, 798, 831, 856, 888, 918, 947, 972, 994, 1020, 1042, 1070, 1099, 1133, 1164, 1201, 1225, 1249, 1277, 1307, 1316, 1328, 1344, 1357, 1371, 1389, 1409, 1430, 1446, 1457, 1473, 1501, 1521, 1537, 1565, 1579, 1596, 1616, 1629, 1643, 1656, 1669, 1685, 1702, 1723, 1737, 1758, 1771, 1793, 1816, 1832, 1847, 1862, 1883, 1901, 1916, 1933, 1958, 1976, 1999, 2014, 2033, 2051, 2067, 2086, 2100, 2108, 2127, 2137, 2152, 2188, 2219, 2252, 2281, 2293, 2313, 2337, 2361, 2382, 2406, 2422, 2455, 2486, 2516, 2540, 2573, 2600, 2624, 2643, 2664, 2681, 2691, 2714, 2736, 2762, 2783, 2801, 2828, 2859, 2879, 2906, 2927, 2948, 2972, 2997, 3025, 3053, 3069, 3092, 3122, 3133, 3145, 3162, 3177, 3195, 3224, 3241, 3257, 3273, 3291, 3309, 3332, 3353, 3376, 3387, 3403, 3426, 3443, 3471, 3490, 3520, 3540, 3557, 3575, 3592, 3606, 3641, 3660, 3671, 3684, 3699, 3722, 3738, 3756, 3774, 3788, 3805, 3836, 3856, 3877, 3898, 3917, 3936, 3954, 3977, 4001, 4025, 4050, 4085, 4110, 4144, 4177, 4201, 4222, 4236, 4265, 4288, 4315, 4349, 4381, 4411, 4434, 4462, 4490, 4514, 4538, 4567, 4585, 4602, 4624, 4641, 4659, 4679, 4705, 4721, 4740, 4761, 4765, 4783, 4800, 4826, 4840, 4864, 4885, 4900, 4918, 4941, 4956, 4975, 4992, 5009, 5033, 5060, 5083, 5106, 5123, 5145, 5161, 5181, 5200, 5222, 5243, 5263, 5285, 5309, 5328, 5370, 5391, 5414, 5435, 5466, 5485, 5507, 5527, 5553, 5574, 5596, 5616, 5640, 5663, 5682, 5702, 5724, 5747, 5778, 5816, 5857, 5887, 5901, 5922, 5938, 5960, 5990, 6016, 6044, 6077, 6095, 6118, 6153, 6193, 6235, 6267, 6284, 6309, 6324, 6341, 6351, 6362, 6400, 6454, 6500, 6552, 6600, 6643, 6687, 6715, 6729, 6747, 6783, 6806, 6829, 6851, 6879, 6902, 6920, 6947, 6979, 6994, 7010, 7027, 7040}
 
 func (i APIErrorCode) String() string {
 	if i < 0 || i >= APIErrorCode(len(_APIErrorCode_index)-1) {
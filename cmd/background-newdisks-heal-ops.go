@@ -226,13 +226,14 @@ func (h *healingTracker) save(ctx context.Context) error {
 
 // delete the tracker on disk.
 func (h *healingTracker) delete(ctx context.Context) error {
-	return h.disk.Delete(ctx, minioMetaBucket,
+	_, err := h.disk.Delete(ctx, minioMetaBucket,
 		pathJoin(bucketMetaPrefix, healingTrackerFilename),
 		DeleteOptions{
 			Recursive: false,
 			Force:     false,
 		},
 	)
+	return err
 }
 
 func (h *healingTracker) isHealed(bucket string) bool {
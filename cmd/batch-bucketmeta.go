@@ -0,0 +1,520 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/klauspost/compress/zip"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+	"github.com/minio/minio/internal/bucket/versioning"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/bucket/policy"
+)
+
+// bucketmeta:
+//   apiVersion: v1
+//   mode: export # or 'import'
+//   buckets: # buckets whose configuration is exported, empty selects all buckets
+//     - images
+//     - videos
+//   bucket: snapshots # bucket holding the bundle object
+//   object: "dr/bucket-config.zip" # bundle object, written on export and read on import
+// # optional flags
+// flags:
+//   overwrite: false # import: replace configs that differ instead of reporting a conflict
+//   notify:
+//     endpoint: "https://notify.endpoint" # notification endpoint to receive job status events
+//     token: "Bearer xxxxx" # optional authentication token for the notification endpoint
+
+//go:generate msgp -file $GOFILE -unexported
+
+// BatchBucketMetaMode selects the direction of a bucket metadata job, the
+// bundle object is written in export mode and replayed in import mode.
+type BatchBucketMetaMode string
+
+const (
+	batchBucketMetaExport BatchBucketMetaMode = "export"
+	batchBucketMetaImport BatchBucketMetaMode = "import"
+)
+
+// Validate returns an error for unknown modes.
+func (m BatchBucketMetaMode) Validate() error {
+	switch m {
+	case batchBucketMetaExport, batchBucketMetaImport:
+		return nil
+	}
+	return errInvalidArgument
+}
+
+// BatchBucketMetaNotification success or failure notification endpoint for each job attempts
+type BatchBucketMetaNotification struct {
+	Endpoint string `yaml:"endpoint" json:"endpoint"`
+	Token    string `yaml:"token" json:"token"`
+}
+
+// BatchJobBucketMetaFlags various configurations for bucket metadata job definition
+type BatchJobBucketMetaFlags struct {
+	// Overwrite replaces configs that already exist and differ from the
+	// bundle during import, instead of reporting them as conflicts.
+	Overwrite bool                        `yaml:"overwrite" json:"overwrite"`
+	Notify    BatchBucketMetaNotification `yaml:"notify" json:"notify"`
+}
+
+// BatchJobBucketMetaV1 v1 of batch bucket metadata export/import job
+type BatchJobBucketMetaV1 struct {
+	APIVersion string                  `yaml:"apiVersion" json:"apiVersion"`
+	Mode       BatchBucketMetaMode     `yaml:"mode" json:"mode"`
+	Buckets    []string                `yaml:"buckets" json:"buckets"`
+	Bucket     string                  `yaml:"bucket" json:"bucket"`
+	Object     string                  `yaml:"object" json:"object"`
+	Flags      BatchJobBucketMetaFlags `yaml:"flags" json:"flags"`
+}
+
+// batchBucketMetaConflict records a config that was present on the cluster
+// with different content than the bundle, these are reported through the
+// job notification and the job is marked failed instead of overwriting.
+//
+//msgp:ignore batchBucketMetaConflict
+type batchBucketMetaConflict struct {
+	Bucket string `json:"bucket"`
+	Config string `json:"config"`
+}
+
+func (c batchBucketMetaConflict) String() string {
+	return pathJoin(c.Bucket, c.Config)
+}
+
+//msgp:ignore batchBucketMetaJobError
+type batchBucketMetaJobError struct {
+	Code           string
+	Description    string
+	HTTPStatusCode int
+}
+
+func (e batchBucketMetaJobError) Error() string {
+	return e.Description
+}
+
+const (
+	batchBucketMetaName       = "batch-bucketmeta.bin"
+	batchBucketMetaFormat     = 1
+	batchBucketMetaVersionV1  = 1
+	batchBucketMetaVersion    = batchBucketMetaVersionV1
+	batchBucketMetaAPIVersion = "v1"
+)
+
+// batchJobTypeBucketMeta is served alongside the madmin defined job types,
+// madmin does not declare a constant for it yet.
+const batchJobTypeBucketMeta = madmin.BatchJobType("bucketmeta")
+
+// bucketMetaExportConfigs are the config files carried in the bundle, the
+// order is the order of application on import - object lock must precede
+// versioning since a lock-enabled bucket cannot suspend versioning.
+var bucketMetaExportConfigs = []string{
+	objectLockConfig,
+	bucketVersioningConfig,
+	bucketTaggingConfig,
+	bucketPolicyConfig,
+}
+
+// Notify notifies notification endpoint if configured regarding job failure or success.
+func (r BatchJobBucketMetaV1) Notify(ctx context.Context, body io.Reader) error {
+	if r.Flags.Notify.Endpoint == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Flags.Notify.Endpoint, body)
+	if err != nil {
+		return err
+	}
+
+	if r.Flags.Notify.Token != "" {
+		req.Header.Set("Authorization", r.Flags.Notify.Token)
+	}
+
+	clnt := http.Client{Transport: getRemoteInstanceTransport}
+	resp, err := clnt.Do(req)
+	if err != nil {
+		return err
+	}
+
+	xhttp.DrainBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// rawBucketConfig returns the stored raw bytes of cfgFile from bucket
+// metadata, empty when the config is not set on the bucket.
+func rawBucketConfig(meta BucketMetadata, cfgFile string) []byte {
+	switch cfgFile {
+	case objectLockConfig:
+		return meta.ObjectLockConfigXML
+	case bucketVersioningConfig:
+		return meta.VersioningConfigXML
+	case bucketTaggingConfig:
+		return meta.TaggingConfigXML
+	case bucketPolicyConfig:
+		return meta.PolicyConfigJSON
+	}
+	return nil
+}
+
+// export writes a zip bundle of the covered config files for the selected
+// buckets to the target object, entries are laid out as bucket/<config>
+// compatible with the admin bucket metadata import API.
+func (r *BatchJobBucketMetaV1) export(ctx context.Context, api ObjectLayer, ri *batchJobInfo, job BatchJobRequest) error {
+	buckets := r.Buckets
+	if len(buckets) == 0 {
+		bis, err := api.ListBuckets(ctx, BucketOptions{})
+		if err != nil {
+			return err
+		}
+		for _, bi := range bis {
+			buckets = append(buckets, bi.Name)
+		}
+	}
+
+	var bundle bytes.Buffer
+	zw := zip.NewWriter(&bundle)
+	for _, bucket := range buckets {
+		meta, _, err := globalBucketMetadataSys.GetConfig(ctx, bucket)
+		if err != nil {
+			return err
+		}
+		for _, cfgFile := range bucketMetaExportConfigs {
+			configData := rawBucketConfig(meta, cfgFile)
+			if len(configData) == 0 {
+				continue
+			}
+			cfgPath := pathJoin(bucket, cfgFile)
+			zwriter, err := zw.Create(cfgPath)
+			if err != nil {
+				return err
+			}
+			if _, err = zwriter.Write(configData); err != nil {
+				return err
+			}
+			ri.trackCurrentBucketObject(bucket, ObjectInfo{Name: cfgPath, Size: int64(len(configData))}, true)
+			globalBatchJobsMetrics.save(job.ID, ri)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	hr, err := hash.NewReader(bytes.NewReader(bundle.Bytes()), int64(bundle.Len()), "", "", int64(bundle.Len()))
+	if err != nil {
+		return err
+	}
+	_, err = api.PutObject(ctx, r.Bucket, r.Object, NewPutObjReader(hr), ObjectOptions{})
+	return err
+}
+
+// apply replays the bundle object through the same config update path the
+// Put*Config handlers use, configs already present with different content
+// are reported as conflicts and left untouched unless overwrite is set.
+func (r *BatchJobBucketMetaV1) apply(ctx context.Context, api ObjectLayer, ri *batchJobInfo, job BatchJobRequest) (conflicts []batchBucketMetaConflict, err error) {
+	gr, err := api.GetObjectNInfo(ctx, r.Bucket, r.Object, nil, http.Header{}, ObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(io.LimitReader(gr, gr.ObjInfo.Size))
+	gr.Close()
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Index bundle entries so configs are applied in the order of
+	// bucketMetaExportConfigs irrespective of their order in the zip.
+	entries := make(map[string]*zip.File, len(zr.File))
+	buckets := make(map[string]struct{})
+	for _, file := range zr.File {
+		bucket, cfgFile := path2BucketObject(file.Name)
+		if bucket == "" || cfgFile == "" {
+			return nil, fmt.Errorf("malformed bundle entry %s - expecting format bucket/<config>", file.Name)
+		}
+		entries[file.Name] = file
+		buckets[bucket] = struct{}{}
+	}
+
+	for bucket := range buckets {
+		for _, cfgFile := range bucketMetaExportConfigs {
+			file, ok := entries[pathJoin(bucket, cfgFile)]
+			if !ok {
+				continue
+			}
+			cfgPath := pathJoin(bucket, cfgFile)
+			conflict, err := r.applyConfig(ctx, api, bucket, cfgFile, file)
+			if conflict {
+				conflicts = append(conflicts, batchBucketMetaConflict{Bucket: bucket, Config: cfgFile})
+			}
+			success := err == nil && !conflict
+			if err != nil {
+				logger.LogIf(ctx, fmt.Errorf("bucketmeta import of %s failed: %w", cfgPath, err))
+			}
+			ri.trackCurrentBucketObject(bucket, ObjectInfo{Name: cfgPath, Size: int64(file.FileInfo().Size())}, success)
+			globalBatchJobsMetrics.save(job.ID, ri)
+		}
+	}
+	return conflicts, nil
+}
+
+// applyConfig validates and applies a single bundle entry, mirroring the
+// checks the corresponding Put*Config handler performs before updating
+// bucket metadata.
+func (r *BatchJobBucketMetaV1) applyConfig(ctx context.Context, api ObjectLayer, bucket, cfgFile string, file *zip.File) (conflict bool, err error) {
+	reader, err := file.Open()
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+
+	var configData []byte
+	var lockEnabled bool
+	switch cfgFile {
+	case objectLockConfig:
+		config, err := objectlock.ParseObjectLockConfig(reader)
+		if err != nil {
+			return false, err
+		}
+		lockEnabled = config.Enabled()
+		configData, err = xml.Marshal(config)
+		if err != nil {
+			return false, err
+		}
+	case bucketVersioningConfig:
+		v, err := versioning.ParseConfig(io.LimitReader(reader, maxBucketVersioningConfigSize))
+		if err != nil {
+			return false, err
+		}
+		if globalSiteReplicationSys.isEnabled() && v.Suspended() {
+			return false, errors.New("cluster replication is enabled for this site, so the versioning state cannot be suspended")
+		}
+		if rcfg, _ := globalBucketObjectLockSys.Get(bucket); rcfg.LockEnabled && v.Suspended() {
+			return false, errors.New("an Object Lock configuration is present on this bucket, so the versioning state cannot be suspended")
+		}
+		configData, err = xml.Marshal(v)
+		if err != nil {
+			return false, err
+		}
+	case bucketTaggingConfig:
+		tagCfg, err := tags.ParseBucketXML(io.LimitReader(reader, file.FileInfo().Size()))
+		if err != nil {
+			return false, err
+		}
+		configData, err = xml.Marshal(tagCfg)
+		if err != nil {
+			return false, err
+		}
+	case bucketPolicyConfig:
+		if file.FileInfo().Size() > maxBucketPolicySize {
+			return false, errors.New(errorCodes[ErrPolicyTooLarge].Description)
+		}
+		bucketPolicyBytes, err := io.ReadAll(io.LimitReader(reader, file.FileInfo().Size()))
+		if err != nil {
+			return false, err
+		}
+		bucketPolicy, err := policy.ParseConfig(bytes.NewReader(bucketPolicyBytes), bucket)
+		if err != nil {
+			return false, err
+		}
+		if bucketPolicy.Version == "" {
+			return false, errors.New(errorCodes[ErrPolicyInvalidVersion].Description)
+		}
+		configData, err = json.Marshal(bucketPolicy)
+		if err != nil {
+			return false, err
+		}
+	default:
+		return false, errInvalidArgument
+	}
+
+	// Create the bucket if it does not exist yet, object lock has to be
+	// requested at creation time for the lock config to apply.
+	if _, err := api.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		if !isErrBucketNotFound(err) {
+			return false, err
+		}
+		if err = api.MakeBucket(ctx, bucket, MakeBucketOptions{LockEnabled: lockEnabled}); err != nil {
+			if _, ok := err.(BucketExists); !ok {
+				return false, err
+			}
+		}
+	}
+
+	if cfgFile == objectLockConfig {
+		// Deny object locking configuration settings on existing buckets
+		// without object lock enabled, same as the admin import API.
+		if _, _, err = globalBucketMetadataSys.GetObjectLockConfig(bucket); err != nil {
+			return false, err
+		}
+	}
+
+	meta, _, err := globalBucketMetadataSys.GetConfig(ctx, bucket)
+	if err != nil {
+		return false, err
+	}
+	existing := rawBucketConfig(meta, cfgFile)
+	if len(existing) > 0 && !bytes.Equal(existing, configData) && !r.Flags.Overwrite {
+		return true, nil
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, cfgFile, configData)
+	if err != nil {
+		return false, err
+	}
+
+	// Call site replication hook for the configs it propagates.
+	//
+	// We encode the xml bytes as base64 to ensure there are no encoding
+	// errors.
+	cfgStr := base64.StdEncoding.EncodeToString(configData)
+	switch cfgFile {
+	case objectLockConfig:
+		err = globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+			Type:             madmin.SRBucketMetaTypeObjectLockConfig,
+			Bucket:           bucket,
+			ObjectLockConfig: &cfgStr,
+			UpdatedAt:        updatedAt,
+		})
+	case bucketTaggingConfig:
+		err = globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+			Type:      madmin.SRBucketMetaTypeTags,
+			Bucket:    bucket,
+			Tags:      &cfgStr,
+			UpdatedAt: updatedAt,
+		})
+	case bucketPolicyConfig:
+		err = globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+			Type:      madmin.SRBucketMetaTypePolicy,
+			Bucket:    bucket,
+			Policy:    configData,
+			UpdatedAt: updatedAt,
+		})
+	}
+	return false, err
+}
+
+// Start the batch bucket metadata job, the job runs in a single pass - there
+// is no resumption point beyond what batchJobInfo already persisted.
+func (r *BatchJobBucketMetaV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.load(ctx, api, job); err != nil {
+		return err
+	}
+	globalBatchJobsMetrics.save(job.ID, ri)
+
+	var conflicts []batchBucketMetaConflict
+	var err error
+	switch r.Mode {
+	case batchBucketMetaExport:
+		err = r.export(ctx, api, ri, job)
+	case batchBucketMetaImport:
+		conflicts, err = r.apply(ctx, api, ri, job)
+	default:
+		err = errInvalidArgument
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, conflict := range conflicts {
+		logger.LogIf(ctx, fmt.Errorf("bucketmeta import conflict: %s differs from the bundle, not overwritten", conflict))
+	}
+
+	ri.finalize()
+	globalBatchJobsMetrics.save(job.ID, ri)
+	// persist in-memory state to disk.
+	logger.LogIf(ctx, ri.updateAfter(ctx, api, 0, job))
+
+	buf, _ := json.Marshal(struct {
+		*batchJobInfo
+		Conflicts []batchBucketMetaConflict `json:"conflicts,omitempty"`
+	}{batchJobInfo: ri, Conflicts: conflicts})
+	if err := r.Notify(ctx, bytes.NewReader(buf)); err != nil {
+		logger.LogIf(ctx, fmt.Errorf("unable to notify %v", err))
+	}
+
+	return nil
+}
+
+// Validate validates the job definition input
+func (r *BatchJobBucketMetaV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.APIVersion != batchBucketMetaAPIVersion {
+		return errInvalidArgument
+	}
+
+	if err := r.Mode.Validate(); err != nil {
+		return err
+	}
+
+	if r.Bucket == "" || r.Object == "" {
+		return errInvalidArgument
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchBucketMetaJobError{
+				Code:           "NoSuchTargetBucket",
+				Description:    "The specified bundle bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	if r.Mode == batchBucketMetaImport && len(r.Buckets) > 0 {
+		// import applies whatever the bundle carries, a bucket list is
+		// only meaningful for export.
+		return errInvalidArgument
+	}
+
+	return nil
+}
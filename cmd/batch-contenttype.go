@@ -0,0 +1,373 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/env"
+	"github.com/minio/pkg/mimedb"
+	"github.com/minio/pkg/workers"
+	"golang.org/x/net/http/httpguts"
+)
+
+// contenttype:
+//   apiVersion: v1
+//   bucket: BUCKET
+//   prefix: PREFIX
+//   mapping: # optional extension overrides, take precedence over the built-in table
+//     - extension: ".log"
+//       contentType: "text/plain"
+// # optional flags
+// flags:
+//   dryRun: true # only report the objects whose content-type would change
+//   notify:
+//     endpoint: "https://notify.endpoint" # notification endpoint to receive job status events
+//     token: "Bearer xxxxx" # optional authentication token for the notification endpoint
+//   retry:
+//     attempts: 10 # number of retries for the job before giving up
+//     delay: "500ms" # least amount of delay between each retry
+
+//go:generate msgp -file $GOFILE -unexported
+
+// BatchContentTypeKV maps an object name extension to the content-type
+// objects carrying it should be stored with, overriding the built-in
+// extension table.
+type BatchContentTypeKV struct {
+	Extension   string `yaml:"extension" json:"extension"`
+	ContentType string `yaml:"contentType" json:"contentType"`
+}
+
+// Validate returns an error for empty extensions or content-types that
+// cannot be set as a header value.
+func (kv BatchContentTypeKV) Validate() error {
+	if strings.TrimPrefix(kv.Extension, ".") == "" {
+		return errInvalidArgument
+	}
+	if kv.ContentType == "" || !httpguts.ValidHeaderFieldValue(kv.ContentType) {
+		return errInvalidArgument
+	}
+	return nil
+}
+
+// BatchJobContentTypeFlags various configurations for content-type job
+// definition, reuses the batch-rotate notify/retry surface.
+type BatchJobContentTypeFlags struct {
+	// DryRun reports the objects whose content-type differs from the
+	// extension-derived value without rewriting any of them.
+	DryRun bool                       `yaml:"dryRun" json:"dryRun"`
+	Notify BatchKeyRotateNotification `yaml:"notify" json:"notify"`
+	Retry  BatchKeyRotateRetry        `yaml:"retry" json:"retry"`
+}
+
+// BatchJobContentTypeV1 v1 of batch content-type normalization job
+type BatchJobContentTypeV1 struct {
+	APIVersion string                   `yaml:"apiVersion" json:"apiVersion"`
+	Flags      BatchJobContentTypeFlags `yaml:"flags" json:"flags"`
+	Bucket     string                   `yaml:"bucket" json:"bucket"`
+	Prefix     string                   `yaml:"prefix" json:"prefix"`
+	Mapping    []BatchContentTypeKV     `yaml:"mapping" json:"mapping"`
+}
+
+//msgp:ignore batchContentTypeJobError
+type batchContentTypeJobError struct {
+	Code           string
+	Description    string
+	HTTPStatusCode int
+}
+
+func (e batchContentTypeJobError) Error() string {
+	return e.Description
+}
+
+const (
+	batchContentTypeName                 = "batch-contenttype.bin"
+	batchContentTypeFormat               = 1
+	batchContentTypeVersionV1            = 1
+	batchContentTypeVersion              = batchContentTypeVersionV1
+	batchContentTypeAPIVersion           = "v1"
+	batchContentTypeJobDefaultRetries    = 3
+	batchContentTypeJobDefaultRetryDelay = 250 * time.Millisecond
+)
+
+// batchJobTypeContentType is served alongside the madmin defined job types,
+// madmin does not declare a constant for it yet.
+const batchJobTypeContentType = madmin.BatchJobType("contenttype")
+
+// Notify notifies notification endpoint if configured regarding job failure or success.
+func (r BatchJobContentTypeV1) Notify(ctx context.Context, body io.Reader) error {
+	if r.Flags.Notify.Endpoint == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Flags.Notify.Endpoint, body)
+	if err != nil {
+		return err
+	}
+
+	if r.Flags.Notify.Token != "" {
+		req.Header.Set("Authorization", r.Flags.Notify.Token)
+	}
+
+	for k, v := range r.Flags.Notify.Headers {
+		req.Header.Set(k, v)
+	}
+
+	clnt := http.Client{Transport: getRemoteInstanceTransport}
+	resp, err := clnt.Do(req)
+	if err != nil {
+		return err
+	}
+
+	xhttp.DrainBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// targetContentType returns the content-type the object should carry based
+// on its name extension, the job mapping is consulted before the built-in
+// extension table. Empty is returned for objects without an extension or
+// with an extension neither table knows about - those are left untouched
+// rather than forced to a generic default.
+func (r *BatchJobContentTypeV1) targetContentType(object string) string {
+	ext := strings.ToLower(strings.TrimPrefix(path.Ext(object), "."))
+	if ext == "" {
+		return ""
+	}
+	for _, kv := range r.Mapping {
+		if strings.ToLower(strings.TrimPrefix(kv.Extension, ".")) == ext {
+			return kv.ContentType
+		}
+	}
+	if content, ok := mimedb.DB[ext]; ok {
+		return content.ContentType
+	}
+	return ""
+}
+
+// NormalizeContentType rewrites the content-type of an object version to
+// contentType via a metadata-only copy, all other metadata is preserved.
+func (r *BatchJobContentTypeV1) NormalizeContentType(ctx context.Context, api ObjectLayer, objInfo ObjectInfo, contentType string) error {
+	versioned := globalBucketVersioningSys.PrefixEnabled(r.Bucket, objInfo.Name)
+	versionSuspended := globalBucketVersioningSys.PrefixSuspended(r.Bucket, objInfo.Name)
+
+	lock := api.NewNSLock(r.Bucket, objInfo.Name)
+	lkctx, err := lock.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return err
+	}
+	ctx = lkctx.Context()
+	defer lock.Unlock(lkctx)
+
+	opts := ObjectOptions{
+		VersionID:        objInfo.VersionID,
+		Versioned:        versioned,
+		VersionSuspended: versionSuspended,
+		NoLock:           true,
+	}
+	obj, err := api.GetObjectInfo(ctx, r.Bucket, objInfo.Name, opts)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(obj.ContentType, contentType) {
+		// Another writer already fixed this version up.
+		return nil
+	}
+
+	oi := obj.Clone()
+	oi.metadataOnly = true // Perform only metadata updates.
+	metadata := cloneMSS(oi.UserDefined)
+	metadata["content-type"] = contentType
+	oi.UserDefined = metadata
+	_, err = api.CopyObject(ctx, r.Bucket, oi.Name, r.Bucket, oi.Name, oi, ObjectOptions{
+		VersionID: oi.VersionID,
+	}, ObjectOptions{
+		VersionID: oi.VersionID,
+		NoLock:    true,
+	})
+	return err
+}
+
+// Start the batch content-type job, resumes if there was a pending job via "job.ID"
+func (r *BatchJobContentTypeV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.load(ctx, api, job); err != nil {
+		return err
+	}
+
+	globalBatchJobsMetrics.save(job.ID, ri)
+	lastObject := ri.Object
+
+	delay := job.ContentType.Flags.Retry.Delay
+	if delay == 0 {
+		delay = batchContentTypeJobDefaultRetryDelay
+	}
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	workerSize, err := strconv.Atoi(env.Get("_MINIO_BATCH_CONTENTTYPE_WORKERS", strconv.Itoa(runtime.GOMAXPROCS(0)/2)))
+	if err != nil {
+		return err
+	}
+
+	wk, err := workers.New(workerSize)
+	if err != nil {
+		// invalid worker size.
+		return err
+	}
+
+	retryAttempts := ri.RetryAttempts
+	ctx, cancel := context.WithCancel(ctx)
+
+	results := make(chan ObjectInfo, 100)
+	if err := api.Walk(ctx, r.Bucket, r.Prefix, results, ObjectOptions{
+		WalkMarker: lastObject,
+	}); err != nil {
+		cancel()
+		// Do not need to retry if we can't list objects on source.
+		return err
+	}
+
+	for result := range results {
+		result := result
+		if result.DeleteMarker || !result.VersionPurgeStatus.Empty() {
+			continue
+		}
+		contentType := r.targetContentType(result.Name)
+		if contentType == "" || strings.EqualFold(result.ContentType, contentType) {
+			// already labeled correctly, or nothing to derive the
+			// content-type from.
+			continue
+		}
+		if r.Flags.DryRun {
+			// Report the object as one that would change, without
+			// rewriting it.
+			ri.trackCurrentBucketObject(r.Bucket, result, true)
+			globalBatchJobsMetrics.save(job.ID, ri)
+			logger.LogIf(ctx, ri.updateAfter(ctx, api, 10*time.Second, job))
+			continue
+		}
+		wk.Take()
+		go func() {
+			defer wk.Give()
+			for attempts := 1; attempts <= retryAttempts; attempts++ {
+				attempts := attempts
+				success := true
+				if err := r.NormalizeContentType(ctx, api, result, contentType); err != nil {
+					logger.LogIf(ctx, err)
+					success = false
+				}
+				ri.trackCurrentBucketObject(r.Bucket, result, success)
+				ri.RetryAttempts = attempts
+				globalBatchJobsMetrics.save(job.ID, ri)
+				// persist in-memory state to disk after every 10secs.
+				logger.LogIf(ctx, ri.updateAfter(ctx, api, 10*time.Second, job))
+				if success {
+					break
+				}
+			}
+		}()
+	}
+	wk.Wait()
+
+	ri.finalize()
+	globalBatchJobsMetrics.save(job.ID, ri)
+	// persist in-memory state to disk.
+	logger.LogIf(ctx, ri.updateAfter(ctx, api, 0, job))
+
+	buf, _ := json.Marshal(struct {
+		*batchJobInfo
+		DryRun bool `json:"dryRun,omitempty"`
+	}{batchJobInfo: ri, DryRun: r.Flags.DryRun})
+	if err := r.Notify(ctx, bytes.NewReader(buf)); err != nil {
+		logger.LogIf(ctx, fmt.Errorf("unable to notify %v", err))
+	}
+
+	cancel()
+	if ri.Failed {
+		ri.ObjectsFailed = 0
+		ri.Bucket = ""
+		ri.Object = ""
+		ri.Objects = 0
+		time.Sleep(delay + time.Duration(rnd.Float64()*float64(delay)))
+	}
+
+	return nil
+}
+
+// Validate validates the job definition input
+func (r *BatchJobContentTypeV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.APIVersion != batchContentTypeAPIVersion {
+		return errInvalidArgument
+	}
+
+	if r.Bucket == "" {
+		return errInvalidArgument
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchContentTypeJobError{
+				Code:           "NoSuchSourceBucket",
+				Description:    "The specified source bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	for _, kv := range r.Mapping {
+		if err := kv.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := r.Flags.Notify.Validate(); err != nil {
+		return err
+	}
+
+	return r.Flags.Retry.Validate()
+}
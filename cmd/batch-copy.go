@@ -0,0 +1,593 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	crand "crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/hash"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/kms"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/env"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/minio/pkg/wildcard"
+	"github.com/minio/pkg/workers"
+)
+
+// copy:
+//   apiVersion: v1
+//   bucket: BUCKET # source bucket
+//   prefix: PREFIX # source prefix, stripped from every copied key
+//   targetBucket: BUCKET # destination bucket, may equal the source bucket
+//   targetPrefix: PREFIX # destination prefix, prepended to every copied key
+//   dryRun: false # walk and report what would be copied without writing
+//   deleteSource: false # delete the source object after a successful copy
+// # only the latest version of each object is copied, delete markers are
+// # skipped. Metadata, tags and encryption are preserved - SSE-S3/SSE-KMS
+// # object keys are re-sealed for the destination path.
+// # optional flags based filtering criteria, the filter surface is shared
+// # with the keyrotate job - kmskey is not applicable here.
+// flags:
+//   filter:
+//     newerThan: "7d" # match objects newer than this value (e.g. 7d10h31s)
+//     olderThan: "7d" # match objects older than this value (e.g. 7d10h31s)
+//     createdAfter: "date" # match objects created after "date"
+//     createdBefore: "date" # match objects created before "date"
+//     tags:
+//       - key: "name"
+//         value: "pick*" # match objects with tag 'name', with all values starting with 'pick'
+//     metadata:
+//       - key: "content-type"
+//         value: "image/*" # match objects with 'content-type', with all values starting with 'image/'
+//     excludePrefixes:
+//       - "logs/" # do not touch objects under these prefixes
+//     excludeNames:
+//       - "*.tmp" # do not touch objects whose names match these wildcard patterns
+//   notify:
+//     endpoint: "https://notify.endpoint" # notification endpoint to receive job status events
+//     token: "Bearer xxxxx" # optional authentication token for the notification endpoint
+//   retry:
+//     attempts: 10 # number of retries for the job before giving up
+//     delay: "500ms" # least amount of delay between each retry
+
+//go:generate msgp -file $GOFILE -unexported
+
+// BatchJobCopyFlags various configurations for the copy job definition,
+// reuses the batch-rotate filter/notify/retry surface.
+type BatchJobCopyFlags struct {
+	Filter BatchKeyRotateFilter       `yaml:"filter" json:"filter"`
+	Notify BatchKeyRotateNotification `yaml:"notify" json:"notify"`
+	Retry  BatchKeyRotateRetry        `yaml:"retry" json:"retry"`
+}
+
+// BatchJobCopyV1 v1 of batch copy job
+type BatchJobCopyV1 struct {
+	APIVersion   string            `yaml:"apiVersion" json:"apiVersion"`
+	Flags        BatchJobCopyFlags `yaml:"flags" json:"flags"`
+	Bucket       string            `yaml:"bucket" json:"bucket"`
+	Prefix       string            `yaml:"prefix" json:"prefix"`
+	TargetBucket string            `yaml:"targetBucket" json:"targetBucket"`
+	TargetPrefix string            `yaml:"targetPrefix" json:"targetPrefix"`
+	DryRun       bool              `yaml:"dryRun" json:"dryRun"`
+	DeleteSource bool              `yaml:"deleteSource" json:"deleteSource"`
+}
+
+//msgp:ignore batchCopyJobError
+type batchCopyJobError struct {
+	Code           string
+	Description    string
+	HTTPStatusCode int
+}
+
+func (e batchCopyJobError) Error() string {
+	return e.Description
+}
+
+const (
+	batchCopyName                 = "batch-copy.bin"
+	batchCopyFormat               = 1
+	batchCopyVersionV1            = 1
+	batchCopyVersion              = batchCopyVersionV1
+	batchCopyAPIVersion           = "v1"
+	batchCopyJobDefaultRetries    = 3
+	batchCopyJobDefaultRetryDelay = 250 * time.Millisecond
+)
+
+// batchJobTypeCopy is served alongside the madmin defined job types,
+// madmin does not declare a constant for it yet.
+const batchJobTypeCopy = madmin.BatchJobType("copy")
+
+// Notify notifies notification endpoint if configured regarding job failure or success.
+func (r BatchJobCopyV1) Notify(ctx context.Context, body io.Reader) error {
+	if r.Flags.Notify.Endpoint == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Flags.Notify.Endpoint, body)
+	if err != nil {
+		return err
+	}
+
+	if r.Flags.Notify.Token != "" {
+		req.Header.Set("Authorization", r.Flags.Notify.Token)
+	}
+
+	for k, v := range r.Flags.Notify.Headers {
+		req.Header.Set(k, v)
+	}
+
+	clnt := http.Client{Transport: getRemoteInstanceTransport}
+	resp, err := clnt.Do(req)
+	if err != nil {
+		return err
+	}
+
+	xhttp.DrainBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// targetObject maps a source object name to its destination name by
+// swapping the source prefix for the target prefix.
+func (r *BatchJobCopyV1) targetObject(srcObject string) string {
+	return r.TargetPrefix + strings.TrimPrefix(srcObject, r.Prefix)
+}
+
+// allowed re-evaluates GetObject on the source and PutObject on the
+// destination for the job user against concrete object names, the
+// prefix-wide check at job submission cannot account for policies
+// conditioned on individual object names.
+func (r *BatchJobCopyV1) allowed(user, srcObject, dstObject string) bool {
+	if user == globalActiveCred.AccessKey {
+		return true
+	}
+	if !globalIAMSys.IsAllowed(iampolicy.Args{
+		AccountName:     user,
+		Action:          iampolicy.GetObjectAction,
+		BucketName:      r.Bucket,
+		ObjectName:      srcObject,
+		ConditionValues: map[string][]string{},
+	}) {
+		return false
+	}
+	return globalIAMSys.IsAllowed(iampolicy.Args{
+		AccountName:     user,
+		Action:          iampolicy.PutObjectAction,
+		BucketName:      r.TargetBucket,
+		ObjectName:      dstObject,
+		ConditionValues: map[string][]string{},
+	})
+}
+
+// resealObjectKey re-seals the object encryption key of an SSE-S3 or
+// SSE-KMS encrypted object for the destination path, sealed keys are
+// bound to the bucket/object pair they were created for. SSE-C objects
+// cannot be re-sealed without the client supplied key.
+func resealObjectKey(ctx context.Context, metadata map[string]string, srcBucket, srcObject, dstBucket, dstObject string) error {
+	kind, _ := crypto.IsEncrypted(metadata)
+	switch kind {
+	case crypto.S3:
+		if GlobalKMS == nil {
+			return errKMSNotConfigured
+		}
+		objectKey, err := crypto.S3.UnsealObjectKey(GlobalKMS, metadata, srcBucket, srcObject)
+		if err != nil {
+			return err
+		}
+		newKey, err := GlobalKMS.GenerateKey(ctx, "", kms.Context{dstBucket: path.Join(dstBucket, dstObject)})
+		if err != nil {
+			return err
+		}
+		sealedKey := objectKey.Seal(newKey.Plaintext, crypto.GenerateIV(crand.Reader), crypto.S3.String(), dstBucket, dstObject)
+		crypto.S3.CreateMetadata(metadata, newKey.KeyID, newKey.Ciphertext, sealedKey)
+		return nil
+	case crypto.S3KMS:
+		if GlobalKMS == nil {
+			return errKMSNotConfigured
+		}
+		objectKey, err := crypto.S3KMS.UnsealObjectKey(GlobalKMS, metadata, srcBucket, srcObject)
+		if err != nil {
+			return err
+		}
+		keyID, _, _, cryptoCtx, err := crypto.S3KMS.ParseMetadata(metadata)
+		if err != nil {
+			return err
+		}
+		// Drop the source path entry from the stored context and
+		// derive the generation context for the destination path,
+		// mirroring how the context is assembled on PutObject.
+		cleanCtx := kms.Context{}
+		for k, v := range cryptoCtx {
+			if k == srcBucket && v == path.Join(srcBucket, srcObject) {
+				continue
+			}
+			cleanCtx[k] = v
+		}
+		kmsCtx := kms.Context{}
+		for k, v := range cleanCtx {
+			kmsCtx[k] = v
+		}
+		if _, ok := kmsCtx[dstBucket]; !ok {
+			kmsCtx[dstBucket] = path.Join(dstBucket, dstObject)
+		}
+		newKey, err := GlobalKMS.GenerateKey(ctx, keyID, kmsCtx)
+		if err != nil {
+			return err
+		}
+		sealedKey := objectKey.Seal(newKey.Plaintext, crypto.GenerateIV(crand.Reader), crypto.S3KMS.String(), dstBucket, dstObject)
+		crypto.S3KMS.CreateMetadata(metadata, newKey.KeyID, newKey.Ciphertext, sealedKey, cleanCtx)
+		return nil
+	case crypto.SSEC:
+		return errInvalidEncryptionParameters
+	}
+	return nil
+}
+
+// CopyTo copies the latest version of an object to the destination
+// bucket/prefix, preserving metadata, tags and encryption. With DryRun
+// set the object is only accounted for, with DeleteSource set the
+// source object is deleted after a successful copy.
+func (r *BatchJobCopyV1) CopyTo(ctx context.Context, api ObjectLayer, objInfo ObjectInfo, user string) error {
+	if objInfo.DeleteMarker || !objInfo.VersionPurgeStatus.Empty() || !objInfo.IsLatest {
+		return nil
+	}
+
+	dstObject := r.targetObject(objInfo.Name)
+	if !r.allowed(user, objInfo.Name, dstObject) {
+		return PrefixAccessDenied{Bucket: r.Bucket, Object: objInfo.Name}
+	}
+
+	if r.DryRun {
+		// Account the object as copied without writing anything.
+		return nil
+	}
+
+	gr, err := api.GetObjectNInfo(ctx, r.Bucket, objInfo.Name, nil, http.Header{}, ObjectOptions{
+		VersionID: objInfo.VersionID,
+	})
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+	oi := gr.ObjInfo
+
+	metadata := cloneMSS(oi.UserDefined)
+	length := oi.Size
+	if oi.IsCompressed() {
+		// The stream is served decompressed, the destination applies
+		// its own compression on write.
+		if length, err = oi.GetActualSize(); err != nil {
+			return err
+		}
+		delete(metadata, ReservedMetadataPrefix+"compression")
+		delete(metadata, ReservedMetadataPrefix+"actual-size")
+	}
+
+	if _, ok := crypto.IsEncrypted(metadata); ok {
+		if err = resealObjectKey(ctx, metadata, r.Bucket, oi.Name, r.TargetBucket, dstObject); err != nil {
+			return err
+		}
+	}
+
+	hr, err := hash.NewReader(gr, length, "", "", length)
+	if err != nil {
+		return err
+	}
+	if _, err = api.PutObject(ctx, r.TargetBucket, dstObject, NewPutObjReader(hr), ObjectOptions{
+		UserDefined: metadata,
+		MTime:       oi.ModTime,
+	}); err != nil {
+		return err
+	}
+
+	if r.DeleteSource {
+		// Delete by name, versioned source buckets keep their history
+		// behind a delete marker instead of losing the version.
+		_, err = api.DeleteObject(ctx, r.Bucket, oi.Name, ObjectOptions{
+			Versioned:        globalBucketVersioningSys.PrefixEnabled(r.Bucket, oi.Name),
+			VersionSuspended: globalBucketVersioningSys.PrefixSuspended(r.Bucket, oi.Name),
+		})
+		return err
+	}
+	return nil
+}
+
+// Start the batch copy job, resumes if there was a pending job via "job.ID"
+func (r *BatchJobCopyV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.load(ctx, api, job); err != nil {
+		return err
+	}
+
+	globalBatchJobsMetrics.save(job.ID, ri)
+	lastObject := ri.Object
+
+	delay := job.Copy.Flags.Retry.Delay
+	if delay == 0 {
+		delay = batchCopyJobDefaultRetryDelay
+	}
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	skip := func(info FileInfo) (ok bool) {
+		for _, prefix := range r.Flags.Filter.ExcludePrefixes {
+			if strings.HasPrefix(info.Name, prefix) {
+				// skip all objects under the excluded prefixes
+				return false
+			}
+		}
+
+		for _, pattern := range r.Flags.Filter.ExcludeNames {
+			if wildcard.Match(pattern, info.Name) {
+				// skip all objects matching the excluded name patterns
+				return false
+			}
+		}
+
+		if r.Flags.Filter.OlderThan > 0 && time.Since(info.ModTime) < r.Flags.Filter.OlderThan {
+			// skip all objects that are newer than specified older duration
+			return false
+		}
+
+		if r.Flags.Filter.NewerThan > 0 && time.Since(info.ModTime) >= r.Flags.Filter.NewerThan {
+			// skip all objects that are older than specified newer duration
+			return false
+		}
+
+		if !r.Flags.Filter.CreatedAfter.IsZero() && r.Flags.Filter.CreatedAfter.Before(info.ModTime) {
+			// skip all objects that are created before the specified time.
+			return false
+		}
+
+		if !r.Flags.Filter.CreatedBefore.IsZero() && r.Flags.Filter.CreatedBefore.After(info.ModTime) {
+			// skip all objects that are created after the specified time.
+			return false
+		}
+
+		if len(r.Flags.Filter.Tags) > 0 {
+			// Only parse object tags if tags filter is specified.
+			tagMap := map[string]string{}
+			tagStr := info.Metadata[xhttp.AmzObjectTagging]
+			if len(tagStr) != 0 {
+				t, err := tags.ParseObjectTags(tagStr)
+				if err != nil {
+					return false
+				}
+				tagMap = t.ToMap()
+			}
+
+			for _, kv := range r.Flags.Filter.Tags {
+				for t, v := range tagMap {
+					if kv.Match(BatchKeyRotateKV{Key: t, Value: v}) {
+						return true
+					}
+				}
+			}
+
+			// None of the provided tags filter match skip the object
+			return false
+		}
+
+		if len(r.Flags.Filter.Metadata) > 0 {
+			for _, kv := range r.Flags.Filter.Metadata {
+				for k, v := range info.Metadata {
+					if !strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") && !isStandardHeader(k) {
+						continue
+					}
+					// We only need to match x-amz-meta or standardHeaders
+					if kv.Match(BatchKeyRotateKV{Key: k, Value: v}) {
+						return true
+					}
+				}
+			}
+
+			// None of the provided metadata filters match skip the object.
+			return false
+		}
+		return true
+	}
+
+	workerSize, err := strconv.Atoi(env.Get("_MINIO_BATCH_COPY_WORKERS", strconv.Itoa(runtime.GOMAXPROCS(0)/2)))
+	if err != nil {
+		return err
+	}
+
+	wk, err := workers.New(workerSize)
+	if err != nil {
+		// invalid worker size.
+		return err
+	}
+
+	retryAttempts := ri.RetryAttempts
+	ctx, cancel := context.WithCancel(ctx)
+
+	// Push the simple tag/metadata checks down to the walk, entries that
+	// cannot match them are skipped without decoding all their versions.
+	var pdTags, pdMeta []WalkPushdownKV
+	for _, kv := range r.Flags.Filter.Tags {
+		pdTags = append(pdTags, WalkPushdownKV{Key: kv.Key, Value: kv.Value})
+	}
+	for _, kv := range r.Flags.Filter.Metadata {
+		pdMeta = append(pdMeta, WalkPushdownKV{Key: kv.Key, Value: kv.Value})
+	}
+
+	results := make(chan ObjectInfo, 100)
+	if err := api.Walk(ctx, r.Bucket, r.Prefix, results, ObjectOptions{
+		WalkMarker:          lastObject,
+		WalkFilter:          skip,
+		WalkExcludePrefixes: r.Flags.Filter.ExcludePrefixes,
+		WalkPushdown:        newWalkPushdownFilter(pdTags, pdMeta),
+	}); err != nil {
+		cancel()
+		// Do not need to retry if we can't list objects on source.
+		return err
+	}
+
+	for result := range results {
+		result := result
+		wk.Take()
+		go func() {
+			defer wk.Give()
+			for attempts := 1; attempts <= retryAttempts; attempts++ {
+				attempts := attempts
+				success := true
+				if err := r.CopyTo(ctx, api, result, job.User); err != nil {
+					logger.LogIf(ctx, err)
+					success = false
+				}
+				ri.trackCurrentBucketObject(r.Bucket, result, success)
+				ri.RetryAttempts = attempts
+				globalBatchJobsMetrics.save(job.ID, ri)
+				// persist in-memory state to disk after every 10secs.
+				logger.LogIf(ctx, ri.updateAfter(ctx, api, 10*time.Second, job))
+				if success {
+					break
+				}
+			}
+		}()
+	}
+	wk.Wait()
+
+	ri.finalize()
+	globalBatchJobsMetrics.save(job.ID, ri)
+	// persist in-memory state to disk.
+	logger.LogIf(ctx, ri.updateAfter(ctx, api, 0, job))
+
+	buf, _ := json.Marshal(ri)
+	if err := r.Notify(ctx, bytes.NewReader(buf)); err != nil {
+		logger.LogIf(ctx, fmt.Errorf("unable to notify %v", err))
+	}
+
+	cancel()
+	if ri.Failed {
+		ri.ObjectsFailed = 0
+		ri.Bucket = ""
+		ri.Object = ""
+		ri.Objects = 0
+		time.Sleep(delay + time.Duration(rnd.Float64()*float64(delay)))
+	}
+
+	return nil
+}
+
+// Validate validates the job definition input
+func (r *BatchJobCopyV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.APIVersion != batchCopyAPIVersion {
+		return errInvalidArgument
+	}
+
+	if r.Bucket == "" || r.TargetBucket == "" {
+		return errInvalidArgument
+	}
+
+	if r.Bucket == r.TargetBucket && r.Prefix == r.TargetPrefix {
+		// Every object would be copied onto itself.
+		return errInvalidArgument
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchCopyJobError{
+				Code:           "NoSuchSourceBucket",
+				Description:    "The specified source bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.TargetBucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchCopyJobError{
+				Code:           "NoSuchTargetBucket",
+				Description:    "The specified target bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	if r.Flags.Filter.KMSKeyID != "" {
+		// kmskey belongs to the keyrotate filter surface and is not
+		// applicable to copying.
+		return errInvalidArgument
+	}
+
+	for _, tag := range r.Flags.Filter.Tags {
+		if err := tag.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, meta := range r.Flags.Filter.Metadata {
+		if err := meta.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, pattern := range r.Flags.Filter.ExcludeNames {
+		// reject patterns that exclude every object.
+		if pattern == "" || pattern == "*" || pattern == "**" {
+			return errInvalidArgument
+		}
+	}
+
+	for _, prefix := range r.Flags.Filter.ExcludePrefixes {
+		// reject excludes that cover the whole include prefix, the
+		// job would touch nothing.
+		if prefix == "" || strings.HasPrefix(r.Prefix, prefix) {
+			return errInvalidArgument
+		}
+	}
+
+	if err := r.Flags.Notify.Validate(); err != nil {
+		return err
+	}
+
+	return r.Flags.Retry.Validate()
+}
@@ -269,13 +269,26 @@ func (r BatchJobReplicateV1) RemoteToLocal() bool {
 
 // BatchJobRequest this is an internal data structure not for external consumption.
 type BatchJobRequest struct {
-	ID        string               `yaml:"-" json:"name"`
-	User      string               `yaml:"-" json:"user"`
-	Started   time.Time            `yaml:"-" json:"started"`
-	Location  string               `yaml:"-" json:"location"`
-	Replicate *BatchJobReplicateV1 `yaml:"replicate" json:"replicate"`
-	KeyRotate *BatchJobKeyRotateV1 `yaml:"keyrotate" json:"keyrotate"`
-	ctx       context.Context      `msg:"-"`
+	ID          string                 `yaml:"-" json:"name"`
+	User        string                 `yaml:"-" json:"user"`
+	Started     time.Time              `yaml:"-" json:"started"`
+	Location    string                 `yaml:"-" json:"location"`
+	Replicate   *BatchJobReplicateV1   `yaml:"replicate" json:"replicate"`
+	KeyRotate   *BatchJobKeyRotateV1   `yaml:"keyrotate" json:"keyrotate"`
+	BucketMeta  *BatchJobBucketMetaV1  `yaml:"bucketmeta" json:"bucketmeta"`
+	Restore     *BatchJobRestoreV1     `yaml:"restore" json:"restore"`
+	ContentType *BatchJobContentTypeV1 `yaml:"contenttype" json:"contenttype"`
+	LegalHold   *BatchJobLegalHoldV1   `yaml:"legalhold" json:"legalhold"`
+	Tag         *BatchJobTagV1         `yaml:"tag" json:"tag"`
+	Copy        *BatchJobCopyV1        `yaml:"copy" json:"copy"`
+
+	// Schedule is an optional cron expression, scheduled jobs are
+	// retained and re-run at each trigger instead of running once.
+	Schedule string    `yaml:"schedule" json:"schedule"`
+	Paused   bool      `yaml:"-" json:"paused"`
+	LastRun  time.Time `yaml:"-" json:"lastRun"`
+
+	ctx context.Context `msg:"-"`
 }
 
 // Notify notifies notification endpoint if configured regarding job failure or success.
@@ -345,6 +358,7 @@ func (r *BatchJobReplicateV1) ReplicateFromSource(ctx context.Context, api Objec
 		MTime:            srcObjInfo.ModTime,
 		PreserveETag:     srcObjInfo.ETag,
 		UserDefined:      srcObjInfo.UserDefined,
+		InlineChecksums:  true,
 	}
 	if r.Target.Type == BatchJobReplicateResourceS3 || r.Source.Type == BatchJobReplicateResourceS3 {
 		opts.VersionID = ""
@@ -639,8 +653,7 @@ func (r *BatchJobReplicateV1) StartFromSource(ctx context.Context, api ObjectLay
 		wk.Wait()
 
 		ri.RetryAttempts = attempts
-		ri.Complete = ri.ObjectsFailed == 0
-		ri.Failed = ri.ObjectsFailed > 0
+		ri.finalize()
 
 		globalBatchJobsMetrics.save(job.ID, ri)
 		// persist in-memory state to disk.
@@ -794,6 +807,16 @@ func (r *BatchJobReplicateV1) ReplicateToTarget(ctx context.Context, api ObjectL
 //go:generate msgp -file $GOFILE -unexported
 
 // batchJobInfo current batch replication information
+// batchJobStatusNoMatches flags a run that completed without matching
+// a single object, usually a sign of a mistyped prefix or an overly
+// narrow filter. The run still counts as complete.
+const batchJobStatusNoMatches = "completed-no-matches"
+
+// batchJobStatusPausedKMS flags a run that is currently paused because
+// the KMS is unreachable. The job probes the KMS in the background and
+// resumes on its own once it answers again.
+const batchJobStatusPausedKMS = "paused-kms-unavailable"
+
 type batchJobInfo struct {
 	mu sync.RWMutex `json:"-" msg:"-"`
 
@@ -807,6 +830,10 @@ type batchJobInfo struct {
 	Complete bool `json:"complete" msg:"cmp"`
 	Failed   bool `json:"failed" msg:"fld"`
 
+	// Status qualifies a finished run beyond Complete/Failed, see the
+	// batchJobStatus* constants.
+	Status string `json:"status,omitempty" msg:"sta"`
+
 	// Last bucket/object batch replicated
 	Bucket string `json:"-" msg:"lbkt"`
 	Object string `json:"-" msg:"lobj"`
@@ -845,11 +872,38 @@ func (ri *batchJobInfo) load(ctx context.Context, api ObjectLayer, job BatchJobR
 		fileName = batchKeyRotationName
 		version = batchKeyRotateVersionV1
 		format = batchKeyRotationFormat
-
-	}
-	data, err := readConfig(ctx, api, pathJoin(job.Location, fileName))
+	case job.BucketMeta != nil:
+		fileName = batchBucketMetaName
+		version = batchBucketMetaVersionV1
+		format = batchBucketMetaFormat
+	case job.Restore != nil:
+		fileName = batchRestoreName
+		version = batchRestoreVersionV1
+		format = batchRestoreFormat
+	case job.ContentType != nil:
+		fileName = batchContentTypeName
+		version = batchContentTypeVersionV1
+		format = batchContentTypeFormat
+	case job.LegalHold != nil:
+		fileName = batchLegalHoldName
+		version = batchLegalHoldVersionV1
+		format = batchLegalHoldFormat
+	case job.Tag != nil:
+		fileName = batchTagName
+		version = batchTagVersionV1
+		format = batchTagFormat
+	case job.Copy != nil:
+		fileName = batchCopyName
+		version = batchCopyVersionV1
+		format = batchCopyFormat
+	}
+	// Only the fixed-size header is read up front, the remainder of the
+	// checkpoint is fetched once the header checks out.
+	checkpointFile := pathJoin(job.Location, fileName)
+	hdr, size, err := readConfigAt(ctx, api, checkpointFile, 0, 4)
 	if err != nil {
-		if errors.Is(err, errConfigNotFound) || isErrObjectNotFound(err) {
+		// io.EOF means an empty checkpoint, treated like a missing one.
+		if errors.Is(err, errConfigNotFound) || isErrObjectNotFound(err) || errors.Is(err, io.EOF) {
 			ri.Version = int(version)
 			switch {
 			case job.Replicate != nil:
@@ -862,35 +916,65 @@ func (ri *batchJobInfo) load(ctx context.Context, api ObjectLayer, job BatchJobR
 				if job.KeyRotate.Flags.Retry.Attempts > 0 {
 					ri.RetryAttempts = job.KeyRotate.Flags.Retry.Attempts
 				}
+			case job.BucketMeta != nil:
+				// bucket metadata jobs run in a single pass.
+				ri.RetryAttempts = 1
+			case job.Restore != nil:
+				ri.RetryAttempts = batchRestoreJobDefaultRetries
+				if job.Restore.Flags.Retry.Attempts > 0 {
+					ri.RetryAttempts = job.Restore.Flags.Retry.Attempts
+				}
+			case job.ContentType != nil:
+				ri.RetryAttempts = batchContentTypeJobDefaultRetries
+				if job.ContentType.Flags.Retry.Attempts > 0 {
+					ri.RetryAttempts = job.ContentType.Flags.Retry.Attempts
+				}
+			case job.LegalHold != nil:
+				ri.RetryAttempts = batchLegalHoldJobDefaultRetries
+				if job.LegalHold.Flags.Retry.Attempts > 0 {
+					ri.RetryAttempts = job.LegalHold.Flags.Retry.Attempts
+				}
+			case job.Tag != nil:
+				ri.RetryAttempts = batchTagJobDefaultRetries
+				if job.Tag.Flags.Retry.Attempts > 0 {
+					ri.RetryAttempts = job.Tag.Flags.Retry.Attempts
+				}
+			case job.Copy != nil:
+				ri.RetryAttempts = batchCopyJobDefaultRetries
+				if job.Copy.Flags.Retry.Attempts > 0 {
+					ri.RetryAttempts = job.Copy.Flags.Retry.Attempts
+				}
 			}
 			return nil
 		}
 		return err
 	}
-	if len(data) == 0 {
-		// Seems to be empty create a new batchRepl object.
-		return nil
-	}
-	if len(data) <= 4 {
+	if size <= 4 {
 		return fmt.Errorf("%s: no data", ri.JobType)
 	}
 	// Read header
-	switch binary.LittleEndian.Uint16(data[0:2]) {
+	switch binary.LittleEndian.Uint16(hdr[0:2]) {
 	case format:
 	default:
-		return fmt.Errorf("%s: unknown format: %d", ri.JobType, binary.LittleEndian.Uint16(data[0:2]))
+		return fmt.Errorf("%s: unknown format: %d", ri.JobType, binary.LittleEndian.Uint16(hdr[0:2]))
 	}
-	switch binary.LittleEndian.Uint16(data[2:4]) {
+	switch binary.LittleEndian.Uint16(hdr[2:4]) {
 	case version:
 	default:
-		return fmt.Errorf("%s: unknown version: %d", ri.JobType, binary.LittleEndian.Uint16(data[2:4]))
+		return fmt.Errorf("%s: unknown version: %d", ri.JobType, binary.LittleEndian.Uint16(hdr[2:4]))
+	}
+
+	// Header checks out, fetch the tail of the checkpoint.
+	data, _, err := readConfigAt(ctx, api, checkpointFile, 4, size-4)
+	if err != nil {
+		return err
 	}
 
 	ri.mu.Lock()
 	defer ri.mu.Unlock()
 
 	// OK, parse data.
-	if _, err = ri.UnmarshalMsg(data[4:]); err != nil {
+	if _, err = ri.UnmarshalMsg(data); err != nil {
 		return err
 	}
 
@@ -914,6 +998,7 @@ func (ri *batchJobInfo) clone() *batchJobInfo {
 		RetryAttempts:    ri.RetryAttempts,
 		Complete:         ri.Complete,
 		Failed:           ri.Failed,
+		Status:           ri.Status,
 		StartTime:        ri.StartTime,
 		LastUpdate:       ri.LastUpdate,
 		Bucket:           ri.Bucket,
@@ -925,6 +1010,21 @@ func (ri *batchJobInfo) clone() *batchJobInfo {
 	}
 }
 
+// finalize records the outcome of a finished run. A completion that
+// never matched an object is flagged with batchJobStatusNoMatches so
+// a filter matching nothing does not masquerade as a successful run.
+func (ri *batchJobInfo) finalize() {
+	if ri == nil {
+		return
+	}
+	ri.Complete = ri.ObjectsFailed == 0
+	ri.Failed = ri.ObjectsFailed > 0
+	ri.Status = ""
+	if ri.Complete && ri.Objects == 0 && ri.DeleteMarkers == 0 {
+		ri.Status = batchJobStatusNoMatches
+	}
+}
+
 func (ri *batchJobInfo) countItem(size int64, dmarker, success bool) {
 	if ri == nil {
 		return
@@ -971,6 +1071,42 @@ func (ri *batchJobInfo) updateAfter(ctx context.Context, api ObjectLayer, durati
 			jobTyp = string(job.Type())
 			fileName = batchKeyRotationName
 			ri.Version = batchKeyRotateVersionV1
+		case batchJobTypeBucketMeta:
+			format = batchBucketMetaFormat
+			version = batchBucketMetaVersion
+			jobTyp = string(job.Type())
+			fileName = batchBucketMetaName
+			ri.Version = batchBucketMetaVersionV1
+		case batchJobTypeRestore:
+			format = batchRestoreFormat
+			version = batchRestoreVersion
+			jobTyp = string(job.Type())
+			fileName = batchRestoreName
+			ri.Version = batchRestoreVersionV1
+		case batchJobTypeContentType:
+			format = batchContentTypeFormat
+			version = batchContentTypeVersion
+			jobTyp = string(job.Type())
+			fileName = batchContentTypeName
+			ri.Version = batchContentTypeVersionV1
+		case batchJobTypeLegalHold:
+			format = batchLegalHoldFormat
+			version = batchLegalHoldVersion
+			jobTyp = string(job.Type())
+			fileName = batchLegalHoldName
+			ri.Version = batchLegalHoldVersionV1
+		case batchJobTypeTag:
+			format = batchTagFormat
+			version = batchTagVersion
+			jobTyp = string(job.Type())
+			fileName = batchTagName
+			ri.Version = batchTagVersionV1
+		case batchJobTypeCopy:
+			format = batchCopyFormat
+			version = batchCopyVersion
+			jobTyp = string(job.Type())
+			fileName = batchCopyName
+			ri.Version = batchCopyVersionV1
 		default:
 			return errInvalidArgument
 		}
@@ -1135,10 +1271,22 @@ func (r *BatchJobReplicateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 		// one of source/target is s3, skip delete marker and all versions under the same object name.
 		s3Type := r.Target.Type == BatchJobReplicateResourceS3 || r.Source.Type == BatchJobReplicateResourceS3
 
+		// Push the simple tag/metadata checks down to the walk, entries
+		// that cannot match them are skipped without decoding all their
+		// versions.
+		var pdTags, pdMeta []WalkPushdownKV
+		for _, kv := range r.Flags.Filter.Tags {
+			pdTags = append(pdTags, WalkPushdownKV{Key: kv.Key, Value: kv.Value})
+		}
+		for _, kv := range r.Flags.Filter.Metadata {
+			pdMeta = append(pdMeta, WalkPushdownKV{Key: kv.Key, Value: kv.Value})
+		}
+
 		results := make(chan ObjectInfo, 100)
 		if err := api.Walk(ctx, r.Source.Bucket, r.Source.Prefix, results, ObjectOptions{
-			WalkMarker: lastObject,
-			WalkFilter: skip,
+			WalkMarker:   lastObject,
+			WalkFilter:   skip,
+			WalkPushdown: newWalkPushdownFilter(pdTags, pdMeta),
 		}); err != nil {
 			cancel()
 			// Do not need to retry if we can't list objects on source.
@@ -1187,8 +1335,7 @@ func (r *BatchJobReplicateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 		wk.Wait()
 
 		ri.RetryAttempts = attempts
-		ri.Complete = ri.ObjectsFailed == 0
-		ri.Failed = ri.ObjectsFailed > 0
+		ri.finalize()
 
 		globalBatchJobsMetrics.save(job.ID, ri)
 		// persist in-memory state to disk.
@@ -1384,6 +1531,18 @@ func (j BatchJobRequest) Type() madmin.BatchJobType {
 		return madmin.BatchJobReplicate
 	case j.KeyRotate != nil:
 		return madmin.BatchJobKeyRotate
+	case j.BucketMeta != nil:
+		return batchJobTypeBucketMeta
+	case j.Restore != nil:
+		return batchJobTypeRestore
+	case j.ContentType != nil:
+		return batchJobTypeContentType
+	case j.LegalHold != nil:
+		return batchJobTypeLegalHold
+	case j.Tag != nil:
+		return batchJobTypeTag
+	case j.Copy != nil:
+		return batchJobTypeCopy
 	}
 	return madmin.BatchJobType("unknown")
 }
@@ -1391,28 +1550,63 @@ func (j BatchJobRequest) Type() madmin.BatchJobType {
 // Validate validates the current job, used by 'save()' before
 // persisting the job request
 func (j BatchJobRequest) Validate(ctx context.Context, o ObjectLayer) error {
+	if j.Schedule != "" {
+		if _, err := parseCronSchedule(j.Schedule); err != nil {
+			return err
+		}
+	}
 	switch {
 	case j.Replicate != nil:
 		return j.Replicate.Validate(ctx, j, o)
 	case j.KeyRotate != nil:
 		return j.KeyRotate.Validate(ctx, j, o)
+	case j.BucketMeta != nil:
+		return j.BucketMeta.Validate(ctx, j, o)
+	case j.Restore != nil:
+		return j.Restore.Validate(ctx, j, o)
+	case j.ContentType != nil:
+		return j.ContentType.Validate(ctx, j, o)
+	case j.LegalHold != nil:
+		return j.LegalHold.Validate(ctx, j, o)
+	case j.Tag != nil:
+		return j.Tag.Validate(ctx, j, o)
+	case j.Copy != nil:
+		return j.Copy.Validate(ctx, j, o)
 	}
 	return errInvalidArgument
 }
 
-func (j BatchJobRequest) delete(ctx context.Context, api ObjectLayer) {
+// resetCheckpoint removes the persisted progress and in-memory metrics
+// of the previous run so the next run starts from a fresh checkpoint.
+func (j BatchJobRequest) resetCheckpoint(ctx context.Context, api ObjectLayer) {
 	switch {
 	case j.Replicate != nil:
 		deleteConfig(ctx, api, pathJoin(j.Location, batchReplName))
 	case j.KeyRotate != nil:
 		deleteConfig(ctx, api, pathJoin(j.Location, batchKeyRotationName))
+	case j.BucketMeta != nil:
+		deleteConfig(ctx, api, pathJoin(j.Location, batchBucketMetaName))
+	case j.Restore != nil:
+		deleteConfig(ctx, api, pathJoin(j.Location, batchRestoreName))
+	case j.ContentType != nil:
+		deleteConfig(ctx, api, pathJoin(j.Location, batchContentTypeName))
+	case j.LegalHold != nil:
+		deleteConfig(ctx, api, pathJoin(j.Location, batchLegalHoldName))
+	case j.Tag != nil:
+		deleteConfig(ctx, api, pathJoin(j.Location, batchTagName))
+	case j.Copy != nil:
+		deleteConfig(ctx, api, pathJoin(j.Location, batchCopyName))
 	}
 	globalBatchJobsMetrics.delete(j.ID)
+}
+
+func (j BatchJobRequest) delete(ctx context.Context, api ObjectLayer) {
+	j.resetCheckpoint(ctx, api)
 	deleteConfig(ctx, api, j.Location)
 }
 
 func (j *BatchJobRequest) save(ctx context.Context, api ObjectLayer) error {
-	if j.Replicate == nil && j.KeyRotate == nil {
+	if j.Replicate == nil && j.KeyRotate == nil && j.BucketMeta == nil && j.Restore == nil && j.ContentType == nil && j.LegalHold == nil && j.Tag == nil && j.Copy == nil {
 		return errInvalidArgument
 	}
 
@@ -1583,12 +1777,91 @@ func (a adminAPIHandlers) StartBatchJob(w http.ResponseWriter, r *http.Request)
 	job.User = user
 	job.Started = time.Now()
 
+	if job.LegalHold != nil {
+		// Legal hold jobs change object lock state, require the
+		// prefix-wide object permission up front in addition to the
+		// per-object evaluation the workers perform for policies
+		// conditioned on individual object names.
+		if !globalIAMSys.IsAllowed(iampolicy.Args{
+			AccountName:     creds.AccessKey,
+			Groups:          creds.Groups,
+			Action:          iampolicy.PutObjectLegalHoldAction,
+			BucketName:      job.LegalHold.Bucket,
+			ObjectName:      job.LegalHold.Prefix + "*",
+			ConditionValues: getConditionValues(r, "", creds),
+			IsOwner:         creds.AccessKey == globalActiveCred.AccessKey,
+			Claims:          creds.Claims,
+		}) {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
+			return
+		}
+	}
+
+	if job.Tag != nil {
+		// Tag jobs rewrite object tag sets, require the prefix-wide
+		// object permission up front in addition to the per-object
+		// evaluation the workers perform for policies conditioned on
+		// individual object names.
+		if !globalIAMSys.IsAllowed(iampolicy.Args{
+			AccountName:     creds.AccessKey,
+			Groups:          creds.Groups,
+			Action:          iampolicy.PutObjectTaggingAction,
+			BucketName:      job.Tag.Bucket,
+			ObjectName:      job.Tag.Prefix + "*",
+			ConditionValues: getConditionValues(r, "", creds),
+			IsOwner:         creds.AccessKey == globalActiveCred.AccessKey,
+			Claims:          creds.Claims,
+		}) {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
+			return
+		}
+	}
+
+	if job.Copy != nil {
+		// Copy jobs read the whole source prefix and write the whole
+		// target prefix, require both prefix-wide permissions up front
+		// in addition to the per-object evaluation the workers perform.
+		copyActions := []struct {
+			action iampolicy.Action
+			bucket string
+			object string
+		}{
+			{iampolicy.GetObjectAction, job.Copy.Bucket, job.Copy.Prefix + "*"},
+			{iampolicy.PutObjectAction, job.Copy.TargetBucket, job.Copy.TargetPrefix + "*"},
+		}
+		if job.Copy.DeleteSource {
+			copyActions = append(copyActions, struct {
+				action iampolicy.Action
+				bucket string
+				object string
+			}{iampolicy.DeleteObjectAction, job.Copy.Bucket, job.Copy.Prefix + "*"})
+		}
+		for _, ca := range copyActions {
+			if !globalIAMSys.IsAllowed(iampolicy.Args{
+				AccountName:     creds.AccessKey,
+				Groups:          creds.Groups,
+				Action:          ca.action,
+				BucketName:      ca.bucket,
+				ObjectName:      ca.object,
+				ConditionValues: getConditionValues(r, "", creds),
+				IsOwner:         creds.AccessKey == globalActiveCred.AccessKey,
+				Claims:          creds.Claims,
+			}) {
+				writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
+				return
+			}
+		}
+	}
+
 	if err := job.save(ctx, objectAPI); err != nil {
 		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
 
-	if err = globalBatchJobPool.queueJob(job); err != nil {
+	if job.Schedule != "" {
+		// Scheduled jobs only run at their cron triggers.
+		globalBatchJobPool.scheduleJob(job)
+	} else if err = globalBatchJobPool.queueJob(job); err != nil {
 		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
@@ -1684,6 +1957,10 @@ func (j *BatchJobPool) resume() {
 			logger.LogIf(ctx, err)
 			continue
 		}
+		if req.Schedule != "" {
+			j.resumeScheduled(req)
+			continue
+		}
 		if err := j.queueJob(req); err != nil {
 			logger.LogIf(ctx, err)
 			continue
@@ -1733,7 +2010,62 @@ func (j *BatchJobPool) AddWorker() {
 					}
 				}
 			}
-			job.delete(j.ctx, j.objLayer)
+			if job.BucketMeta != nil {
+				if err := job.BucketMeta.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						logger.LogIf(j.ctx, err)
+						continue
+					}
+				}
+			}
+			if job.Restore != nil {
+				if err := job.Restore.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						logger.LogIf(j.ctx, err)
+						continue
+					}
+				}
+			}
+			if job.ContentType != nil {
+				if err := job.ContentType.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						logger.LogIf(j.ctx, err)
+						continue
+					}
+				}
+			}
+			if job.LegalHold != nil {
+				if err := job.LegalHold.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						logger.LogIf(j.ctx, err)
+						continue
+					}
+				}
+			}
+			if job.Tag != nil {
+				if err := job.Tag.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						logger.LogIf(j.ctx, err)
+						continue
+					}
+				}
+			}
+			if job.Copy != nil {
+				if err := job.Copy.Start(job.ctx, j.objLayer, *job); err != nil {
+					if !isErrBucketNotFound(err) {
+						logger.LogIf(j.ctx, err)
+						continue
+					}
+				}
+			}
+			if job.Schedule != "" {
+				// Scheduled jobs are retained, record the completed
+				// run and arm the next trigger instead of deleting
+				// the definition.
+				j.finishScheduledRun(job)
+			} else {
+				job.delete(j.ctx, j.objLayer)
+			}
 			j.canceler(job.ID, false)
 		case <-j.workerKillCh:
 			return
@@ -1832,13 +2164,18 @@ func batchJobTrace(d batchJobMetric, job string, startTime time.Time, duration t
 	if attempts > 0 {
 		funcName = fmt.Sprintf("%s.%s (job-name=%s,attempts=%s)", jobKind, d.String(), job, humanize.Ordinal(attempts))
 	}
+	objPath := info.Name
+	if info.VersionID != "" {
+		// disambiguate versions of the same object in the trace output.
+		objPath = fmt.Sprintf("%s (%s)", info.Name, info.VersionID)
+	}
 	return madmin.TraceInfo{
 		TraceType: traceType,
 		Time:      startTime,
 		NodeName:  globalLocalNodeName,
 		FuncName:  funcName,
 		Duration:  duration,
-		Path:      info.Name,
+		Path:      objPath,
 		Error:     errStr,
 	}
 }
@@ -1606,6 +1606,132 @@ func (z *BatchJobRequest) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "BucketMeta":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "BucketMeta")
+					return
+				}
+				z.BucketMeta = nil
+			} else {
+				if z.BucketMeta == nil {
+					z.BucketMeta = new(BatchJobBucketMetaV1)
+				}
+				err = z.BucketMeta.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "BucketMeta")
+					return
+				}
+			}
+		case "Restore":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Restore")
+					return
+				}
+				z.Restore = nil
+			} else {
+				if z.Restore == nil {
+					z.Restore = new(BatchJobRestoreV1)
+				}
+				err = z.Restore.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Restore")
+					return
+				}
+			}
+		case "ContentType":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "ContentType")
+					return
+				}
+				z.ContentType = nil
+			} else {
+				if z.ContentType == nil {
+					z.ContentType = new(BatchJobContentTypeV1)
+				}
+				err = z.ContentType.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "ContentType")
+					return
+				}
+			}
+		case "LegalHold":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "LegalHold")
+					return
+				}
+				z.LegalHold = nil
+			} else {
+				if z.LegalHold == nil {
+					z.LegalHold = new(BatchJobLegalHoldV1)
+				}
+				err = z.LegalHold.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "LegalHold")
+					return
+				}
+			}
+		case "Tag":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Tag")
+					return
+				}
+				z.Tag = nil
+			} else {
+				if z.Tag == nil {
+					z.Tag = new(BatchJobTagV1)
+				}
+				err = z.Tag.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Tag")
+					return
+				}
+			}
+		case "Copy":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Copy")
+					return
+				}
+				z.Copy = nil
+			} else {
+				if z.Copy == nil {
+					z.Copy = new(BatchJobCopyV1)
+				}
+				err = z.Copy.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Copy")
+					return
+				}
+			}
+		case "Schedule":
+			z.Schedule, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Schedule")
+				return
+			}
+		case "Paused":
+			z.Paused, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Paused")
+				return
+			}
+		case "LastRun":
+			z.LastRun, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "LastRun")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -1619,9 +1745,9 @@ func (z *BatchJobRequest) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *BatchJobRequest) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 6
+	// map header, size 15
 	// write "ID"
-	err = en.Append(0x86, 0xa2, 0x49, 0x44)
+	err = en.Append(0x8f, 0xa2, 0x49, 0x44)
 	if err != nil {
 		return
 	}
@@ -1694,15 +1820,147 @@ func (z *BatchJobRequest) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	// write "BucketMeta"
+	err = en.Append(0xaa, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61)
+	if err != nil {
+		return
+	}
+	if z.BucketMeta == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.BucketMeta.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "BucketMeta")
+			return
+		}
+	}
+	// write "Restore"
+	err = en.Append(0xa7, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65)
+	if err != nil {
+		return
+	}
+	if z.Restore == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Restore.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Restore")
+			return
+		}
+	}
+	// write "ContentType"
+	err = en.Append(0xab, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65)
+	if err != nil {
+		return
+	}
+	if z.ContentType == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.ContentType.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "ContentType")
+			return
+		}
+	}
+	// write "LegalHold"
+	err = en.Append(0xa9, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64)
+	if err != nil {
+		return
+	}
+	if z.LegalHold == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.LegalHold.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "LegalHold")
+			return
+		}
+	}
+	// write "Tag"
+	err = en.Append(0xa3, 0x54, 0x61, 0x67)
+	if err != nil {
+		return
+	}
+	if z.Tag == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Tag.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Tag")
+			return
+		}
+	}
+	// write "Copy"
+	err = en.Append(0xa4, 0x43, 0x6f, 0x70, 0x79)
+	if err != nil {
+		return
+	}
+	if z.Copy == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Copy.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Copy")
+			return
+		}
+	}
+	// write "Schedule"
+	err = en.Append(0xa8, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Schedule)
+	if err != nil {
+		err = msgp.WrapError(err, "Schedule")
+		return
+	}
+	// write "Paused"
+	err = en.Append(0xa6, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Paused)
+	if err != nil {
+		err = msgp.WrapError(err, "Paused")
+		return
+	}
+	// write "LastRun"
+	err = en.Append(0xa7, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.LastRun)
+	if err != nil {
+		err = msgp.WrapError(err, "LastRun")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *BatchJobRequest) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 15
 	// string "ID"
-	o = append(o, 0x86, 0xa2, 0x49, 0x44)
+	o = append(o, 0x8f, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "User"
 	o = append(o, 0xa4, 0x55, 0x73, 0x65, 0x72)
@@ -1735,6 +1993,81 @@ func (z *BatchJobRequest) MarshalMsg(b []byte) (o []byte, err error) {
 			return
 		}
 	}
+	// string "BucketMeta"
+	o = append(o, 0xaa, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61)
+	if z.BucketMeta == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.BucketMeta.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "BucketMeta")
+			return
+		}
+	}
+	// string "Restore"
+	o = append(o, 0xa7, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65)
+	if z.Restore == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Restore.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Restore")
+			return
+		}
+	}
+	// string "ContentType"
+	o = append(o, 0xab, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65)
+	if z.ContentType == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.ContentType.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "ContentType")
+			return
+		}
+	}
+	// string "LegalHold"
+	o = append(o, 0xa9, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64)
+	if z.LegalHold == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.LegalHold.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "LegalHold")
+			return
+		}
+	}
+	// string "Tag"
+	o = append(o, 0xa3, 0x54, 0x61, 0x67)
+	if z.Tag == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Tag.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Tag")
+			return
+		}
+	}
+	// string "Copy"
+	o = append(o, 0xa4, 0x43, 0x6f, 0x70, 0x79)
+	if z.Copy == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Copy.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Copy")
+			return
+		}
+	}
+	// string "Schedule"
+	o = append(o, 0xa8, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65)
+	o = msgp.AppendString(o, z.Schedule)
+	// string "Paused"
+	o = append(o, 0xa6, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64)
+	o = msgp.AppendBool(o, z.Paused)
+	// string "LastRun"
+	o = append(o, 0xa7, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e)
+	o = msgp.AppendTime(o, z.LastRun)
 	return
 }
 
@@ -1814,6 +2147,126 @@ func (z *BatchJobRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "BucketMeta":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.BucketMeta = nil
+			} else {
+				if z.BucketMeta == nil {
+					z.BucketMeta = new(BatchJobBucketMetaV1)
+				}
+				bts, err = z.BucketMeta.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "BucketMeta")
+					return
+				}
+			}
+		case "Restore":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Restore = nil
+			} else {
+				if z.Restore == nil {
+					z.Restore = new(BatchJobRestoreV1)
+				}
+				bts, err = z.Restore.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Restore")
+					return
+				}
+			}
+		case "ContentType":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.ContentType = nil
+			} else {
+				if z.ContentType == nil {
+					z.ContentType = new(BatchJobContentTypeV1)
+				}
+				bts, err = z.ContentType.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ContentType")
+					return
+				}
+			}
+		case "LegalHold":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.LegalHold = nil
+			} else {
+				if z.LegalHold == nil {
+					z.LegalHold = new(BatchJobLegalHoldV1)
+				}
+				bts, err = z.LegalHold.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "LegalHold")
+					return
+				}
+			}
+		case "Tag":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Tag = nil
+			} else {
+				if z.Tag == nil {
+					z.Tag = new(BatchJobTagV1)
+				}
+				bts, err = z.Tag.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Tag")
+					return
+				}
+			}
+		case "Copy":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Copy = nil
+			} else {
+				if z.Copy == nil {
+					z.Copy = new(BatchJobCopyV1)
+				}
+				bts, err = z.Copy.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Copy")
+					return
+				}
+			}
+		case "Schedule":
+			z.Schedule, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Schedule")
+				return
+			}
+		case "Paused":
+			z.Paused, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Paused")
+				return
+			}
+		case "LastRun":
+			z.LastRun, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LastRun")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -1840,6 +2293,43 @@ func (z *BatchJobRequest) Msgsize() (s int) {
 	} else {
 		s += z.KeyRotate.Msgsize()
 	}
+	s += 11
+	if z.BucketMeta == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.BucketMeta.Msgsize()
+	}
+	s += 8
+	if z.Restore == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Restore.Msgsize()
+	}
+	s += 12
+	if z.ContentType == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.ContentType.Msgsize()
+	}
+	s += 10
+	if z.LegalHold == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.LegalHold.Msgsize()
+	}
+	s += 4
+	if z.Tag == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Tag.Msgsize()
+	}
+	s += 5
+	if z.Copy == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Copy.Msgsize()
+	}
+	s += 9 + msgp.StringPrefixSize + len(z.Schedule) + 7 + msgp.BoolSize + 8 + msgp.TimeSize
 	return
 }
 
@@ -2632,6 +3122,12 @@ func (z *batchJobInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Failed")
 				return
 			}
+		case "sta":
+			z.Status, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
 		case "lbkt":
 			z.Bucket, err = dc.ReadString()
 			if err != nil {
@@ -2693,9 +3189,9 @@ func (z *batchJobInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *batchJobInfo) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 16
+	// map header, size 17
 	// write "v"
-	err = en.Append(0xde, 0x0, 0x10, 0xa1, 0x76)
+	err = en.Append(0xde, 0x0, 0x11, 0xa1, 0x76)
 	if err != nil {
 		return
 	}
@@ -2774,6 +3270,16 @@ func (z *batchJobInfo) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Failed")
 		return
 	}
+	// write "sta"
+	err = en.Append(0xa3, 0x73, 0x74, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Status)
+	if err != nil {
+		err = msgp.WrapError(err, "Status")
+		return
+	}
 	// write "lbkt"
 	err = en.Append(0xa4, 0x6c, 0x62, 0x6b, 0x74)
 	if err != nil {
@@ -2860,9 +3366,9 @@ func (z *batchJobInfo) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *batchJobInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 16
+	// map header, size 17
 	// string "v"
-	o = append(o, 0xde, 0x0, 0x10, 0xa1, 0x76)
+	o = append(o, 0xde, 0x0, 0x11, 0xa1, 0x76)
 	o = msgp.AppendInt(o, z.Version)
 	// string "jid"
 	o = append(o, 0xa3, 0x6a, 0x69, 0x64)
@@ -2885,6 +3391,9 @@ func (z *batchJobInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "fld"
 	o = append(o, 0xa3, 0x66, 0x6c, 0x64)
 	o = msgp.AppendBool(o, z.Failed)
+	// string "sta"
+	o = append(o, 0xa3, 0x73, 0x74, 0x61)
+	o = msgp.AppendString(o, z.Status)
 	// string "lbkt"
 	o = append(o, 0xa4, 0x6c, 0x62, 0x6b, 0x74)
 	o = msgp.AppendString(o, z.Bucket)
@@ -2978,6 +3487,12 @@ func (z *batchJobInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Failed")
 				return
 			}
+		case "sta":
+			z.Status, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
 		case "lbkt":
 			z.Bucket, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
@@ -3040,6 +3555,6 @@ func (z *batchJobInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *batchJobInfo) Msgsize() (s int) {
-	s = 3 + 2 + msgp.IntSize + 4 + msgp.StringPrefixSize + len(z.JobID) + 3 + msgp.StringPrefixSize + len(z.JobType) + 3 + msgp.TimeSize + 3 + msgp.TimeSize + 3 + msgp.IntSize + 4 + msgp.BoolSize + 4 + msgp.BoolSize + 5 + msgp.StringPrefixSize + len(z.Bucket) + 5 + msgp.StringPrefixSize + len(z.Object) + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 4 + msgp.Int64Size + 4 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size
+	s = 3 + 2 + msgp.IntSize + 4 + msgp.StringPrefixSize + len(z.JobID) + 3 + msgp.StringPrefixSize + len(z.JobType) + 3 + msgp.TimeSize + 3 + msgp.TimeSize + 3 + msgp.IntSize + 4 + msgp.BoolSize + 4 + msgp.BoolSize + 4 + msgp.StringPrefixSize + len(z.Status) + 5 + msgp.StringPrefixSize + len(z.Bucket) + 5 + msgp.StringPrefixSize + len(z.Object) + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 4 + msgp.Int64Size + 4 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size
 	return
 }
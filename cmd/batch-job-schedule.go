@@ -0,0 +1,377 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio/internal/logger"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// batchJobScheduleGrace is how far past a missed cron trigger a run is
+// still started on startup, e.g. when the server was down over the
+// trigger time. Triggers missed by more than this simply wait for the
+// next one.
+const batchJobScheduleGrace = time.Hour
+
+// cronField is the set of allowed values for one field of a cron
+// expression, one bit per value.
+type cronField uint64
+
+func (f cronField) match(v int) bool {
+	return f&(1<<uint(v)) != 0
+}
+
+// cronSchedule is a parsed five field cron expression
+// "minute hour day-of-month month day-of-week".
+type cronSchedule struct {
+	minute     cronField
+	hour       cronField
+	dayOfMonth cronField
+	month      cronField
+	dayOfWeek  cronField
+}
+
+// parseCronField parses one field of a cron expression, supporting "*",
+// single values, ranges "a-b", "/step" suffixes and comma separated
+// combinations thereof.
+func parseCronField(field string, min, max int) (cronField, error) {
+	var f cronField
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		rangePart := part
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[idx+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in cron field %q", field)
+			}
+			rangePart = part[:idx]
+		}
+		lo, hi := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			loStr, hiStr, _ := strings.Cut(rangePart, "-")
+			var errLo, errHi error
+			lo, errLo = strconv.Atoi(loStr)
+			hi, errHi = strconv.Atoi(hiStr)
+			if errLo != nil || errHi != nil {
+				return 0, fmt.Errorf("invalid range in cron field %q", field)
+			}
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value in cron field %q", field)
+			}
+			lo, hi = v, v
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("cron field %q out of range %d-%d", field, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			f |= 1 << uint(v)
+		}
+	}
+	if f == 0 {
+		return 0, fmt.Errorf("empty cron field %q", field)
+	}
+	return f, nil
+}
+
+// parseCronSchedule parses a standard five field cron expression such as
+// "0 3 1 * *". Unlike classic cron, day-of-month and day-of-week must
+// both match when both are restricted.
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron schedule %q - expecting five fields 'minute hour day-of-month month day-of-week'", expr)
+	}
+	var s cronSchedule
+	var err error
+	if s.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if s.dayOfMonth, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	// Both 0 and 7 denote Sunday.
+	if s.dayOfWeek, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	if s.dayOfWeek.match(7) {
+		s.dayOfWeek |= 1
+	}
+	return &s, nil
+}
+
+// Next returns the first trigger time strictly after t, at minute
+// granularity. The search is bounded to five years so expressions that
+// can never trigger, such as "0 0 31 2 *", return the zero time instead
+// of looping forever.
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	bound := t.AddDate(5, 0, 0)
+	for t.Before(bound) {
+		if !s.month.match(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+			continue
+		}
+		if !s.dayOfMonth.match(t.Day()) || !s.dayOfWeek.match(int(t.Weekday())) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			continue
+		}
+		if !s.hour.match(t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(time.Hour)
+			continue
+		}
+		if !s.minute.match(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// isRunning reports whether the job is currently queued or running.
+func (j *BatchJobPool) isRunning(jobID string) bool {
+	j.jmu.Lock()
+	defer j.jmu.Unlock()
+	_, ok := j.jobCancelers[jobID]
+	return ok
+}
+
+// scheduleJob arms the job's next cron trigger and queues a fresh run
+// when it fires. The definition is reloaded at trigger time, so pausing
+// or canceling the schedule takes effect on an already sleeping timer.
+func (j *BatchJobPool) scheduleJob(req *BatchJobRequest) {
+	sched, err := parseCronSchedule(req.Schedule)
+	if err != nil {
+		logger.LogIf(j.ctx, err)
+		return
+	}
+	go func() {
+		for {
+			next := sched.Next(time.Now())
+			if next.IsZero() {
+				logger.LogIf(j.ctx, fmt.Errorf("cron schedule %q of batch job %s can never trigger", req.Schedule, req.ID))
+				return
+			}
+			select {
+			case <-j.ctx.Done():
+				return
+			case <-time.After(time.Until(next)):
+			}
+			fresh := &BatchJobRequest{}
+			if err := fresh.load(j.ctx, j.objLayer, pathJoin(batchJobPrefix, req.ID)); err != nil {
+				// The definition is gone, the schedule was canceled.
+				return
+			}
+			if fresh.Paused {
+				continue
+			}
+			if j.isRunning(fresh.ID) {
+				// The previous run is still in progress, skip this
+				// trigger instead of overlapping it.
+				logger.LogIf(j.ctx, fmt.Errorf("batch job %s is still running, skipping the %s trigger", fresh.ID, next.Format(time.RFC3339)))
+				continue
+			}
+			// The worker re-arms the schedule once the run completes.
+			j.startScheduledRun(fresh)
+			return
+		}
+	}()
+}
+
+// startScheduledRun begins a fresh run of a scheduled job, clearing the
+// previous run's checkpoint so it does not resume where the last run
+// stopped.
+func (j *BatchJobPool) startScheduledRun(req *BatchJobRequest) {
+	req.resetCheckpoint(j.ctx, j.objLayer)
+	req.Started = time.Now()
+	if err := req.save(j.ctx, j.objLayer); err != nil {
+		logger.LogIf(j.ctx, err)
+		return
+	}
+	if err := j.queueJob(req); err != nil {
+		logger.LogIf(j.ctx, err)
+	}
+}
+
+// finishScheduledRun records a completed run of a scheduled job and arms
+// its next trigger, instead of deleting the definition like one-shot
+// jobs do.
+func (j *BatchJobPool) finishScheduledRun(req *BatchJobRequest) {
+	req.LastRun = time.Now()
+	if err := req.save(j.ctx, j.objLayer); err != nil {
+		logger.LogIf(j.ctx, err)
+	}
+	j.scheduleJob(req)
+}
+
+// resumeScheduled re-arms a scheduled job on startup. A trigger missed
+// while the server was down is run immediately when still within the
+// grace window, otherwise the schedule simply continues with the next
+// trigger.
+func (j *BatchJobPool) resumeScheduled(req *BatchJobRequest) {
+	sched, err := parseCronSchedule(req.Schedule)
+	if err != nil {
+		logger.LogIf(j.ctx, err)
+		return
+	}
+	last := req.LastRun
+	if last.IsZero() {
+		last = req.Started
+	}
+	if next := sched.Next(last); !req.Paused && next.Before(time.Now()) && time.Since(next) <= batchJobScheduleGrace {
+		j.startScheduledRun(req)
+		return
+	}
+	j.scheduleJob(req)
+}
+
+// batchJobScheduleInfo describes one scheduled batch job definition in
+// the list-job-schedules admin API response.
+type batchJobScheduleInfo struct {
+	ID       string              `json:"id"`
+	Type     madmin.BatchJobType `json:"type"`
+	User     string              `json:"user"`
+	Schedule string              `json:"schedule"`
+	Paused   bool                `json:"paused"`
+	LastRun  time.Time           `json:"lastRun"`
+	NextRun  time.Time           `json:"nextRun"`
+}
+
+// batchJobSchedulesResult is the list-job-schedules admin API response.
+type batchJobSchedulesResult struct {
+	Schedules []batchJobScheduleInfo `json:"schedules"`
+}
+
+// ListBatchJobSchedules - lists all batch job definitions carrying a
+// cron schedule along with their upcoming trigger times.
+func (a adminAPIHandlers) ListBatchJobSchedules(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "ListBatchJobSchedules")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ListBatchJobsAction)
+	if objectAPI == nil {
+		return
+	}
+
+	resultCh := make(chan ObjectInfo)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err := objectAPI.Walk(ctx, minioMetaBucket, batchJobPrefix, resultCh, ObjectOptions{}); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	listResult := batchJobSchedulesResult{}
+	for result := range resultCh {
+		req := &BatchJobRequest{}
+		if err := req.load(ctx, objectAPI, result.Name); err != nil {
+			if !errors.Is(err, errNoSuchJob) {
+				logger.LogIf(ctx, err)
+			}
+			continue
+		}
+		if req.Schedule == "" {
+			continue
+		}
+		info := batchJobScheduleInfo{
+			ID:       req.ID,
+			Type:     req.Type(),
+			User:     req.User,
+			Schedule: req.Schedule,
+			Paused:   req.Paused,
+			LastRun:  req.LastRun,
+		}
+		if !req.Paused {
+			if sched, err := parseCronSchedule(req.Schedule); err == nil {
+				info.NextRun = sched.Next(time.Now())
+			}
+		}
+		listResult.Schedules = append(listResult.Schedules, info)
+	}
+
+	logger.LogIf(ctx, json.NewEncoder(w).Encode(&listResult))
+}
+
+// PauseBatchJobSchedule pauses or resumes the cron schedule of a batch
+// job definition. Paused schedules skip their triggers until resumed,
+// the definition itself is retained.
+func (a adminAPIHandlers) PauseBatchJobSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PauseBatchJobSchedule")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.StartBatchJobAction)
+	if objectAPI == nil {
+		return
+	}
+
+	jobID := r.Form.Get("jobId")
+	if jobID == "" {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, errInvalidArgument), r.URL)
+		return
+	}
+	pause, err := strconv.ParseBool(r.Form.Get("pause"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, errInvalidArgument), r.URL)
+		return
+	}
+
+	req := &BatchJobRequest{}
+	if err := req.load(ctx, objectAPI, pathJoin(batchJobPrefix, jobID)); err != nil {
+		if !errors.Is(err, errNoSuchJob) {
+			logger.LogIf(ctx, err)
+		}
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	if req.Schedule == "" {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, fmt.Errorf("batch job %s carries no schedule", jobID)), r.URL)
+		return
+	}
+
+	req.Paused = pause
+	if err := req.save(ctx, objectAPI); err != nil {
+		writeErrorResponseJSON(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessNoContent(w)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	valid := []string{
+		"0 3 1 * *",
+		"* * * * *",
+		"*/15 0-6 * * 1-5",
+		"30 2 1,15 */3 0",
+		"0 0 * * 7",
+	}
+	for _, expr := range valid {
+		if _, err := parseCronSchedule(expr); err != nil {
+			t.Errorf("expected schedule %q to parse, got %v", expr, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"0 3 1 *",
+		"0 3 1 * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"a * * * *",
+		"5-1 * * * *",
+	}
+	for _, expr := range invalid {
+		if _, err := parseCronSchedule(expr); err == nil {
+			t.Errorf("expected schedule %q to be rejected", expr)
+		}
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	testCases := []struct {
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		// Monthly at 03:00 on the 1st.
+		{"0 3 1 * *", time.Date(2023, 5, 20, 10, 0, 0, 0, time.UTC), time.Date(2023, 6, 1, 3, 0, 0, 0, time.UTC)},
+		// Same day when the trigger is still ahead.
+		{"0 3 1 * *", time.Date(2023, 6, 1, 2, 0, 0, 0, time.UTC), time.Date(2023, 6, 1, 3, 0, 0, 0, time.UTC)},
+		// Strictly after the input time.
+		{"0 3 1 * *", time.Date(2023, 6, 1, 3, 0, 0, 0, time.UTC), time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC)},
+		// Every 15 minutes.
+		{"*/15 * * * *", time.Date(2023, 5, 20, 10, 20, 0, 0, time.UTC), time.Date(2023, 5, 20, 10, 30, 0, 0, time.UTC)},
+		// Weekdays only, 2023-05-20 is a Saturday.
+		{"0 9 * * 1-5", time.Date(2023, 5, 20, 10, 0, 0, 0, time.UTC), time.Date(2023, 5, 22, 9, 0, 0, 0, time.UTC)},
+		// 7 folds to Sunday.
+		{"0 0 * * 7", time.Date(2023, 5, 20, 10, 0, 0, 0, time.UTC), time.Date(2023, 5, 21, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, testCase := range testCases {
+		sched, err := parseCronSchedule(testCase.expr)
+		if err != nil {
+			t.Fatalf("Test %d: failed to parse %q: %v", i, testCase.expr, err)
+		}
+		if got := sched.Next(testCase.from); !got.Equal(testCase.want) {
+			t.Errorf("Test %d: %q from %v: expected %v, got %v", i, testCase.expr, testCase.from, testCase.want, got)
+		}
+	}
+
+	// An impossible expression returns the zero time instead of looping.
+	sched, err := parseCronSchedule("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sched.Next(time.Date(2023, 5, 20, 10, 0, 0, 0, time.UTC)); !got.IsZero() {
+		t.Errorf("expected the zero time for an impossible schedule, got %v", got)
+	}
+}
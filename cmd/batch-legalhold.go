@@ -0,0 +1,465 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/env"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/minio/pkg/wildcard"
+	"github.com/minio/pkg/workers"
+)
+
+// legalhold:
+//   apiVersion: v1
+//   bucket: BUCKET # bucket must have object lock enabled
+//   prefix: PREFIX
+//   status: ON # ON places the legal hold, OFF releases it
+// # optional flags based filtering criteria, the filter surface is shared
+// # with the keyrotate job - kmskey is not applicable here.
+// flags:
+//   filter:
+//     newerThan: "7d" # match objects newer than this value (e.g. 7d10h31s)
+//     olderThan: "7d" # match objects older than this value (e.g. 7d10h31s)
+//     createdAfter: "date" # match objects created after "date"
+//     createdBefore: "date" # match objects created before "date"
+//     tags:
+//       - key: "name"
+//         value: "pick*" # match objects with tag 'name', with all values starting with 'pick'
+//     metadata:
+//       - key: "content-type"
+//         value: "image/*" # match objects with 'content-type', with all values starting with 'image/'
+//     excludePrefixes:
+//       - "logs/" # do not touch objects under these prefixes
+//     excludeNames:
+//       - "*.tmp" # do not touch objects whose names match these wildcard patterns
+//   notify:
+//     endpoint: "https://notify.endpoint" # notification endpoint to receive job status events
+//     token: "Bearer xxxxx" # optional authentication token for the notification endpoint
+//   retry:
+//     attempts: 10 # number of retries for the job before giving up
+//     delay: "500ms" # least amount of delay between each retry
+
+//go:generate msgp -file $GOFILE -unexported
+
+// BatchJobLegalHoldFlags various configurations for legal hold job
+// definition, reuses the batch-rotate filter/notify/retry surface.
+type BatchJobLegalHoldFlags struct {
+	Filter BatchKeyRotateFilter       `yaml:"filter" json:"filter"`
+	Notify BatchKeyRotateNotification `yaml:"notify" json:"notify"`
+	Retry  BatchKeyRotateRetry        `yaml:"retry" json:"retry"`
+}
+
+// BatchJobLegalHoldV1 v1 of batch legal hold job
+type BatchJobLegalHoldV1 struct {
+	APIVersion string                 `yaml:"apiVersion" json:"apiVersion"`
+	Flags      BatchJobLegalHoldFlags `yaml:"flags" json:"flags"`
+	Bucket     string                 `yaml:"bucket" json:"bucket"`
+	Prefix     string                 `yaml:"prefix" json:"prefix"`
+	Status     string                 `yaml:"status" json:"status"`
+}
+
+//msgp:ignore batchLegalHoldJobError
+type batchLegalHoldJobError struct {
+	Code           string
+	Description    string
+	HTTPStatusCode int
+}
+
+func (e batchLegalHoldJobError) Error() string {
+	return e.Description
+}
+
+const (
+	batchLegalHoldName                 = "batch-legalhold.bin"
+	batchLegalHoldFormat               = 1
+	batchLegalHoldVersionV1            = 1
+	batchLegalHoldVersion              = batchLegalHoldVersionV1
+	batchLegalHoldAPIVersion           = "v1"
+	batchLegalHoldJobDefaultRetries    = 3
+	batchLegalHoldJobDefaultRetryDelay = 250 * time.Millisecond
+)
+
+// batchJobTypeLegalHold is served alongside the madmin defined job types,
+// madmin does not declare a constant for it yet.
+const batchJobTypeLegalHold = madmin.BatchJobType("legalhold")
+
+// Notify notifies notification endpoint if configured regarding job failure or success.
+func (r BatchJobLegalHoldV1) Notify(ctx context.Context, body io.Reader) error {
+	if r.Flags.Notify.Endpoint == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Flags.Notify.Endpoint, body)
+	if err != nil {
+		return err
+	}
+
+	if r.Flags.Notify.Token != "" {
+		req.Header.Set("Authorization", r.Flags.Notify.Token)
+	}
+
+	for k, v := range r.Flags.Notify.Headers {
+		req.Header.Set(k, v)
+	}
+
+	clnt := http.Client{Transport: getRemoteInstanceTransport}
+	resp, err := clnt.Do(req)
+	if err != nil {
+		return err
+	}
+
+	xhttp.DrainBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+// allowed re-evaluates PutObjectLegalHold for the job user against a
+// concrete object name, the prefix-wide check at job submission cannot
+// account for policies conditioned on individual object names.
+func (r *BatchJobLegalHoldV1) allowed(user, object string) bool {
+	if user == globalActiveCred.AccessKey {
+		return true
+	}
+	return globalIAMSys.IsAllowed(iampolicy.Args{
+		AccountName:     user,
+		Action:          iampolicy.PutObjectLegalHoldAction,
+		BucketName:      r.Bucket,
+		ObjectName:      object,
+		ConditionValues: map[string][]string{},
+	})
+}
+
+// SetLegalHold applies the job's legal hold status to an object version
+// via a metadata-only copy, mirroring what PutObjectLegalHoldHandler does
+// for a single object.
+func (r *BatchJobLegalHoldV1) SetLegalHold(ctx context.Context, api ObjectLayer, objInfo ObjectInfo, user string) error {
+	if objInfo.DeleteMarker || !objInfo.VersionPurgeStatus.Empty() {
+		return nil
+	}
+
+	if !r.allowed(user, objInfo.Name) {
+		return PrefixAccessDenied{Bucket: r.Bucket, Object: objInfo.Name}
+	}
+
+	status := objectlock.LegalHoldStatus(strings.ToUpper(r.Status))
+	if objectlock.GetObjectLegalHoldMeta(objInfo.UserDefined).Status == status {
+		// The version already carries the requested status.
+		return nil
+	}
+
+	oi := objInfo.Clone()
+	oi.metadataOnly = true // Perform only metadata updates.
+	metadata := cloneMSS(oi.UserDefined)
+	metadata[strings.ToLower(xhttp.AmzObjectLockLegalHold)] = string(status)
+	metadata[ReservedMetadataPrefixLower+ObjectLockLegalHoldTimestamp] = UTCNow().Format(time.RFC3339Nano)
+	oi.UserDefined = metadata
+	_, err := api.CopyObject(ctx, r.Bucket, oi.Name, r.Bucket, oi.Name, oi, ObjectOptions{
+		VersionID: oi.VersionID,
+	}, ObjectOptions{
+		VersionID: oi.VersionID,
+	})
+	return err
+}
+
+// Start the batch legal hold job, resumes if there was a pending job via "job.ID"
+func (r *BatchJobLegalHoldV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
+	ri := &batchJobInfo{
+		JobID:     job.ID,
+		JobType:   string(job.Type()),
+		StartTime: job.Started,
+	}
+	if err := ri.load(ctx, api, job); err != nil {
+		return err
+	}
+
+	globalBatchJobsMetrics.save(job.ID, ri)
+	lastObject := ri.Object
+
+	delay := job.LegalHold.Flags.Retry.Delay
+	if delay == 0 {
+		delay = batchLegalHoldJobDefaultRetryDelay
+	}
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	skip := func(info FileInfo) (ok bool) {
+		for _, prefix := range r.Flags.Filter.ExcludePrefixes {
+			if strings.HasPrefix(info.Name, prefix) {
+				// skip all objects under the excluded prefixes
+				return false
+			}
+		}
+
+		for _, pattern := range r.Flags.Filter.ExcludeNames {
+			if wildcard.Match(pattern, info.Name) {
+				// skip all objects matching the excluded name patterns
+				return false
+			}
+		}
+
+		if r.Flags.Filter.OlderThan > 0 && time.Since(info.ModTime) < r.Flags.Filter.OlderThan {
+			// skip all objects that are newer than specified older duration
+			return false
+		}
+
+		if r.Flags.Filter.NewerThan > 0 && time.Since(info.ModTime) >= r.Flags.Filter.NewerThan {
+			// skip all objects that are older than specified newer duration
+			return false
+		}
+
+		if !r.Flags.Filter.CreatedAfter.IsZero() && r.Flags.Filter.CreatedAfter.Before(info.ModTime) {
+			// skip all objects that are created before the specified time.
+			return false
+		}
+
+		if !r.Flags.Filter.CreatedBefore.IsZero() && r.Flags.Filter.CreatedBefore.After(info.ModTime) {
+			// skip all objects that are created after the specified time.
+			return false
+		}
+
+		if len(r.Flags.Filter.Tags) > 0 {
+			// Only parse object tags if tags filter is specified.
+			tagMap := map[string]string{}
+			tagStr := info.Metadata[xhttp.AmzObjectTagging]
+			if len(tagStr) != 0 {
+				t, err := tags.ParseObjectTags(tagStr)
+				if err != nil {
+					return false
+				}
+				tagMap = t.ToMap()
+			}
+
+			for _, kv := range r.Flags.Filter.Tags {
+				for t, v := range tagMap {
+					if kv.Match(BatchKeyRotateKV{Key: t, Value: v}) {
+						return true
+					}
+				}
+			}
+
+			// None of the provided tags filter match skip the object
+			return false
+		}
+
+		if len(r.Flags.Filter.Metadata) > 0 {
+			for _, kv := range r.Flags.Filter.Metadata {
+				for k, v := range info.Metadata {
+					if !strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") && !isStandardHeader(k) {
+						continue
+					}
+					// We only need to match x-amz-meta or standardHeaders
+					if kv.Match(BatchKeyRotateKV{Key: k, Value: v}) {
+						return true
+					}
+				}
+			}
+
+			// None of the provided metadata filters match skip the object.
+			return false
+		}
+		return true
+	}
+
+	workerSize, err := strconv.Atoi(env.Get("_MINIO_BATCH_LEGALHOLD_WORKERS", strconv.Itoa(runtime.GOMAXPROCS(0)/2)))
+	if err != nil {
+		return err
+	}
+
+	wk, err := workers.New(workerSize)
+	if err != nil {
+		// invalid worker size.
+		return err
+	}
+
+	retryAttempts := ri.RetryAttempts
+	ctx, cancel := context.WithCancel(ctx)
+
+	// Push the simple tag/metadata checks down to the walk, entries that
+	// cannot match them are skipped without decoding all their versions.
+	var pdTags, pdMeta []WalkPushdownKV
+	for _, kv := range r.Flags.Filter.Tags {
+		pdTags = append(pdTags, WalkPushdownKV{Key: kv.Key, Value: kv.Value})
+	}
+	for _, kv := range r.Flags.Filter.Metadata {
+		pdMeta = append(pdMeta, WalkPushdownKV{Key: kv.Key, Value: kv.Value})
+	}
+
+	results := make(chan ObjectInfo, 100)
+	if err := api.Walk(ctx, r.Bucket, r.Prefix, results, ObjectOptions{
+		WalkMarker:          lastObject,
+		WalkFilter:          skip,
+		WalkExcludePrefixes: r.Flags.Filter.ExcludePrefixes,
+		WalkPushdown:        newWalkPushdownFilter(pdTags, pdMeta),
+	}); err != nil {
+		cancel()
+		// Do not need to retry if we can't list objects on source.
+		return err
+	}
+
+	for result := range results {
+		result := result
+		wk.Take()
+		go func() {
+			defer wk.Give()
+			for attempts := 1; attempts <= retryAttempts; attempts++ {
+				attempts := attempts
+				success := true
+				if err := r.SetLegalHold(ctx, api, result, job.User); err != nil {
+					logger.LogIf(ctx, err)
+					success = false
+				}
+				ri.trackCurrentBucketObject(r.Bucket, result, success)
+				ri.RetryAttempts = attempts
+				globalBatchJobsMetrics.save(job.ID, ri)
+				// persist in-memory state to disk after every 10secs.
+				logger.LogIf(ctx, ri.updateAfter(ctx, api, 10*time.Second, job))
+				if success {
+					break
+				}
+			}
+		}()
+	}
+	wk.Wait()
+
+	ri.finalize()
+	globalBatchJobsMetrics.save(job.ID, ri)
+	// persist in-memory state to disk.
+	logger.LogIf(ctx, ri.updateAfter(ctx, api, 0, job))
+
+	buf, _ := json.Marshal(ri)
+	if err := r.Notify(ctx, bytes.NewReader(buf)); err != nil {
+		logger.LogIf(ctx, fmt.Errorf("unable to notify %v", err))
+	}
+
+	cancel()
+	if ri.Failed {
+		ri.ObjectsFailed = 0
+		ri.Bucket = ""
+		ri.Object = ""
+		ri.Objects = 0
+		time.Sleep(delay + time.Duration(rnd.Float64()*float64(delay)))
+	}
+
+	return nil
+}
+
+// Validate validates the job definition input
+func (r *BatchJobLegalHoldV1) Validate(ctx context.Context, job BatchJobRequest, o ObjectLayer) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.APIVersion != batchLegalHoldAPIVersion {
+		return errInvalidArgument
+	}
+
+	if r.Bucket == "" {
+		return errInvalidArgument
+	}
+
+	if _, err := o.GetBucketInfo(ctx, r.Bucket, BucketOptions{}); err != nil {
+		if isErrBucketNotFound(err) {
+			return batchLegalHoldJobError{
+				Code:           "NoSuchSourceBucket",
+				Description:    "The specified source bucket does not exist",
+				HTTPStatusCode: http.StatusNotFound,
+			}
+		}
+		return err
+	}
+
+	if rcfg, _ := globalBucketObjectLockSys.Get(r.Bucket); !rcfg.LockEnabled {
+		// Legal hold requires the bucket to be created with object
+		// lock, fail the job up front instead of on every object.
+		return batchLegalHoldJobError{
+			Code:           "InvalidBucketObjectLockConfiguration",
+			Description:    "Bucket is missing ObjectLockConfiguration",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if !objectlock.LegalHoldStatus(strings.ToUpper(r.Status)).Valid() {
+		return batchLegalHoldJobError{
+			Code:           "InvalidLegalHoldStatus",
+			Description:    "Legal hold status must be either ON or OFF",
+			HTTPStatusCode: http.StatusBadRequest,
+		}
+	}
+
+	if r.Flags.Filter.KMSKeyID != "" {
+		// kmskey belongs to the keyrotate filter surface and is not
+		// applicable to legal hold.
+		return errInvalidArgument
+	}
+
+	for _, tag := range r.Flags.Filter.Tags {
+		if err := tag.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, meta := range r.Flags.Filter.Metadata {
+		if err := meta.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, pattern := range r.Flags.Filter.ExcludeNames {
+		// reject patterns that exclude every object.
+		if pattern == "" || pattern == "*" || pattern == "**" {
+			return errInvalidArgument
+		}
+	}
+
+	for _, prefix := range r.Flags.Filter.ExcludePrefixes {
+		// reject excludes that cover the whole include prefix, the
+		// job would touch nothing.
+		if prefix == "" || strings.HasPrefix(r.Prefix, prefix) {
+			return errInvalidArgument
+		}
+	}
+
+	if err := r.Flags.Notify.Validate(); err != nil {
+		return err
+	}
+
+	return r.Flags.Retry.Validate()
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/env"
+)
+
+// Defaults for the batch notify circuit breaker, overridable through the
+// corresponding environment variables.
+const (
+	batchNotifyFailureThreshold = 3
+	batchNotifyFailureWindow    = time.Minute
+	batchNotifyCooldown         = 5 * time.Minute
+)
+
+// batchNotifyCircuitBreaker suppresses notification deliveries to an
+// endpoint after a run of consecutive failures within a window, so an
+// endpoint that is down for an extended period does not slow the job's
+// completion path. After the cool-down a single probe is let through,
+// a success closes the breaker again.
+type batchNotifyCircuitBreaker struct {
+	mu        sync.Mutex
+	endpoint  string
+	threshold int
+	window    time.Duration
+	cooldown  time.Duration
+
+	failures  int
+	firstFail time.Time
+	openedAt  time.Time
+}
+
+var batchNotifyBreakers = struct {
+	sync.Mutex
+	m map[string]*batchNotifyCircuitBreaker
+}{m: make(map[string]*batchNotifyCircuitBreaker)}
+
+// batchNotifyBreakerFor returns the circuit breaker guarding deliveries
+// to the given notify endpoint, shared across jobs pointing at it.
+func batchNotifyBreakerFor(endpoint string) *batchNotifyCircuitBreaker {
+	batchNotifyBreakers.Lock()
+	defer batchNotifyBreakers.Unlock()
+	if b, ok := batchNotifyBreakers.m[endpoint]; ok {
+		return b
+	}
+	threshold, err := strconv.Atoi(env.Get("_MINIO_BATCH_NOTIFY_FAILURE_THRESHOLD", strconv.Itoa(batchNotifyFailureThreshold)))
+	if err != nil || threshold <= 0 {
+		threshold = batchNotifyFailureThreshold
+	}
+	window, err := time.ParseDuration(env.Get("_MINIO_BATCH_NOTIFY_FAILURE_WINDOW", batchNotifyFailureWindow.String()))
+	if err != nil || window <= 0 {
+		window = batchNotifyFailureWindow
+	}
+	cooldown, err := time.ParseDuration(env.Get("_MINIO_BATCH_NOTIFY_COOLDOWN", batchNotifyCooldown.String()))
+	if err != nil || cooldown <= 0 {
+		cooldown = batchNotifyCooldown
+	}
+	b := &batchNotifyCircuitBreaker{
+		endpoint:  endpoint,
+		threshold: threshold,
+		window:    window,
+		cooldown:  cooldown,
+	}
+	batchNotifyBreakers.m[endpoint] = b
+	return b
+}
+
+// allow reports whether a delivery attempt may proceed. While open only
+// a probe after the cool-down is allowed.
+func (b *batchNotifyCircuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.openedAt.IsZero() {
+		return true
+	}
+	return time.Since(b.openedAt) >= b.cooldown
+}
+
+// success records a delivered notification and closes the breaker if it
+// was open.
+func (b *batchNotifyCircuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.openedAt.IsZero() {
+		logger.Info("batch notify endpoint %s is reachable again, resuming notifications", b.endpoint)
+	}
+	b.failures = 0
+	b.firstFail = time.Time{}
+	b.openedAt = time.Time{}
+}
+
+// failure records a failed delivery, opening the breaker once the
+// threshold of consecutive failures within the window is reached. A
+// failed probe while open restarts the cool-down.
+func (b *batchNotifyCircuitBreaker) failure(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	if !b.openedAt.IsZero() {
+		b.openedAt = now
+		return
+	}
+	if b.failures == 0 || now.Sub(b.firstFail) > b.window {
+		b.failures = 0
+		b.firstFail = now
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = now
+		logger.LogIf(ctx, fmt.Errorf("batch notify endpoint %s failed %d times within %s, suspending notifications for %s", b.endpoint, b.failures, b.window, b.cooldown))
+	}
+}
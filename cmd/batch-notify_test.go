@@ -0,0 +1,92 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBatchNotifyCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	b := &batchNotifyCircuitBreaker{
+		endpoint:  "http://localhost:0/notify",
+		threshold: 3,
+		window:    time.Minute,
+		cooldown:  5 * time.Minute,
+	}
+
+	// Below the threshold deliveries keep flowing.
+	for i := 0; i < 2; i++ {
+		if !b.allow() {
+			t.Fatalf("expected the breaker to allow delivery %d", i)
+		}
+		b.failure(ctx)
+	}
+	if !b.allow() {
+		t.Fatal("expected the breaker to still be closed below the threshold")
+	}
+
+	// The third consecutive failure within the window opens it.
+	b.failure(ctx)
+	if b.allow() {
+		t.Fatal("expected the breaker to be open after reaching the threshold")
+	}
+
+	// After the cool-down a probe is let through, a failed probe
+	// restarts the cool-down.
+	b.openedAt = time.Now().Add(-b.cooldown)
+	if !b.allow() {
+		t.Fatal("expected a probe to be allowed after the cool-down")
+	}
+	b.failure(ctx)
+	if b.allow() {
+		t.Fatal("expected a failed probe to re-open the breaker")
+	}
+
+	// A successful probe closes the breaker again.
+	b.openedAt = time.Now().Add(-b.cooldown)
+	if !b.allow() {
+		t.Fatal("expected a probe to be allowed after the cool-down")
+	}
+	b.success()
+	if !b.allow() {
+		t.Fatal("expected the breaker to be closed after a successful probe")
+	}
+
+	// A success resets the consecutive failure run.
+	b.failure(ctx)
+	b.failure(ctx)
+	b.success()
+	b.failure(ctx)
+	b.failure(ctx)
+	if !b.allow() {
+		t.Fatal("expected the breaker to stay closed when failures are not consecutive")
+	}
+
+	// Failures further apart than the window do not accumulate.
+	b.success()
+	b.failure(ctx)
+	b.failure(ctx)
+	b.firstFail = time.Now().Add(-2 * b.window)
+	b.failure(ctx)
+	if !b.allow() {
+		t.Fatal("expected the breaker to stay closed when failures fall outside the window")
+	}
+}
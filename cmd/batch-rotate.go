@@ -30,17 +30,23 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/hash"
 	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/kms"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/pkg/env"
+	xnet "github.com/minio/pkg/net"
 	"github.com/minio/pkg/wildcard"
 	"github.com/minio/pkg/workers"
+	"golang.org/x/net/http/httpguts"
+	"golang.org/x/time/rate"
 )
 
 // keyrotate:
@@ -66,14 +72,32 @@ import (
 //       - key: "content-type"
 //         value: "image/*" # match objects with 'content-type', with all values starting with 'image/'
 //     kmskey: "key-id" # match objects with KMS key-id (applicable only for sse-kms)
+//     excludePrefixes:
+//       - "logs/" # do not rotate objects under these prefixes
+//     excludeNames:
+//       - "*.tmp" # do not rotate objects whose names match these wildcard patterns
 //   notify:
 //     endpoint: "https://notify.endpoint" # notification endpoint to receive job status events
 //     token: "Bearer xxxxx" # optional authentication token for the notification endpoint
+//     headers:
+//       x-tenant-id: "tenant1" # optional additional headers set on the notification request
+
+//   verify:
+//     enable: true # re-read the object after rotation to confirm decryptability
+//     rangeSize: 1048576 # optionally decrypt this many leading bytes as well
+
+//   verifyOnly: true # only verify that objects decrypt under their current key, rotate nothing
 
 //   retry:
 //     attempts: 10 # number of retries for the job before giving up
 //     delay: "500ms" # least amount of delay between each retry
 
+//   maxPause: "30m" # fail the job if a KMS outage keeps it paused longer than this, 0 waits forever
+
+//   objectsPerSecond: 100 # rate limit the job to this many objects per second, 0 is unlimited
+
+//   forceRotate: true # rotate objects even if this job already rotated them, for full re-runs
+
 //go:generate msgp -file $GOFILE -unexported
 
 // BatchKeyRotateKV is a datatype that holds key and values for filtering of objects
@@ -126,6 +150,24 @@ func (r BatchKeyRotateRetry) Validate() error {
 	return nil
 }
 
+// BatchKeyRotateVerify datatype enables re-reading the object after its key
+// was rotated to confirm it is still decryptable under the new key, the
+// attempt is failed if it is not. RangeSize > 0 additionally decrypts that
+// many leading bytes of content.
+type BatchKeyRotateVerify struct {
+	Enable    bool  `yaml:"enable" json:"enable"`       // verify object post rotation
+	RangeSize int64 `yaml:"rangeSize" json:"rangeSize"` // number of leading bytes to decrypt
+}
+
+// Validate validates input verification options.
+func (v BatchKeyRotateVerify) Validate() error {
+	if v.RangeSize < 0 {
+		return errInvalidArgument
+	}
+
+	return nil
+}
+
 // BatchKeyRotationType defines key rotation type
 type BatchKeyRotationType string
 
@@ -178,29 +220,66 @@ func (e BatchJobKeyRotateEncryption) Validate() error {
 
 // BatchKeyRotateFilter holds all the filters currently supported for batch replication
 type BatchKeyRotateFilter struct {
-	NewerThan     time.Duration      `yaml:"newerThan,omitempty" json:"newerThan"`
-	OlderThan     time.Duration      `yaml:"olderThan,omitempty" json:"olderThan"`
-	CreatedAfter  time.Time          `yaml:"createdAfter,omitempty" json:"createdAfter"`
-	CreatedBefore time.Time          `yaml:"createdBefore,omitempty" json:"createdBefore"`
-	Tags          []BatchKeyRotateKV `yaml:"tags,omitempty" json:"tags"`
-	Metadata      []BatchKeyRotateKV `yaml:"metadata,omitempty" json:"metadata"`
-	KMSKeyID      string             `yaml:"kmskeyid" json:"kmskey"`
+	NewerThan       time.Duration      `yaml:"newerThan,omitempty" json:"newerThan"`
+	OlderThan       time.Duration      `yaml:"olderThan,omitempty" json:"olderThan"`
+	CreatedAfter    time.Time          `yaml:"createdAfter,omitempty" json:"createdAfter"`
+	CreatedBefore   time.Time          `yaml:"createdBefore,omitempty" json:"createdBefore"`
+	Tags            []BatchKeyRotateKV `yaml:"tags,omitempty" json:"tags"`
+	Metadata        []BatchKeyRotateKV `yaml:"metadata,omitempty" json:"metadata"`
+	KMSKeyID        string             `yaml:"kmskeyid" json:"kmskey"`
+	ExcludePrefixes []string           `yaml:"excludePrefixes,omitempty" json:"excludePrefixes"`
+	ExcludeNames    []string           `yaml:"excludeNames,omitempty" json:"excludeNames"`
 }
 
 // BatchKeyRotateNotification success or failure notification endpoint for each job attempts
 type BatchKeyRotateNotification struct {
-	Endpoint string `yaml:"endpoint" json:"endpoint"`
-	Token    string `yaml:"token" json:"token"`
+	Endpoint string            `yaml:"endpoint" json:"endpoint"`
+	Token    string            `yaml:"token" json:"token"`
+	Headers  map[string]string `yaml:"headers,omitempty" json:"headers"`
+}
+
+// Validate validates the notification headers carry well formed names
+// and values, they are set verbatim on the outgoing request.
+func (n BatchKeyRotateNotification) Validate() error {
+	for k, v := range n.Headers {
+		if !httpguts.ValidHeaderFieldName(k) || !httpguts.ValidHeaderFieldValue(v) {
+			return errInvalidArgument
+		}
+	}
+	return nil
 }
 
 // BatchJobKeyRotateFlags various configurations for replication job definition currently includes
 // - filter
 // - notify
 // - retry
+// - verify
+// - objectsPerSecond
 type BatchJobKeyRotateFlags struct {
 	Filter BatchKeyRotateFilter       `yaml:"filter" json:"filter"`
 	Notify BatchKeyRotateNotification `yaml:"notify" json:"notify"`
 	Retry  BatchKeyRotateRetry        `yaml:"retry" json:"retry"`
+	Verify BatchKeyRotateVerify       `yaml:"verify" json:"verify"`
+
+	// ObjectsPerSecond rate limits the job to this many objects per
+	// second across all workers, protecting foreground traffic during
+	// mass rotations. Zero means no limit.
+	ObjectsPerSecond int `yaml:"objectsPerSecond" json:"objectsPerSecond"`
+
+	// ForceRotate rotates objects even if they already carry this
+	// job's rotation marker, for forced full re-runs.
+	ForceRotate bool `yaml:"forceRotate" json:"forceRotate"`
+
+	// VerifyOnly turns the job into a read-only integrity pass: every
+	// matching object is checked for decryptability under its current
+	// key (honoring verify.rangeSize for content sampling) and nothing
+	// is rewritten. The encryption target is ignored in this mode.
+	VerifyOnly bool `yaml:"verifyOnly" json:"verifyOnly"`
+
+	// MaxPause bounds how long the job stays paused while the KMS is
+	// unreachable before the pause is converted into a job failure.
+	// Zero pauses indefinitely until the KMS recovers.
+	MaxPause time.Duration `yaml:"maxPause" json:"maxPause"`
 }
 
 // BatchJobKeyRotateV1 v1 of batch key rotation job
@@ -211,14 +290,38 @@ type BatchJobKeyRotateV1 struct {
 	Prefix     string                      `yaml:"prefix" json:"prefix"`
 	Endpoint   string                      `yaml:"endpoint" json:"endpoint"`
 	Encryption BatchJobKeyRotateEncryption `yaml:"encryption" json:"encryption"`
+
+	jobID string `msg:"-"`
 }
 
+// batchKeyRotationJobMarker is stamped on rotated objects so a re-run of
+// the same job can skip them without reprocessing, unless forceRotate is
+// set.
+const batchKeyRotationJobMarker = ReservedMetadataPrefixLower + "rotation-job"
+
 // Notify notifies notification endpoint if configured regarding job failure or success.
 func (r BatchJobKeyRotateV1) Notify(ctx context.Context, body io.Reader) error {
 	if r.Flags.Notify.Endpoint == "" {
 		return nil
 	}
 
+	// Deliveries are skipped while the endpoint's circuit breaker is
+	// open, so a down endpoint does not slow the job's completion path.
+	breaker := batchNotifyBreakerFor(r.Flags.Notify.Endpoint)
+	if !breaker.allow() {
+		return nil
+	}
+
+	if err := r.notify(ctx, body); err != nil {
+		breaker.failure(ctx)
+		return err
+	}
+	breaker.success()
+	return nil
+}
+
+// notify performs a single delivery to the configured notify endpoint.
+func (r BatchJobKeyRotateV1) notify(ctx context.Context, body io.Reader) error {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
@@ -231,6 +334,10 @@ func (r BatchJobKeyRotateV1) Notify(ctx context.Context, body io.Reader) error {
 		req.Header.Set("Authorization", r.Flags.Notify.Token)
 	}
 
+	for k, v := range r.Flags.Notify.Headers {
+		req.Header.Set(k, v)
+	}
+
 	clnt := http.Client{Transport: getRemoteInstanceTransport}
 	resp, err := clnt.Do(req)
 	if err != nil {
@@ -245,6 +352,73 @@ func (r BatchJobKeyRotateV1) Notify(ctx context.Context, body io.Reader) error {
 	return nil
 }
 
+// Objects larger than this are rotated through the multipart copy
+// machinery, some backends cannot copy a bigger object in a single shot.
+const batchKeyRotateMultipartSize = 5 * humanize.GiByte
+
+// rotateMultipart rewrites the object through the multipart copy
+// machinery, one server-side copy per original part. The part boundaries
+// are preserved so the resulting multipart ETag stays stable, and the
+// version ID is carried over exactly as the single-shot path does. Note
+// that key rotation reseals the object key without touching the data
+// key, so the raw encrypted stream can be copied as is.
+func (r *BatchJobKeyRotateV1) rotateMultipart(ctx context.Context, api ObjectLayer, oi ObjectInfo) (err error) {
+	res, err := api.NewMultipartUpload(ctx, r.Bucket, oi.Name, ObjectOptions{
+		VersionID:   oi.VersionID,
+		UserDefined: oi.UserDefined,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			api.AbortMultipartUpload(ctx, r.Bucket, oi.Name, res.UploadID, ObjectOptions{})
+		}
+	}()
+
+	var uploadedParts []CompletePart
+	var startOffset int64
+	for _, part := range oi.Parts {
+		rs := &HTTPRangeSpec{Start: startOffset, End: startOffset + part.Size - 1}
+		gr, err := api.GetObjectNInfo(ctx, r.Bucket, oi.Name, rs, http.Header{}, ObjectOptions{
+			VersionID: oi.VersionID,
+			NoLock:    true,
+		})
+		if err != nil {
+			return err
+		}
+		hr, err := hash.NewReader(io.LimitReader(gr, part.Size), part.Size, "", "", part.Size)
+		if err != nil {
+			gr.Close()
+			return err
+		}
+		srcInfo := oi
+		srcInfo.PutObjReader = NewPutObjReader(hr)
+		pi, err := api.CopyObjectPart(ctx, r.Bucket, oi.Name, r.Bucket, oi.Name, res.UploadID,
+			part.Number, startOffset, part.Size, srcInfo, ObjectOptions{
+				VersionID: oi.VersionID,
+			}, ObjectOptions{})
+		gr.Close()
+		if err != nil {
+			return err
+		}
+		if pi.Size != part.Size {
+			return InvalidObjectState{Bucket: r.Bucket, Object: oi.Name}
+		}
+		startOffset += part.Size
+		uploadedParts = append(uploadedParts, CompletePart{
+			PartNumber: pi.PartNumber,
+			ETag:       pi.ETag,
+		})
+	}
+	_, err = api.CompleteMultipartUpload(ctx, r.Bucket, oi.Name, res.UploadID, uploadedParts, ObjectOptions{
+		VersionID: oi.VersionID,
+		MTime:     oi.ModTime,
+		NoLock:    true,
+	})
+	return err
+}
+
 // KeyRotate rotates encryption key of an object
 func (r *BatchJobKeyRotateV1) KeyRotate(ctx context.Context, api ObjectLayer, objInfo ObjectInfo) error {
 	srcBucket := r.Bucket
@@ -258,11 +432,22 @@ func (r *BatchJobKeyRotateV1) KeyRotate(ctx context.Context, api ObjectLayer, ob
 	if !sseKMS && !sseS3 { // neither sse-s3 nor sse-kms disallowed
 		return errInvalidEncryptionParameters
 	}
+	versioned := globalBucketVersioningSys.PrefixEnabled(srcBucket, srcObject)
+	versionSuspended := globalBucketVersioningSys.PrefixSuspended(srcBucket, srcObject)
+
+	if r.Flags.VerifyOnly {
+		// Read-only integrity pass, confirm the object decrypts under
+		// its current key without rewriting anything.
+		return r.VerifyKeyRotation(ctx, api, objInfo.Name, ObjectOptions{
+			VersionID:        objInfo.VersionID,
+			Versioned:        versioned,
+			VersionSuspended: versionSuspended,
+		})
+	}
+
 	if sseKMS && r.Encryption.Type == sses3 { // previously encrypted with sse-kms, now sse-s3 disallowed
 		return errInvalidEncryptionParameters
 	}
-	versioned := globalBucketVersioningSys.PrefixEnabled(srcBucket, srcObject)
-	versionSuspended := globalBucketVersioningSys.PrefixSuspended(srcBucket, srcObject)
 
 	lock := api.NewNSLock(r.Bucket, objInfo.Name)
 	lkctx, err := lock.GetLock(ctx, globalOperationTimeout)
@@ -311,7 +496,16 @@ func (r *BatchJobKeyRotateV1) KeyRotate(ctx context.Context, api ObjectLayer, ob
 	for k, v := range encMetadata {
 		oi.UserDefined[k] = v
 	}
-	if _, err := api.CopyObject(ctx, r.Bucket, oi.Name, r.Bucket, oi.Name, oi, ObjectOptions{
+	if r.jobID != "" {
+		oi.UserDefined[batchKeyRotationJobMarker] = r.jobID
+	}
+	if oi.Size > batchKeyRotateMultipartSize && len(oi.Parts) > 1 {
+		// A single-shot copy of an object this large fails or blows
+		// memory on some backends, rotate part by part instead.
+		if err := r.rotateMultipart(ctx, api, oi); err != nil {
+			return err
+		}
+	} else if _, err := api.CopyObject(ctx, r.Bucket, oi.Name, r.Bucket, oi.Name, oi, ObjectOptions{
 		VersionID: oi.VersionID,
 	}, ObjectOptions{
 		VersionID: oi.VersionID,
@@ -320,6 +514,56 @@ func (r *BatchJobKeyRotateV1) KeyRotate(ctx context.Context, api ObjectLayer, ob
 		return err
 	}
 
+	if r.Flags.Verify.Enable {
+		return r.VerifyKeyRotation(ctx, api, oi.Name, ObjectOptions{
+			VersionID:        oi.VersionID,
+			Versioned:        versioned,
+			VersionSuspended: versionSuspended,
+			NoLock:           true,
+		})
+	}
+
+	return nil
+}
+
+// VerifyKeyRotation re-reads the object to confirm it is decryptable
+// under its current key, either right after rotation or as part of a
+// verify-only pass. The object key is unsealed from the freshly read
+// metadata, and when rangeSize is set, that many leading bytes of the
+// content are decrypted as well.
+func (r *BatchJobKeyRotateV1) VerifyKeyRotation(ctx context.Context, api ObjectLayer, object string, opts ObjectOptions) error {
+	oi, err := api.GetObjectInfo(ctx, r.Bucket, object, opts)
+	if err != nil {
+		return err
+	}
+
+	if _, err := decryptObjectMeta(nil, r.Bucket, object, oi.UserDefined); err != nil {
+		return fmt.Errorf("verification failed for %s/%s(%s): %w", r.Bucket, object, oi.VersionID, err)
+	}
+
+	length := r.Flags.Verify.RangeSize
+	if length > oi.Size {
+		length = oi.Size
+	}
+	if length <= 0 {
+		return nil
+	}
+
+	gr, err := api.GetObjectNInfo(ctx, r.Bucket, object, nil, http.Header{}, opts)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	decrd, err := newDecryptReader(gr, nil, r.Bucket, object, 0, oi.UserDefined)
+	if err != nil {
+		return fmt.Errorf("verification failed for %s/%s(%s): %w", r.Bucket, object, oi.VersionID, err)
+	}
+
+	if _, err := io.CopyN(io.Discard, decrd, length); err != nil {
+		return fmt.Errorf("verification failed for %s/%s(%s): %w", r.Bucket, object, oi.VersionID, err)
+	}
+
 	return nil
 }
 
@@ -331,8 +575,112 @@ const (
 	batchKeyRotateAPIVersion           = "v1"
 	batchKeyRotateJobDefaultRetries    = 3
 	batchKeyRotateJobDefaultRetryDelay = 250 * time.Millisecond
+	batchKeyRotateKMSProbeInterval     = 15 * time.Second
 )
 
+// isKMSConnectivityErr returns true if err means the KMS itself is
+// unreachable, as opposed to a per-object failure such as an unknown
+// key ID or an unsealing error. Connectivity errors pause the whole
+// job instead of burning the object's retry attempts.
+func isKMSConnectivityErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	return xnet.IsNetworkOrHostDown(err, false) || xnet.IsConnRefusedErr(err) || xnet.IsConnResetErr(err)
+}
+
+// errKMSOutagePauseExpired is returned to paused workers once a KMS
+// outage outlived the job's configured maxPause.
+var errKMSOutagePauseExpired = errors.New("KMS was unreachable for longer than the configured maxPause")
+
+//msgp:ignore kmsOutagePauser
+
+// kmsOutagePauser coordinates a whole-job pause while the KMS is down.
+// The first worker that hits a connectivity error flips the job into
+// the paused state and probes the KMS until it answers again, every
+// other worker blocks in wait in the meantime. A zero maxPause waits
+// indefinitely.
+type kmsOutagePauser struct {
+	mu            sync.Mutex
+	resumed       chan struct{} // non-nil while a pause is in progress
+	failed        bool          // a pause outlived maxPause, the job is done for
+	maxPause      time.Duration
+	probeInterval time.Duration // batchKeyRotateKMSProbeInterval if zero
+	probe         func(context.Context) error
+	onPause       func() // checkpoint/notification hook, called once per pause
+	onResume      func() // called once per recovered pause
+}
+
+// wait blocks until the current KMS outage is over. The caller that
+// opens the pause doubles as the prober. A nil return means the KMS
+// recovered and the caller should retry its object.
+func (p *kmsOutagePauser) wait(ctx context.Context) error {
+	p.mu.Lock()
+	if p.failed {
+		p.mu.Unlock()
+		return errKMSOutagePauseExpired
+	}
+	if resumed := p.resumed; resumed != nil {
+		// Somebody else is probing already, wait the pause out.
+		p.mu.Unlock()
+		select {
+		case <-resumed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if p.jobFailed() {
+			return errKMSOutagePauseExpired
+		}
+		return nil
+	}
+	resumed := make(chan struct{})
+	p.resumed = resumed
+	p.mu.Unlock()
+
+	p.onPause()
+	interval := p.probeInterval
+	if interval <= 0 {
+		interval = batchKeyRotateKMSProbeInterval
+	}
+	pausedAt := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			p.finish(false)
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		if err := p.probe(ctx); err == nil {
+			p.finish(false)
+			p.onResume()
+			return nil
+		}
+		if p.maxPause > 0 && time.Since(pausedAt) > p.maxPause {
+			p.finish(true)
+			return errKMSOutagePauseExpired
+		}
+	}
+}
+
+// finish ends the pause in progress and releases all waiters.
+func (p *kmsOutagePauser) finish(failed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failed = p.failed || failed
+	if p.resumed != nil {
+		close(p.resumed)
+		p.resumed = nil
+	}
+}
+
+// jobFailed returns true once a pause outlived maxPause, the dispatch
+// loop stops taking new objects at that point.
+func (p *kmsOutagePauser) jobFailed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.failed
+}
+
 // Start the batch key rottion job, resumes if there was a pending job via "job.ID"
 func (r *BatchJobKeyRotateV1) Start(ctx context.Context, api ObjectLayer, job BatchJobRequest) error {
 	ri := &batchJobInfo{
@@ -346,6 +694,7 @@ func (r *BatchJobKeyRotateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 
 	globalBatchJobsMetrics.save(job.ID, ri)
 	lastObject := ri.Object
+	r.jobID = job.ID
 
 	delay := job.KeyRotate.Flags.Retry.Delay
 	if delay == 0 {
@@ -354,6 +703,26 @@ func (r *BatchJobKeyRotateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	skip := func(info FileInfo) (ok bool) {
+		if !r.Flags.ForceRotate && r.jobID != "" && info.Metadata[batchKeyRotationJobMarker] == r.jobID {
+			// skip objects this job already rotated, keeps re-runs
+			// after a restart cheap
+			return false
+		}
+
+		for _, prefix := range r.Flags.Filter.ExcludePrefixes {
+			if strings.HasPrefix(info.Name, prefix) {
+				// skip all objects under the excluded prefixes
+				return false
+			}
+		}
+
+		for _, pattern := range r.Flags.Filter.ExcludeNames {
+			if wildcard.Match(pattern, info.Name) {
+				// skip all objects matching the excluded name patterns
+				return false
+			}
+		}
+
 		if r.Flags.Filter.OlderThan > 0 && time.Since(info.ModTime) < r.Flags.Filter.OlderThan {
 			// skip all objects that are newer than specified older duration
 			return false
@@ -436,18 +805,73 @@ func (r *BatchJobKeyRotateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 	retryAttempts := ri.RetryAttempts
 	ctx, cancel := context.WithCancel(ctx)
 
+	// Push the simple tag/metadata checks down to the walk, entries that
+	// cannot match them are skipped without decoding all their versions.
+	var pdTags, pdMeta []WalkPushdownKV
+	for _, kv := range r.Flags.Filter.Tags {
+		pdTags = append(pdTags, WalkPushdownKV{Key: kv.Key, Value: kv.Value})
+	}
+	for _, kv := range r.Flags.Filter.Metadata {
+		pdMeta = append(pdMeta, WalkPushdownKV{Key: kv.Key, Value: kv.Value})
+	}
+
 	results := make(chan ObjectInfo, 100)
 	if err := api.Walk(ctx, r.Bucket, r.Prefix, results, ObjectOptions{
-		WalkMarker: lastObject,
-		WalkFilter: skip,
+		WalkMarker:          lastObject,
+		WalkFilter:          skip,
+		WalkExcludePrefixes: r.Flags.Filter.ExcludePrefixes,
+		WalkPushdown:        newWalkPushdownFilter(pdTags, pdMeta),
 	}); err != nil {
 		cancel()
 		// Do not need to retry if we can't list objects on source.
 		return err
 	}
 
+	// Token bucket between the results channel and worker dispatch,
+	// keeps the job from hammering the backend faster than the
+	// configured objects-per-second ceiling.
+	var limiter *rate.Limiter
+	if r.Flags.ObjectsPerSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(r.Flags.ObjectsPerSecond), r.Flags.ObjectsPerSecond)
+	}
+
+	// A KMS connectivity error pauses the whole job instead of failing
+	// objects one by one: workers block until the KMS answers again,
+	// the status API reports the pause and an interim notification is
+	// delivered. A pause outliving maxPause fails the job.
+	pauser := &kmsOutagePauser{
+		maxPause: r.Flags.MaxPause,
+		probe: func(ctx context.Context) error {
+			_, err := GlobalKMS.Stat(ctx)
+			return err
+		},
+		onPause: func() {
+			ri.Status = batchJobStatusPausedKMS
+			globalBatchJobsMetrics.save(job.ID, ri)
+			logger.LogIf(ctx, ri.updateAfter(ctx, api, 0, job))
+			buf, _ := json.Marshal(ri)
+			if err := r.Notify(ctx, bytes.NewReader(buf)); err != nil {
+				logger.LogIf(ctx, fmt.Errorf("unable to notify %v", err))
+			}
+		},
+		onResume: func() {
+			ri.Status = ""
+			globalBatchJobsMetrics.save(job.ID, ri)
+		},
+	}
+
 	for result := range results {
 		result := result
+		if pauser.jobFailed() {
+			// An expired KMS outage pause already failed the job, do
+			// not take new objects.
+			break
+		}
+		if limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				break
+			}
+		}
 		sseKMS := crypto.S3KMS.IsEncrypted(result.UserDefined)
 		sseS3 := crypto.S3.IsEncrypted(result.UserDefined)
 		if !sseKMS && !sseS3 { // neither sse-s3 nor sse-kms disallowed
@@ -460,8 +884,29 @@ func (r *BatchJobKeyRotateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 				attempts := attempts
 				stopFn := globalBatchJobsMetrics.trace(batchKeyRotationMetricObject, job.ID, attempts, result)
 				success := true
-				if err := r.KeyRotate(ctx, api, result); err != nil {
+				err := r.KeyRotate(ctx, api, result)
+				for err != nil && isKMSConnectivityErr(err) {
+					// The KMS itself is unreachable, not a problem
+					// with this object: pause the job and retry the
+					// same object once the KMS is back, without
+					// burning the attempt.
+					if perr := pauser.wait(ctx); perr != nil {
+						err = perr
+						break
+					}
+					err = r.KeyRotate(ctx, api, result)
+				}
+				if err != nil {
 					stopFn(err)
+					op := "key rotation"
+					if r.Flags.VerifyOnly {
+						op = "key verification"
+					}
+					if result.VersionID != "" {
+						err = fmt.Errorf("%s failed for %s/%s (%s): %w", op, r.Bucket, result.Name, result.VersionID, err)
+					} else {
+						err = fmt.Errorf("%s failed for %s/%s: %w", op, r.Bucket, result.Name, err)
+					}
 					logger.LogIf(ctx, err)
 					success = false
 				} else {
@@ -475,13 +920,16 @@ func (r *BatchJobKeyRotateV1) Start(ctx context.Context, api ObjectLayer, job Ba
 				if success {
 					break
 				}
+				if errors.Is(err, errKMSOutagePauseExpired) {
+					// Retrying would only re-enter the expired pause.
+					break
+				}
 			}
 		}()
 	}
 	wk.Wait()
 
-	ri.Complete = ri.ObjectsFailed == 0
-	ri.Failed = ri.ObjectsFailed > 0
+	ri.finalize()
 	globalBatchJobsMetrics.save(job.ID, ri)
 	// persist in-memory state to disk.
 	logger.LogIf(ctx, ri.updateAfter(ctx, api, 0, job))
@@ -541,8 +989,12 @@ func (r *BatchJobKeyRotateV1) Validate(ctx context.Context, job BatchJobRequest,
 	if GlobalKMS == nil {
 		return errKMSNotConfigured
 	}
-	if err := r.Encryption.Validate(); err != nil {
-		return err
+	if !r.Flags.VerifyOnly {
+		// A verification pass rotates nothing, no target encryption is
+		// required or consulted.
+		if err := r.Encryption.Validate(); err != nil {
+			return err
+		}
 	}
 
 	for _, tag := range r.Flags.Filter.Tags {
@@ -557,8 +1009,39 @@ func (r *BatchJobKeyRotateV1) Validate(ctx context.Context, job BatchJobRequest,
 		}
 	}
 
+	for _, pattern := range r.Flags.Filter.ExcludeNames {
+		// reject patterns that exclude every object.
+		if pattern == "" || pattern == "*" || pattern == "**" {
+			return errInvalidArgument
+		}
+	}
+
+	for _, prefix := range r.Flags.Filter.ExcludePrefixes {
+		// reject excludes that cover the whole include prefix, the
+		// job would rotate nothing.
+		if prefix == "" || strings.HasPrefix(r.Prefix, prefix) {
+			return errInvalidArgument
+		}
+	}
+
+	if err := r.Flags.Notify.Validate(); err != nil {
+		return err
+	}
+
 	if err := r.Flags.Retry.Validate(); err != nil {
 		return err
 	}
+
+	if err := r.Flags.Verify.Validate(); err != nil {
+		return err
+	}
+
+	if r.Flags.ObjectsPerSecond < 0 {
+		return errInvalidArgument
+	}
+
+	if r.Flags.MaxPause < 0 {
+		return errInvalidArgument
+	}
 	return nil
 }
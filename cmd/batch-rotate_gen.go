@@ -192,75 +192,105 @@ func (z *BatchJobKeyRotateFlags) DecodeMsg(dc *msgp.Reader) (err error) {
 				return
 			}
 		case "Notify":
+			err = z.Notify.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Notify")
+				return
+			}
+		case "Retry":
 			var zb0002 uint32
 			zb0002, err = dc.ReadMapHeader()
 			if err != nil {
-				err = msgp.WrapError(err, "Notify")
+				err = msgp.WrapError(err, "Retry")
 				return
 			}
 			for zb0002 > 0 {
 				zb0002--
 				field, err = dc.ReadMapKeyPtr()
 				if err != nil {
-					err = msgp.WrapError(err, "Notify")
+					err = msgp.WrapError(err, "Retry")
 					return
 				}
 				switch msgp.UnsafeString(field) {
-				case "Endpoint":
-					z.Notify.Endpoint, err = dc.ReadString()
+				case "Attempts":
+					z.Retry.Attempts, err = dc.ReadInt()
 					if err != nil {
-						err = msgp.WrapError(err, "Notify", "Endpoint")
+						err = msgp.WrapError(err, "Retry", "Attempts")
 						return
 					}
-				case "Token":
-					z.Notify.Token, err = dc.ReadString()
+				case "Delay":
+					z.Retry.Delay, err = dc.ReadDuration()
 					if err != nil {
-						err = msgp.WrapError(err, "Notify", "Token")
+						err = msgp.WrapError(err, "Retry", "Delay")
 						return
 					}
 				default:
 					err = dc.Skip()
 					if err != nil {
-						err = msgp.WrapError(err, "Notify")
+						err = msgp.WrapError(err, "Retry")
 						return
 					}
 				}
 			}
-		case "Retry":
+		case "Verify":
 			var zb0003 uint32
 			zb0003, err = dc.ReadMapHeader()
 			if err != nil {
-				err = msgp.WrapError(err, "Retry")
+				err = msgp.WrapError(err, "Verify")
 				return
 			}
 			for zb0003 > 0 {
 				zb0003--
 				field, err = dc.ReadMapKeyPtr()
 				if err != nil {
-					err = msgp.WrapError(err, "Retry")
+					err = msgp.WrapError(err, "Verify")
 					return
 				}
 				switch msgp.UnsafeString(field) {
-				case "Attempts":
-					z.Retry.Attempts, err = dc.ReadInt()
+				case "Enable":
+					z.Verify.Enable, err = dc.ReadBool()
 					if err != nil {
-						err = msgp.WrapError(err, "Retry", "Attempts")
+						err = msgp.WrapError(err, "Verify", "Enable")
 						return
 					}
-				case "Delay":
-					z.Retry.Delay, err = dc.ReadDuration()
+				case "RangeSize":
+					z.Verify.RangeSize, err = dc.ReadInt64()
 					if err != nil {
-						err = msgp.WrapError(err, "Retry", "Delay")
+						err = msgp.WrapError(err, "Verify", "RangeSize")
 						return
 					}
 				default:
 					err = dc.Skip()
 					if err != nil {
-						err = msgp.WrapError(err, "Retry")
+						err = msgp.WrapError(err, "Verify")
 						return
 					}
 				}
 			}
+		case "ObjectsPerSecond":
+			z.ObjectsPerSecond, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsPerSecond")
+				return
+			}
+		case "ForceRotate":
+			z.ForceRotate, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "ForceRotate")
+				return
+			}
+		case "VerifyOnly":
+			z.VerifyOnly, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "VerifyOnly")
+				return
+			}
+		case "MaxPause":
+			z.MaxPause, err = dc.ReadDuration()
+			if err != nil {
+				err = msgp.WrapError(err, "MaxPause")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -274,9 +304,9 @@ func (z *BatchJobKeyRotateFlags) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *BatchJobKeyRotateFlags) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 3
+	// map header, size 8
 	// write "Filter"
-	err = en.Append(0x83, 0xa6, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72)
+	err = en.Append(0x88, 0xa6, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72)
 	if err != nil {
 		return
 	}
@@ -290,25 +320,9 @@ func (z *BatchJobKeyRotateFlags) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	// map header, size 2
-	// write "Endpoint"
-	err = en.Append(0x82, 0xa8, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.Notify.Endpoint)
+	err = z.Notify.EncodeMsg(en)
 	if err != nil {
-		err = msgp.WrapError(err, "Notify", "Endpoint")
-		return
-	}
-	// write "Token"
-	err = en.Append(0xa5, 0x54, 0x6f, 0x6b, 0x65, 0x6e)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.Notify.Token)
-	if err != nil {
-		err = msgp.WrapError(err, "Notify", "Token")
+		err = msgp.WrapError(err, "Notify")
 		return
 	}
 	// write "Retry"
@@ -337,15 +351,81 @@ func (z *BatchJobKeyRotateFlags) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Retry", "Delay")
 		return
 	}
+	// write "Verify"
+	err = en.Append(0xa6, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79)
+	if err != nil {
+		return
+	}
+	// map header, size 2
+	// write "Enable"
+	err = en.Append(0x82, 0xa6, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Verify.Enable)
+	if err != nil {
+		err = msgp.WrapError(err, "Verify", "Enable")
+		return
+	}
+	// write "RangeSize"
+	err = en.Append(0xa9, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Verify.RangeSize)
+	if err != nil {
+		err = msgp.WrapError(err, "Verify", "RangeSize")
+		return
+	}
+	// write "ObjectsPerSecond"
+	err = en.Append(0xb0, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.ObjectsPerSecond)
+	if err != nil {
+		err = msgp.WrapError(err, "ObjectsPerSecond")
+		return
+	}
+	// write "ForceRotate"
+	err = en.Append(0xab, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.ForceRotate)
+	if err != nil {
+		err = msgp.WrapError(err, "ForceRotate")
+		return
+	}
+	// write "VerifyOnly"
+	err = en.Append(0xaa, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f, 0x6e, 0x6c, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.VerifyOnly)
+	if err != nil {
+		err = msgp.WrapError(err, "VerifyOnly")
+		return
+	}
+	// write "MaxPause"
+	err = en.Append(0xa8, 0x4d, 0x61, 0x78, 0x50, 0x61, 0x75, 0x73, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteDuration(z.MaxPause)
+	if err != nil {
+		err = msgp.WrapError(err, "MaxPause")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *BatchJobKeyRotateFlags) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 3
+	// map header, size 8
 	// string "Filter"
-	o = append(o, 0x83, 0xa6, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72)
+	o = append(o, 0x88, 0xa6, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72)
 	o, err = z.Filter.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "Filter")
@@ -353,13 +433,11 @@ func (z *BatchJobKeyRotateFlags) MarshalMsg(b []byte) (o []byte, err error) {
 	}
 	// string "Notify"
 	o = append(o, 0xa6, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79)
-	// map header, size 2
-	// string "Endpoint"
-	o = append(o, 0x82, 0xa8, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
-	o = msgp.AppendString(o, z.Notify.Endpoint)
-	// string "Token"
-	o = append(o, 0xa5, 0x54, 0x6f, 0x6b, 0x65, 0x6e)
-	o = msgp.AppendString(o, z.Notify.Token)
+	o, err = z.Notify.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Notify")
+		return
+	}
 	// string "Retry"
 	o = append(o, 0xa5, 0x52, 0x65, 0x74, 0x72, 0x79)
 	// map header, size 2
@@ -369,6 +447,27 @@ func (z *BatchJobKeyRotateFlags) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "Delay"
 	o = append(o, 0xa5, 0x44, 0x65, 0x6c, 0x61, 0x79)
 	o = msgp.AppendDuration(o, z.Retry.Delay)
+	// string "Verify"
+	o = append(o, 0xa6, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79)
+	// map header, size 2
+	// string "Enable"
+	o = append(o, 0x82, 0xa6, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65)
+	o = msgp.AppendBool(o, z.Verify.Enable)
+	// string "RangeSize"
+	o = append(o, 0xa9, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65)
+	o = msgp.AppendInt64(o, z.Verify.RangeSize)
+	// string "ObjectsPerSecond"
+	o = append(o, 0xb0, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64)
+	o = msgp.AppendInt(o, z.ObjectsPerSecond)
+	// string "ForceRotate"
+	o = append(o, 0xab, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65)
+	o = msgp.AppendBool(o, z.ForceRotate)
+	// string "VerifyOnly"
+	o = append(o, 0xaa, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f, 0x6e, 0x6c, 0x79)
+	o = msgp.AppendBool(o, z.VerifyOnly)
+	// string "MaxPause"
+	o = append(o, 0xa8, 0x4d, 0x61, 0x78, 0x50, 0x61, 0x75, 0x73, 0x65)
+	o = msgp.AppendDuration(o, z.MaxPause)
 	return
 }
 
@@ -397,75 +496,105 @@ func (z *BatchJobKeyRotateFlags) UnmarshalMsg(bts []byte) (o []byte, err error)
 				return
 			}
 		case "Notify":
+			bts, err = z.Notify.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Notify")
+				return
+			}
+		case "Retry":
 			var zb0002 uint32
 			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Notify")
+				err = msgp.WrapError(err, "Retry")
 				return
 			}
 			for zb0002 > 0 {
 				zb0002--
 				field, bts, err = msgp.ReadMapKeyZC(bts)
 				if err != nil {
-					err = msgp.WrapError(err, "Notify")
+					err = msgp.WrapError(err, "Retry")
 					return
 				}
 				switch msgp.UnsafeString(field) {
-				case "Endpoint":
-					z.Notify.Endpoint, bts, err = msgp.ReadStringBytes(bts)
+				case "Attempts":
+					z.Retry.Attempts, bts, err = msgp.ReadIntBytes(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "Notify", "Endpoint")
+						err = msgp.WrapError(err, "Retry", "Attempts")
 						return
 					}
-				case "Token":
-					z.Notify.Token, bts, err = msgp.ReadStringBytes(bts)
+				case "Delay":
+					z.Retry.Delay, bts, err = msgp.ReadDurationBytes(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "Notify", "Token")
+						err = msgp.WrapError(err, "Retry", "Delay")
 						return
 					}
 				default:
 					bts, err = msgp.Skip(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "Notify")
+						err = msgp.WrapError(err, "Retry")
 						return
 					}
 				}
 			}
-		case "Retry":
+		case "Verify":
 			var zb0003 uint32
 			zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Retry")
+				err = msgp.WrapError(err, "Verify")
 				return
 			}
 			for zb0003 > 0 {
 				zb0003--
 				field, bts, err = msgp.ReadMapKeyZC(bts)
 				if err != nil {
-					err = msgp.WrapError(err, "Retry")
+					err = msgp.WrapError(err, "Verify")
 					return
 				}
 				switch msgp.UnsafeString(field) {
-				case "Attempts":
-					z.Retry.Attempts, bts, err = msgp.ReadIntBytes(bts)
+				case "Enable":
+					z.Verify.Enable, bts, err = msgp.ReadBoolBytes(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "Retry", "Attempts")
+						err = msgp.WrapError(err, "Verify", "Enable")
 						return
 					}
-				case "Delay":
-					z.Retry.Delay, bts, err = msgp.ReadDurationBytes(bts)
+				case "RangeSize":
+					z.Verify.RangeSize, bts, err = msgp.ReadInt64Bytes(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "Retry", "Delay")
+						err = msgp.WrapError(err, "Verify", "RangeSize")
 						return
 					}
 				default:
 					bts, err = msgp.Skip(bts)
 					if err != nil {
-						err = msgp.WrapError(err, "Retry")
+						err = msgp.WrapError(err, "Verify")
 						return
 					}
 				}
 			}
+		case "ObjectsPerSecond":
+			z.ObjectsPerSecond, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsPerSecond")
+				return
+			}
+		case "ForceRotate":
+			z.ForceRotate, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ForceRotate")
+				return
+			}
+		case "VerifyOnly":
+			z.VerifyOnly, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VerifyOnly")
+				return
+			}
+		case "MaxPause":
+			z.MaxPause, bts, err = msgp.ReadDurationBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxPause")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -480,7 +609,7 @@ func (z *BatchJobKeyRotateFlags) UnmarshalMsg(bts []byte) (o []byte, err error)
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *BatchJobKeyRotateFlags) Msgsize() (s int) {
-	s = 1 + 7 + z.Filter.Msgsize() + 7 + 1 + 9 + msgp.StringPrefixSize + len(z.Notify.Endpoint) + 6 + msgp.StringPrefixSize + len(z.Notify.Token) + 6 + 1 + 9 + msgp.IntSize + 6 + msgp.DurationSize
+	s = 1 + 7 + z.Filter.Msgsize() + 7 + z.Notify.Msgsize() + 6 + 1 + 9 + msgp.IntSize + 6 + msgp.DurationSize + 7 + 1 + 7 + msgp.BoolSize + 10 + msgp.Int64Size + 17 + msgp.IntSize + 12 + msgp.BoolSize + 11 + msgp.BoolSize + 9 + msgp.DurationSize
 	return
 }
 
@@ -864,6 +993,44 @@ func (z *BatchKeyRotateFilter) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "KMSKeyID")
 				return
 			}
+		case "ExcludePrefixes":
+			var zb0006 uint32
+			zb0006, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ExcludePrefixes")
+				return
+			}
+			if cap(z.ExcludePrefixes) >= int(zb0006) {
+				z.ExcludePrefixes = (z.ExcludePrefixes)[:zb0006]
+			} else {
+				z.ExcludePrefixes = make([]string, zb0006)
+			}
+			for za0003 := range z.ExcludePrefixes {
+				z.ExcludePrefixes[za0003], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "ExcludePrefixes", za0003)
+					return
+				}
+			}
+		case "ExcludeNames":
+			var zb0007 uint32
+			zb0007, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ExcludeNames")
+				return
+			}
+			if cap(z.ExcludeNames) >= int(zb0007) {
+				z.ExcludeNames = (z.ExcludeNames)[:zb0007]
+			} else {
+				z.ExcludeNames = make([]string, zb0007)
+			}
+			for za0004 := range z.ExcludeNames {
+				z.ExcludeNames[za0004], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "ExcludeNames", za0004)
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -877,9 +1044,9 @@ func (z *BatchKeyRotateFilter) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *BatchKeyRotateFilter) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 7
+	// map header, size 9
 	// write "NewerThan"
-	err = en.Append(0x87, 0xa9, 0x4e, 0x65, 0x77, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e)
+	err = en.Append(0x89, 0xa9, 0x4e, 0x65, 0x77, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e)
 	if err != nil {
 		return
 	}
@@ -994,15 +1161,49 @@ func (z *BatchKeyRotateFilter) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "KMSKeyID")
 		return
 	}
+	// write "ExcludePrefixes"
+	err = en.Append(0xaf, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.ExcludePrefixes)))
+	if err != nil {
+		err = msgp.WrapError(err, "ExcludePrefixes")
+		return
+	}
+	for za0003 := range z.ExcludePrefixes {
+		err = en.WriteString(z.ExcludePrefixes[za0003])
+		if err != nil {
+			err = msgp.WrapError(err, "ExcludePrefixes", za0003)
+			return
+		}
+	}
+	// write "ExcludeNames"
+	err = en.Append(0xac, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.ExcludeNames)))
+	if err != nil {
+		err = msgp.WrapError(err, "ExcludeNames")
+		return
+	}
+	for za0004 := range z.ExcludeNames {
+		err = en.WriteString(z.ExcludeNames[za0004])
+		if err != nil {
+			err = msgp.WrapError(err, "ExcludeNames", za0004)
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *BatchKeyRotateFilter) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 7
+	// map header, size 9
 	// string "NewerThan"
-	o = append(o, 0x87, 0xa9, 0x4e, 0x65, 0x77, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e)
+	o = append(o, 0x89, 0xa9, 0x4e, 0x65, 0x77, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e)
 	o = msgp.AppendDuration(o, z.NewerThan)
 	// string "OlderThan"
 	o = append(o, 0xa9, 0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e)
@@ -1040,6 +1241,18 @@ func (z *BatchKeyRotateFilter) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "KMSKeyID"
 	o = append(o, 0xa8, 0x4b, 0x4d, 0x53, 0x4b, 0x65, 0x79, 0x49, 0x44)
 	o = msgp.AppendString(o, z.KMSKeyID)
+	// string "ExcludePrefixes"
+	o = append(o, 0xaf, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.ExcludePrefixes)))
+	for za0003 := range z.ExcludePrefixes {
+		o = msgp.AppendString(o, z.ExcludePrefixes[za0003])
+	}
+	// string "ExcludeNames"
+	o = append(o, 0xac, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.ExcludeNames)))
+	for za0004 := range z.ExcludeNames {
+		o = msgp.AppendString(o, z.ExcludeNames[za0004])
+	}
 	return
 }
 
@@ -1187,6 +1400,44 @@ func (z *BatchKeyRotateFilter) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "KMSKeyID")
 				return
 			}
+		case "ExcludePrefixes":
+			var zb0006 uint32
+			zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ExcludePrefixes")
+				return
+			}
+			if cap(z.ExcludePrefixes) >= int(zb0006) {
+				z.ExcludePrefixes = (z.ExcludePrefixes)[:zb0006]
+			} else {
+				z.ExcludePrefixes = make([]string, zb0006)
+			}
+			for za0003 := range z.ExcludePrefixes {
+				z.ExcludePrefixes[za0003], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ExcludePrefixes", za0003)
+					return
+				}
+			}
+		case "ExcludeNames":
+			var zb0007 uint32
+			zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ExcludeNames")
+				return
+			}
+			if cap(z.ExcludeNames) >= int(zb0007) {
+				z.ExcludeNames = (z.ExcludeNames)[:zb0007]
+			} else {
+				z.ExcludeNames = make([]string, zb0007)
+			}
+			for za0004 := range z.ExcludeNames {
+				z.ExcludeNames[za0004], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ExcludeNames", za0004)
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -1209,7 +1460,14 @@ func (z *BatchKeyRotateFilter) Msgsize() (s int) {
 	for za0002 := range z.Metadata {
 		s += 1 + 4 + msgp.StringPrefixSize + len(z.Metadata[za0002].Key) + 6 + msgp.StringPrefixSize + len(z.Metadata[za0002].Value)
 	}
-	s += 9 + msgp.StringPrefixSize + len(z.KMSKeyID)
+	s += 9 + msgp.StringPrefixSize + len(z.KMSKeyID) + 16 + msgp.ArrayHeaderSize
+	for za0003 := range z.ExcludePrefixes {
+		s += msgp.StringPrefixSize + len(z.ExcludePrefixes[za0003])
+	}
+	s += 13 + msgp.ArrayHeaderSize
+	for za0004 := range z.ExcludeNames {
+		s += msgp.StringPrefixSize + len(z.ExcludeNames[za0004])
+	}
 	return
 }
 
@@ -1371,6 +1629,36 @@ func (z *BatchKeyRotateNotification) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Token")
 				return
 			}
+		case "Headers":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Headers")
+				return
+			}
+			if z.Headers == nil {
+				z.Headers = make(map[string]string, zb0002)
+			} else if len(z.Headers) > 0 {
+				for key := range z.Headers {
+					delete(z.Headers, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 string
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Headers")
+					return
+				}
+				za0002, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Headers", za0001)
+					return
+				}
+				z.Headers[za0001] = za0002
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -1383,10 +1671,10 @@ func (z *BatchKeyRotateNotification) DecodeMsg(dc *msgp.Reader) (err error) {
 }
 
 // EncodeMsg implements msgp.Encodable
-func (z BatchKeyRotateNotification) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 2
+func (z *BatchKeyRotateNotification) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
 	// write "Endpoint"
-	err = en.Append(0x82, 0xa8, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+	err = en.Append(0x83, 0xa8, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
 	if err != nil {
 		return
 	}
@@ -1405,19 +1693,48 @@ func (z BatchKeyRotateNotification) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Token")
 		return
 	}
+	// write "Headers"
+	err = en.Append(0xa7, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.Headers)))
+	if err != nil {
+		err = msgp.WrapError(err, "Headers")
+		return
+	}
+	for za0001, za0002 := range z.Headers {
+		err = en.WriteString(za0001)
+		if err != nil {
+			err = msgp.WrapError(err, "Headers")
+			return
+		}
+		err = en.WriteString(za0002)
+		if err != nil {
+			err = msgp.WrapError(err, "Headers", za0001)
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z BatchKeyRotateNotification) MarshalMsg(b []byte) (o []byte, err error) {
+func (z *BatchKeyRotateNotification) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 3
 	// string "Endpoint"
-	o = append(o, 0x82, 0xa8, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+	o = append(o, 0x83, 0xa8, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
 	o = msgp.AppendString(o, z.Endpoint)
 	// string "Token"
 	o = append(o, 0xa5, 0x54, 0x6f, 0x6b, 0x65, 0x6e)
 	o = msgp.AppendString(o, z.Token)
+	// string "Headers"
+	o = append(o, 0xa7, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Headers)))
+	for za0001, za0002 := range z.Headers {
+		o = msgp.AppendString(o, za0001)
+		o = msgp.AppendString(o, za0002)
+	}
 	return
 }
 
@@ -1451,6 +1768,36 @@ func (z *BatchKeyRotateNotification) UnmarshalMsg(bts []byte) (o []byte, err err
 				err = msgp.WrapError(err, "Token")
 				return
 			}
+		case "Headers":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Headers")
+				return
+			}
+			if z.Headers == nil {
+				z.Headers = make(map[string]string, zb0002)
+			} else if len(z.Headers) > 0 {
+				for key := range z.Headers {
+					delete(z.Headers, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 string
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Headers")
+					return
+				}
+				za0002, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Headers", za0001)
+					return
+				}
+				z.Headers[za0001] = za0002
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -1464,8 +1811,14 @@ func (z *BatchKeyRotateNotification) UnmarshalMsg(bts []byte) (o []byte, err err
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z BatchKeyRotateNotification) Msgsize() (s int) {
-	s = 1 + 9 + msgp.StringPrefixSize + len(z.Endpoint) + 6 + msgp.StringPrefixSize + len(z.Token)
+func (z *BatchKeyRotateNotification) Msgsize() (s int) {
+	s = 1 + 9 + msgp.StringPrefixSize + len(z.Endpoint) + 6 + msgp.StringPrefixSize + len(z.Token) + 8 + msgp.MapHeaderSize
+	if z.Headers != nil {
+		for za0001, za0002 := range z.Headers {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
 	return
 }
 
@@ -1597,6 +1950,134 @@ func (z BatchKeyRotateRetry) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *BatchKeyRotateVerify) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Enable":
+			z.Enable, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Enable")
+				return
+			}
+		case "RangeSize":
+			z.RangeSize, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "RangeSize")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z BatchKeyRotateVerify) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Enable"
+	err = en.Append(0x82, 0xa6, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Enable)
+	if err != nil {
+		err = msgp.WrapError(err, "Enable")
+		return
+	}
+	// write "RangeSize"
+	err = en.Append(0xa9, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.RangeSize)
+	if err != nil {
+		err = msgp.WrapError(err, "RangeSize")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z BatchKeyRotateVerify) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Enable"
+	o = append(o, 0x82, 0xa6, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65)
+	o = msgp.AppendBool(o, z.Enable)
+	// string "RangeSize"
+	o = append(o, 0xa9, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65)
+	o = msgp.AppendInt64(o, z.RangeSize)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *BatchKeyRotateVerify) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Enable":
+			z.Enable, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Enable")
+				return
+			}
+		case "RangeSize":
+			z.RangeSize, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RangeSize")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z BatchKeyRotateVerify) Msgsize() (s int) {
+	s = 1 + 7 + msgp.BoolSize + 10 + msgp.Int64Size
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *BatchKeyRotationType) DecodeMsg(dc *msgp.Reader) (err error) {
 	{
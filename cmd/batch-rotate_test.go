@@ -0,0 +1,308 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"path"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/internal/crypto"
+	"github.com/minio/minio/internal/kms"
+)
+
+// keyRotateTestObjLayer records which copy path KeyRotate picked, any
+// method not overridden here panics through the embedded nil interface.
+type keyRotateTestObjLayer struct {
+	ObjectLayer
+	ns *nsLockMap
+	oi ObjectInfo
+
+	copyCalls         int
+	multipartCalls    int
+	partsCopied       []int
+	abortCalls        int
+	completedParts    []CompletePart
+	completeVersionID string
+}
+
+func (m *keyRotateTestObjLayer) NewNSLock(bucket string, objects ...string) RWLocker {
+	return m.ns.NewNSLock(nil, bucket, objects...)
+}
+
+func (m *keyRotateTestObjLayer) GetObjectInfo(ctx context.Context, bucket, object string, opts ObjectOptions) (ObjectInfo, error) {
+	return m.oi.Clone(), nil
+}
+
+func (m *keyRotateTestObjLayer) CopyObject(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string, srcInfo ObjectInfo, srcOpts, dstOpts ObjectOptions) (ObjectInfo, error) {
+	m.copyCalls++
+	return srcInfo, nil
+}
+
+func (m *keyRotateTestObjLayer) NewMultipartUpload(ctx context.Context, bucket, object string, opts ObjectOptions) (*NewMultipartUploadResult, error) {
+	m.multipartCalls++
+	return &NewMultipartUploadResult{UploadID: "key-rotate-upload"}, nil
+}
+
+func (m *keyRotateTestObjLayer) GetObjectNInfo(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, opts ObjectOptions) (*GetObjectReader, error) {
+	length := int64(len("part-data"))
+	if rs != nil {
+		length = rs.End - rs.Start + 1
+	}
+	return NewGetObjectReaderFromReader(io.LimitReader(zeroReader{}, length), ObjectInfo{Size: length}, opts)
+}
+
+func (m *keyRotateTestObjLayer) CopyObjectPart(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject, uploadID string, partID int, startOffset, length int64, srcInfo ObjectInfo, srcOpts, dstOpts ObjectOptions) (PartInfo, error) {
+	if _, err := io.Copy(io.Discard, srcInfo.PutObjReader); err != nil {
+		return PartInfo{}, err
+	}
+	m.partsCopied = append(m.partsCopied, partID)
+	return PartInfo{PartNumber: partID, ETag: "etag", Size: length}, nil
+}
+
+func (m *keyRotateTestObjLayer) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string, opts ObjectOptions) error {
+	m.abortCalls++
+	return nil
+}
+
+func (m *keyRotateTestObjLayer) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, uploadedParts []CompletePart, opts ObjectOptions) (ObjectInfo, error) {
+	m.completedParts = uploadedParts
+	m.completeVersionID = opts.VersionID
+	return ObjectInfo{}, nil
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// sseS3EncryptedMetadata returns object metadata sealed the way an
+// SSE-S3 upload would have stored it.
+func sseS3EncryptedMetadata(t *testing.T, bucket, object string) map[string]string {
+	t.Helper()
+	key, err := GlobalKMS.GenerateKey(context.Background(), "", kms.Context{bucket: path.Join(bucket, object)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectKey := crypto.GenerateKey(key.Plaintext, crand.Reader)
+	sealedKey := objectKey.Seal(key.Plaintext, crypto.GenerateIV(crand.Reader), crypto.S3.String(), bucket, object)
+	return crypto.S3.CreateMetadata(make(map[string]string), key.KeyID, key.Ciphertext, sealedKey)
+}
+
+func TestKeyRotateMultipartThreshold(t *testing.T) {
+	saveKMS := GlobalKMS
+	defer func() {
+		GlobalKMS = saveKMS
+	}()
+	var err error
+	GlobalKMS, err = kms.Parse("my-minio-key:5lF+0pJM0OWwlQrvK2S/I7W9mO4a6rJJI7wzj7v09cw=")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if globalBucketVersioningSys == nil {
+		globalBucketVersioningSys = NewBucketVersioningSys()
+	}
+
+	bucket, object := "rotate-bucket", "rotate-object"
+	saveMetaSys := globalBucketMetadataSys
+	defer func() {
+		globalBucketMetadataSys = saveMetaSys
+	}()
+	globalBucketMetadataSys = NewBucketMetadataSys()
+	globalBucketMetadataSys.Set(bucket, newBucketMetadata(bucket))
+	saveObjAPI := newObjectLayerFn()
+	defer setObjectLayer(saveObjAPI)
+	r := BatchJobKeyRotateV1{
+		Bucket:     bucket,
+		Encryption: BatchJobKeyRotateEncryption{Type: sses3},
+	}
+
+	// A small object takes the single-shot copy path.
+	small := &keyRotateTestObjLayer{
+		ns: newNSLock(false),
+		oi: ObjectInfo{
+			Bucket:      bucket,
+			Name:        object,
+			Size:        1024,
+			UserDefined: sseS3EncryptedMetadata(t, bucket, object),
+		},
+	}
+	setObjectLayer(small)
+	if err = r.KeyRotate(context.Background(), small, small.oi); err != nil {
+		t.Fatalf("expected rotation to succeed, got %v", err)
+	}
+	if small.copyCalls != 1 || small.multipartCalls != 0 {
+		t.Fatalf("expected the single-shot copy path, got copy=%d multipart=%d", small.copyCalls, small.multipartCalls)
+	}
+
+	// Above the threshold the multipart copy machinery is used with the
+	// original part boundaries and the version ID preserved.
+	partSize := int64(batchKeyRotateMultipartSize / 2)
+	large := &keyRotateTestObjLayer{
+		ns: newNSLock(false),
+		oi: ObjectInfo{
+			Bucket:      bucket,
+			Name:        object,
+			VersionID:   mustGetUUID(),
+			Size:        3 * partSize,
+			UserDefined: sseS3EncryptedMetadata(t, bucket, object),
+			Parts: []ObjectPartInfo{
+				{Number: 1, Size: partSize},
+				{Number: 2, Size: partSize},
+				{Number: 3, Size: partSize},
+			},
+		},
+	}
+	if err = r.KeyRotate(context.Background(), large, large.oi); err != nil {
+		t.Fatalf("expected rotation to succeed, got %v", err)
+	}
+	if large.copyCalls != 0 || large.multipartCalls != 1 {
+		t.Fatalf("expected the multipart copy path, got copy=%d multipart=%d", large.copyCalls, large.multipartCalls)
+	}
+	if len(large.partsCopied) != 3 {
+		t.Fatalf("expected 3 copied parts, got %v", large.partsCopied)
+	}
+	for i, partID := range large.partsCopied {
+		if partID != i+1 {
+			t.Fatalf("expected part %d, got %d", i+1, partID)
+		}
+	}
+	if len(large.completedParts) != 3 {
+		t.Fatalf("expected 3 completed parts, got %v", large.completedParts)
+	}
+	if large.completeVersionID != large.oi.VersionID {
+		t.Fatalf("expected version %s to be preserved, got %s", large.oi.VersionID, large.completeVersionID)
+	}
+	if large.abortCalls != 0 {
+		t.Fatalf("expected no aborted uploads, got %d", large.abortCalls)
+	}
+}
+
+func TestKMSOutagePauser(t *testing.T) {
+	// All workers hitting a connectivity error share a single pause,
+	// everybody resumes once the probe succeeds.
+	var probes, pauses, resumes int32
+	p := &kmsOutagePauser{
+		probeInterval: time.Millisecond,
+		probe: func(context.Context) error {
+			if atomic.AddInt32(&probes, 1) < 3 {
+				return errors.New("connection refused")
+			}
+			return nil
+		},
+		onPause:  func() { atomic.AddInt32(&pauses, 1) },
+		onResume: func() { atomic.AddInt32(&resumes, 1) },
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = p.wait(context.Background())
+		}(i)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("worker %d: expected to resume cleanly, got %v", i, err)
+		}
+	}
+	if pauses != 1 || resumes != 1 {
+		t.Fatalf("expected a single shared pause/resume, got pauses=%d resumes=%d", pauses, resumes)
+	}
+	if p.jobFailed() {
+		t.Fatal("expected the job to survive a recovered outage")
+	}
+
+	// A pause outliving maxPause fails the job, late callers fail fast.
+	p = &kmsOutagePauser{
+		maxPause:      5 * time.Millisecond,
+		probeInterval: time.Millisecond,
+		probe:         func(context.Context) error { return errors.New("connection refused") },
+		onPause:       func() {},
+		onResume:      func() { t.Error("unexpected resume of an expired pause") },
+	}
+	if err := p.wait(context.Background()); !errors.Is(err, errKMSOutagePauseExpired) {
+		t.Fatalf("expected %v, got %v", errKMSOutagePauseExpired, err)
+	}
+	if !p.jobFailed() {
+		t.Fatal("expected the job to be failed after maxPause expired")
+	}
+	if err := p.wait(context.Background()); !errors.Is(err, errKMSOutagePauseExpired) {
+		t.Fatalf("expected late callers to fail fast, got %v", err)
+	}
+}
+
+func TestIsKMSConnectivityErr(t *testing.T) {
+	if isKMSConnectivityErr(nil) {
+		t.Fatal("nil is not a connectivity error")
+	}
+	if !isKMSConnectivityErr(&net.OpError{Op: "dial", Err: errors.New("connection refused")}) {
+		t.Fatal("expected a refused dial to classify as a connectivity error")
+	}
+	if isKMSConnectivityErr(crypto.ErrInvalidEncryptionKeyID) {
+		t.Fatal("a per-object key error must not pause the job")
+	}
+}
+
+func TestBatchJobInfoFinalize(t *testing.T) {
+	// Completion without a single matched object is flagged.
+	ri := &batchJobInfo{}
+	ri.finalize()
+	if !ri.Complete || ri.Failed {
+		t.Fatalf("expected complete, got complete=%v failed=%v", ri.Complete, ri.Failed)
+	}
+	if ri.Status != batchJobStatusNoMatches {
+		t.Fatalf("expected status %q, got %q", batchJobStatusNoMatches, ri.Status)
+	}
+
+	// Matching objects clears the flag.
+	ri = &batchJobInfo{Objects: 3}
+	ri.finalize()
+	if !ri.Complete || ri.Status != "" {
+		t.Fatalf("expected clean completion, got complete=%v status=%q", ri.Complete, ri.Status)
+	}
+
+	// Delete markers count as matches too.
+	ri = &batchJobInfo{DeleteMarkers: 1}
+	ri.finalize()
+	if ri.Status != "" {
+		t.Fatalf("expected no status, got %q", ri.Status)
+	}
+
+	// Failed runs are never flagged as no-matches.
+	ri = &batchJobInfo{ObjectsFailed: 2}
+	ri.finalize()
+	if !ri.Failed || ri.Status != "" {
+		t.Fatalf("expected failed run without status, got failed=%v status=%q", ri.Failed, ri.Status)
+	}
+}
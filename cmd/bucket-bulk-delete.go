@@ -0,0 +1,62 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// name of the config file carrying the bucket bulk delete setting.
+const bucketBulkDeleteConfig = "bulk-delete.xml"
+
+// srBucketMetaTypeBulkDelete - represents replication of the bucket
+// bulk delete configuration across sites, the encoded config travels
+// in the SRBucketMeta Tags field.
+const srBucketMetaTypeBulkDelete = "bulk-delete"
+
+// BulkDeleteConfig controls the DeleteMultipleObjects API on the
+// bucket. Disabling it rejects every bulk delete request outright,
+// regardless of per-object permissions, a belt-and-suspenders guard
+// for compliance buckets where even permitted credentials should not
+// bulk-delete.
+type BulkDeleteConfig struct {
+	XMLName xml.Name `xml:"BulkDeleteConfiguration"`
+	// Disabled rejects all DeleteMultipleObjects requests when true.
+	Disabled bool `xml:"Disabled"`
+}
+
+// parseBucketBulkDeleteConfig unmarshals 'bulk-delete.xml'.
+func parseBucketBulkDeleteConfig(reader io.Reader) (*BulkDeleteConfig, error) {
+	cfg := BulkDeleteConfig{}
+	if err := xml.NewDecoder(reader).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// bulkDeleteDisabled returns true when the bucket categorically rejects
+// DeleteMultipleObjects requests. Buckets without the configuration
+// allow them.
+func bulkDeleteDisabled(bucket string) bool {
+	cfg, _, err := globalBucketMetadataSys.GetBulkDeleteConfig(bucket)
+	if err != nil {
+		return false
+	}
+	return cfg.Disabled
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+)
+
+func TestParseBucketBulkDeleteConfig(t *testing.T) {
+	testCases := []struct {
+		config       string
+		expectErr    bool
+		wantDisabled bool
+	}{
+		{`<BulkDeleteConfiguration><Disabled>true</Disabled></BulkDeleteConfiguration>`, false, true},
+		{`<BulkDeleteConfiguration><Disabled>false</Disabled></BulkDeleteConfiguration>`, false, false},
+		// Missing element defaults to enabled.
+		{`<BulkDeleteConfiguration></BulkDeleteConfiguration>`, false, false},
+		// Malformed XML.
+		{`<BulkDeleteConfiguration>`, true, false},
+	}
+	for i, testCase := range testCases {
+		cfg, err := parseBucketBulkDeleteConfig(strings.NewReader(testCase.config))
+		if testCase.expectErr && err == nil {
+			t.Errorf("Test %d: expected an error, got none", i+1)
+		}
+		if !testCase.expectErr && err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i+1, err)
+		}
+		if err == nil && cfg.Disabled != testCase.wantDisabled {
+			t.Errorf("Test %d: expected Disabled to be %v, got %v", i+1, testCase.wantDisabled, cfg.Disabled)
+		}
+	}
+}
+
+// Wrapper for calling TestAPIBucketBulkDeleteDisabled tests for both Erasure
+// multiple disks and single node setup.
+func TestAPIBucketBulkDeleteDisabled(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIBucketBulkDeleteDisabled, []string{"DeleteMultipleObjects"})
+}
+
+// testAPIBucketBulkDeleteDisabled - Tests that DeleteMultipleObjects requests
+// are rejected outright on buckets carrying a disabled bulk delete config,
+// and accepted again once the config is removed.
+func testAPIBucketBulkDeleteDisabled(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	objectName := "protected-object"
+	contentBytes := []byte("hello")
+	if _, err := obj.PutObject(GlobalContext, bucketName, objectName,
+		mustGetPutObjReader(t, bytes.NewReader(contentBytes), int64(len(contentBytes)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatalf("Error uploading object: <ERROR> %v", err)
+	}
+
+	cfg := []byte(`<BulkDeleteConfiguration><Disabled>true</Disabled></BulkDeleteConfiguration>`)
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketBulkDeleteConfig, cfg); err != nil {
+		t.Fatalf("Failed to set bucket bulk delete configuration: <ERROR> %v", err)
+	}
+
+	deleteReqBytes := encodeResponse(DeleteObjectsRequest{Objects: []ObjectToDelete{
+		{ObjectV: ObjectV{ObjectName: objectName}},
+	}})
+	deleteObjects := func() *httptest.ResponseRecorder {
+		req, err := newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+			int64(len(deleteReqBytes)), bytes.NewReader(deleteReqBytes), credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := deleteObjects()
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusForbidden, rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, "XMinioBulkDeleteDisabled") {
+		t.Errorf("MinIO %s: Expected a bulk delete disabled error, got `%s`", instanceType, body)
+	}
+
+	// The object survived the rejected request.
+	if _, err := obj.GetObjectInfo(GlobalContext, bucketName, objectName, ObjectOptions{}); err != nil {
+		t.Fatalf("MinIO %s: Expected the object to still exist: <ERROR> %v", instanceType, err)
+	}
+
+	// Removing the configuration allows bulk deletes again.
+	if _, err := globalBucketMetadataSys.Delete(GlobalContext, bucketName, bucketBulkDeleteConfig); err != nil {
+		t.Fatalf("Failed to delete bucket bulk delete configuration: <ERROR> %v", err)
+	}
+	if rec := deleteObjects(); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+	"github.com/minio/minio/internal/bucket/replication"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/bucket/policy"
+)
+
+// auditConfigValueMaxLen bounds the size of a configuration summary
+// attached to an audit entry. Summaries are compact renderings of a
+// configuration, credentials or full policy documents never end up in
+// the audit log.
+const auditConfigValueMaxLen = 256
+
+// auditBucketConfigChange tags the request's audit entry with a
+// bounded before/after summary of a bucket configuration change,
+// no-op updates leave no tags.
+func auditBucketConfigChange(ctx context.Context, subsystem, before, after string) {
+	if before == after {
+		return
+	}
+	reqInfo := logger.GetReqInfo(ctx)
+	if reqInfo == nil {
+		return
+	}
+	clip := func(v string) string {
+		if len(v) > auditConfigValueMaxLen {
+			return v[:auditConfigValueMaxLen] + "..."
+		}
+		return v
+	}
+	reqInfo.SetTags(subsystem+"ConfigBefore", clip(before))
+	reqInfo.SetTags(subsystem+"ConfigAfter", clip(after))
+}
+
+// objectLockConfigSummary renders the default retention of an object
+// lock configuration in a compact, audit friendly form.
+func objectLockConfigSummary(cfg *objectlock.Config) string {
+	if cfg == nil || cfg.Rule == nil {
+		return "no-default-retention"
+	}
+	ret := cfg.Rule.DefaultRetention
+	s := fmt.Sprintf("mode=%s", ret.Mode)
+	switch {
+	case ret.Days != nil:
+		s += fmt.Sprintf(",days=%d", *ret.Days)
+	case ret.Years != nil:
+		s += fmt.Sprintf(",years=%d", *ret.Years)
+	}
+	if len(cfg.PrefixRules) > 0 {
+		s += fmt.Sprintf(",prefixRules=%d", len(cfg.PrefixRules))
+	}
+	return s
+}
+
+// objectLockRetentionDays returns the approximate default retention in
+// days, zero when the configuration has none.
+func objectLockRetentionDays(cfg *objectlock.Config) uint64 {
+	if cfg == nil || cfg.Rule == nil {
+		return 0
+	}
+	ret := cfg.Rule.DefaultRetention
+	switch {
+	case ret.Days != nil:
+		return *ret.Days
+	case ret.Years != nil:
+		return *ret.Years * 365
+	}
+	return 0
+}
+
+// objectLockConfigWeakened reports whether the updated configuration
+// retains new objects less strictly than the previous one.
+func objectLockConfigWeakened(prev, updated *objectlock.Config) bool {
+	if objectLockRetentionDays(updated) < objectLockRetentionDays(prev) {
+		return true
+	}
+	var prevMode, updatedMode objectlock.RetMode
+	if prev != nil && prev.Rule != nil {
+		prevMode = prev.Rule.DefaultRetention.Mode
+	}
+	if updated != nil && updated.Rule != nil {
+		updatedMode = updated.Rule.DefaultRetention.Mode
+	}
+	// Dropping from COMPLIANCE to GOVERNANCE re-allows privileged
+	// bypass of the retention.
+	return prevMode == objectlock.RetCompliance && updatedMode == objectlock.RetGovernance
+}
+
+// replicationConfigSummary renders a replication configuration as rule
+// counts, target ARNs and rule contents are not expanded.
+func replicationConfigSummary(cfg *replication.Config) string {
+	if cfg == nil || len(cfg.Rules) == 0 {
+		return "no-rules"
+	}
+	enabled := 0
+	for _, rule := range cfg.Rules {
+		if rule.Status == replication.Enabled {
+			enabled++
+		}
+	}
+	return fmt.Sprintf("rules=%d,enabled=%d", len(cfg.Rules), enabled)
+}
+
+// bucketPolicySummary renders a bucket policy as statement counts,
+// never the policy document itself.
+func bucketPolicySummary(p *policy.Policy) string {
+	if p == nil || len(p.Statements) == 0 {
+		return "no-policy"
+	}
+	allow, deny := 0, 0
+	for _, st := range p.Statements {
+		if st.Effect == policy.Allow {
+			allow++
+		} else {
+			deny++
+		}
+	}
+	return fmt.Sprintf("statements=%d,allow=%d,deny=%d", len(p.Statements), allow, deny)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+)
+
+func lockConfigFromXML(t *testing.T, data string) *objectlock.Config {
+	t.Helper()
+	cfg, err := objectlock.ParseObjectLockConfig(bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	return cfg
+}
+
+func TestObjectLockConfigWeakened(t *testing.T) {
+	governance30 := lockConfigFromXML(t, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+	governance10 := lockConfigFromXML(t, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>10</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+	compliance30 := lockConfigFromXML(t, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+	noRetention := lockConfigFromXML(t, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`)
+
+	testCases := []struct {
+		prev, updated *objectlock.Config
+		weakened      bool
+	}{
+		{governance30, governance10, true},  // shorter retention
+		{governance30, noRetention, true},   // retention dropped
+		{compliance30, governance30, true},  // compliance downgraded
+		{governance10, governance30, false}, // longer retention
+		{noRetention, governance30, false},  // retention introduced
+		{governance30, governance30, false}, // unchanged
+		{compliance30, compliance30, false}, // unchanged
+		{noRetention, noRetention, false},   // still none
+	}
+	for i, tc := range testCases {
+		if got := objectLockConfigWeakened(tc.prev, tc.updated); got != tc.weakened {
+			t.Errorf("case %d: got weakened=%v, want %v", i, got, tc.weakened)
+		}
+	}
+}
+
+func TestObjectLockConfigSummary(t *testing.T) {
+	governance30 := lockConfigFromXML(t, `<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+	if got, want := objectLockConfigSummary(governance30), "mode=GOVERNANCE,days=30"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := objectLockConfigSummary(nil), "no-default-retention"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+
+	"github.com/minio/minio-go/v7/pkg/tags"
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+// name of the config file carrying the bucket default object tags.
+const bucketDefaultTagsConfig = "default-tags.xml"
+
+// srBucketMetaTypeDefaultTags - represents replication of the bucket
+// default object tags configuration across sites, the encoded config
+// travels in the SRBucketMeta Tags field.
+const srBucketMetaTypeDefaultTags = "default-tags"
+
+// DefaultTagsConfig holds the set of tags applied to every object
+// uploaded to the bucket. When Force is unset, tags supplied by the
+// client win on key conflicts; when set, the configured tags win.
+type DefaultTagsConfig struct {
+	XMLName xml.Name  `xml:"DefaultTagsConfiguration"`
+	Force   bool      `xml:"Force,omitempty"`
+	Tagging tags.Tags `xml:"Tagging"`
+}
+
+// parseBucketDefaultTagsConfig unmarshals and validates 'default-tags.xml'.
+// The tag set is validated against object tag limits since the configured
+// tags end up on objects.
+func parseBucketDefaultTagsConfig(reader io.Reader) (*DefaultTagsConfig, error) {
+	cfg := DefaultTagsConfig{}
+	if err := xml.NewDecoder(reader).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Tagging.TagSet == nil || len(cfg.Tagging.ToMap()) == 0 {
+		return nil, errors.New("default tags configuration must carry a non-empty tag set")
+	}
+	if _, err := tags.MapToObjectTags(cfg.Tagging.ToMap()); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// applyBucketDefaultTags merges the bucket's default object tags, if
+// configured, into the object tags carried in metadata under the
+// x-amz-tagging key. Client supplied tags win on key conflicts unless
+// the configuration sets Force. The merged tag set is validated against
+// object tag limits.
+func applyBucketDefaultTags(bucket string, metadata map[string]string) error {
+	cfg, _, err := globalBucketMetadataSys.GetDefaultTagsConfig(bucket)
+	if err != nil {
+		if _, ok := err.(BucketDefaultTagsNotFound); ok {
+			return nil
+		}
+		return err
+	}
+
+	merged := cfg.Tagging.ToMap()
+	if objTags := metadata[xhttp.AmzObjectTagging]; objTags != "" {
+		clientTags, err := tags.ParseObjectTags(objTags)
+		if err != nil {
+			return err
+		}
+		for k, v := range clientTags.ToMap() {
+			if _, ok := merged[k]; ok && cfg.Force {
+				// forced default tags win on key conflicts.
+				continue
+			}
+			merged[k] = v
+		}
+	}
+
+	mergedTags, err := tags.MapToObjectTags(merged)
+	if err != nil {
+		return err
+	}
+	metadata[xhttp.AmzObjectTagging] = mergedTags.String()
+	return nil
+}
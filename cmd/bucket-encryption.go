@@ -20,8 +20,11 @@ package cmd
 import (
 	"errors"
 	"io"
+	"net/http"
 
 	sse "github.com/minio/minio/internal/bucket/encryption"
+	"github.com/minio/minio/internal/crypto"
+	xhttp "github.com/minio/minio/internal/http"
 )
 
 // BucketSSEConfigSys - in-memory cache of bucket encryption config
@@ -38,6 +41,48 @@ func (sys *BucketSSEConfigSys) Get(bucket string) (*sse.BucketSSEConfig, error)
 	return sseCfg, err
 }
 
+// enforceBucketSSEConfig checks the given request headers against the bucket
+// encryption configuration when the configuration asks for enforcement.
+// It returns ErrNone when the headers are acceptable and a specific error
+// code when the upload must be rejected instead of silently rewritten to
+// the bucket default. Replication writes bypass enforcement since the
+// source cluster has already applied it.
+func enforceBucketSSEConfig(bucket string, h http.Header) APIErrorCode {
+	sseCfg, _ := globalBucketSSEConfigSys.Get(bucket)
+	if !sseCfg.Enforced() {
+		return ErrNone
+	}
+	if _, ok := h[xhttp.MinIOSourceReplicationRequest]; ok {
+		return ErrNone
+	}
+	if crypto.SSEC.IsRequested(h) {
+		// SSE-C content cannot be read by the bucket default key.
+		return ErrSSEEnforcementFailed
+	}
+	switch sseCfg.Algo() {
+	case sse.AWSKms:
+		if crypto.S3KMS.IsRequested(h) {
+			keyID, _, err := crypto.S3KMS.ParseHTTP(h)
+			if err != nil || (keyID != "" && keyID != sseCfg.KeyID()) {
+				return ErrSSEEnforcementFailed
+			}
+			return ErrNone
+		}
+		if crypto.S3.IsRequested(h) {
+			// Plain SSE-S3 does not use the bucket's KMS key.
+			return ErrSSEEnforcementFailed
+		}
+	case sse.AES256:
+		if crypto.Requested(h) {
+			return ErrNone
+		}
+	}
+	if !crypto.Requested(h) && !globalAutoEncryption {
+		return ErrSSEEnforcementFailed
+	}
+	return ErrNone
+}
+
 // validateBucketSSEConfig parses bucket encryption configuration and validates if it is supported by MinIO.
 func validateBucketSSEConfig(r io.Reader) (*sse.BucketSSEConfig, error) {
 	encConfig, err := sse.ParseBucketSSEConfig(r)
@@ -19,7 +19,12 @@ package cmd
 
 import (
 	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+
+	"github.com/minio/minio/internal/auth"
 )
 
 func TestValidateBucketSSEConfig(t *testing.T) {
@@ -68,3 +73,51 @@ func TestValidateBucketSSEConfig(t *testing.T) {
 		}
 	}
 }
+
+// Wrapper for calling TestAPIBucketSSEEnforcement tests for both Erasure
+// multiple disks and single node setup.
+func TestAPIBucketSSEEnforcement(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIBucketSSEEnforcement, []string{"PutObject"})
+}
+
+// testAPIBucketSSEEnforcement - Tests that unencrypted uploads are rejected
+// when the bucket encryption configuration carries the enforcement flag.
+func testAPIBucketSSEEnforcement(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	objectName := "enforced-object"
+	cfg := []byte(`<ServerSideEncryptionConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault></Rule><Enforce>true</Enforce></ServerSideEncryptionConfiguration>`)
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketSSEConfig, cfg); err != nil {
+		t.Fatalf("Failed to set bucket encryption configuration: <ERROR> %v", err)
+	}
+
+	putObject := func() *httptest.ResponseRecorder {
+		objData := []byte("hello")
+		req, err := newTestSignedRequestV4(http.MethodPut, getPutObjectURL("", bucketName, objectName),
+			int64(len(objData)), bytes.NewReader(objData), credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for PutObjectHandler: <ERROR> %v", err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Auto-encrypt is off in the test setup, so an unencrypted upload
+	// conflicts with the enforced configuration.
+	rec := putObject()
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusBadRequest, rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, errorCodes[ErrSSEEnforcementFailed].Code) {
+		t.Errorf("MinIO %s: Expected an SSE enforcement error, got `%s`", instanceType, body)
+	}
+
+	// Removing the configuration lifts enforcement.
+	if _, err := globalBucketMetadataSys.Delete(GlobalContext, bucketName, bucketSSEConfig); err != nil {
+		t.Fatalf("Failed to delete bucket encryption configuration: <ERROR> %v", err)
+	}
+	if rec := putObject(); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+)
+
+// name of the config file carrying the bucket fan-out routing rules.
+const bucketFanOutRoutingConfig = "fanout-routing.xml"
+
+// srBucketMetaTypeFanOutRouting - represents replication of the bucket
+// fan-out routing configuration across sites, the encoded config travels
+// in the SRBucketMeta Tags field.
+const srBucketMetaTypeFanOutRouting = "fanout-routing"
+
+// maxFanOutRoutingRules caps the number of rules in a single
+// configuration.
+const maxFanOutRoutingRules = 100
+
+// FanOutRoutingRule routes fan-out entries carrying a matching object tag
+// to a server pool.
+type FanOutRoutingRule struct {
+	Tag struct {
+		Key string `xml:"Key"`
+		// Value is the tag value to match, an empty value matches any
+		// value of the key.
+		Value string `xml:"Value"`
+	} `xml:"Tag"`
+	// Pool is the destination server pool, 1-based in command line
+	// order, mirroring the bucket pinning convention.
+	Pool int `xml:"Pool"`
+}
+
+// FanOutRoutingConfig routes fan-out POST entries to server pools based
+// on the object tags each entry carries. Entries matching no rule follow
+// regular pool selection. This is a MinIO extension.
+type FanOutRoutingConfig struct {
+	XMLName xml.Name            `xml:"FanOutRoutingConfiguration"`
+	Rules   []FanOutRoutingRule `xml:"Rule"`
+}
+
+// parseBucketFanOutRoutingConfig unmarshals and validates
+// 'fanout-routing.xml'.
+func parseBucketFanOutRoutingConfig(reader io.Reader) (*FanOutRoutingConfig, error) {
+	cfg := FanOutRoutingConfig{}
+	if err := xml.NewDecoder(reader).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	if len(cfg.Rules) > maxFanOutRoutingRules {
+		return nil, errors.New("too many fan-out routing rules")
+	}
+	for _, rule := range cfg.Rules {
+		if rule.Tag.Key == "" {
+			return nil, errors.New("fan-out routing rule with empty tag key")
+		}
+		if rule.Pool < 1 {
+			return nil, errors.New("fan-out routing rule pool must be 1 or greater")
+		}
+	}
+	return &cfg, nil
+}
+
+// poolFor returns the destination pool (1-based) for an entry carrying
+// the given tags, or zero when no rule matches. The first matching rule
+// in configuration order wins.
+func (cfg *FanOutRoutingConfig) poolFor(tags map[string]string) int {
+	if cfg == nil {
+		return 0
+	}
+	for _, rule := range cfg.Rules {
+		v, ok := tags[rule.Tag.Key]
+		if !ok {
+			continue
+		}
+		if rule.Tag.Value == "" || rule.Tag.Value == v {
+			return rule.Pool
+		}
+	}
+	return 0
+}
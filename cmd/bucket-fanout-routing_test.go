@@ -0,0 +1,94 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseBucketFanOutRoutingConfig(t *testing.T) {
+	testCases := []struct {
+		config    string
+		expectErr bool
+		wantRules int
+	}{
+		{`<FanOutRoutingConfiguration><Rule><Tag><Key>class</Key><Value>hot</Value></Tag><Pool>1</Pool></Rule></FanOutRoutingConfiguration>`, false, 1},
+		{`<FanOutRoutingConfiguration><Rule><Tag><Key>class</Key><Value>hot</Value></Tag><Pool>1</Pool></Rule><Rule><Tag><Key>class</Key><Value>cold</Value></Tag><Pool>2</Pool></Rule></FanOutRoutingConfiguration>`, false, 2},
+		// No rules is a valid, inert configuration.
+		{`<FanOutRoutingConfiguration></FanOutRoutingConfiguration>`, false, 0},
+		// Empty tag key.
+		{`<FanOutRoutingConfiguration><Rule><Tag><Key></Key></Tag><Pool>1</Pool></Rule></FanOutRoutingConfiguration>`, true, 0},
+		// Pool below 1.
+		{`<FanOutRoutingConfiguration><Rule><Tag><Key>class</Key></Tag><Pool>0</Pool></Rule></FanOutRoutingConfiguration>`, true, 0},
+		// Malformed XML.
+		{`<FanOutRoutingConfiguration>`, true, 0},
+	}
+	for i, testCase := range testCases {
+		cfg, err := parseBucketFanOutRoutingConfig(strings.NewReader(testCase.config))
+		if testCase.expectErr && err == nil {
+			t.Errorf("Test %d: expected an error, got none", i+1)
+		}
+		if !testCase.expectErr && err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i+1, err)
+		}
+		if err == nil && len(cfg.Rules) != testCase.wantRules {
+			t.Errorf("Test %d: expected %d rules, got %d", i+1, testCase.wantRules, len(cfg.Rules))
+		}
+	}
+}
+
+func TestFanOutRoutingPoolFor(t *testing.T) {
+	cfg, err := parseBucketFanOutRoutingConfig(strings.NewReader(
+		`<FanOutRoutingConfiguration>` +
+			`<Rule><Tag><Key>class</Key><Value>hot</Value></Tag><Pool>1</Pool></Rule>` +
+			`<Rule><Tag><Key>class</Key><Value>cold</Value></Tag><Pool>2</Pool></Rule>` +
+			`<Rule><Tag><Key>archived</Key></Tag><Pool>3</Pool></Rule>` +
+			`</FanOutRoutingConfiguration>`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		tags map[string]string
+		want int
+	}{
+		{map[string]string{"class": "hot"}, 1},
+		{map[string]string{"class": "cold"}, 2},
+		// Value-less rule matches any value of the key.
+		{map[string]string{"archived": "yes"}, 3},
+		{map[string]string{"archived": ""}, 3},
+		// First matching rule in configuration order wins.
+		{map[string]string{"class": "hot", "archived": "yes"}, 1},
+		// No matching rule.
+		{map[string]string{"class": "warm"}, 0},
+		{map[string]string{"other": "hot"}, 0},
+		{nil, 0},
+	}
+	for i, testCase := range testCases {
+		if got := cfg.poolFor(testCase.tags); got != testCase.want {
+			t.Errorf("Test %d: expected pool %d for tags %v, got %d", i+1, testCase.want, testCase.tags, got)
+		}
+	}
+
+	// A bucket without the configuration routes nothing.
+	var none *FanOutRoutingConfig
+	if got := none.poolFor(map[string]string{"class": "hot"}); got != 0 {
+		t.Errorf("expected pool 0 on nil config, got %d", got)
+	}
+}
@@ -20,6 +20,7 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
@@ -31,11 +32,11 @@ import (
 	"net/textproto"
 	"net/url"
 	"path"
-	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/minio/mux"
@@ -45,11 +46,13 @@ import (
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/minio/internal/amztime"
 	"github.com/minio/minio/internal/auth"
 	sse "github.com/minio/minio/internal/bucket/encryption"
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/bucket/replication"
 	"github.com/minio/minio/internal/config/dns"
+	"github.com/minio/minio/internal/config/storageclass"
 	"github.com/minio/minio/internal/crypto"
 	"github.com/minio/minio/internal/event"
 	"github.com/minio/minio/internal/handlers"
@@ -61,6 +64,7 @@ import (
 	"github.com/minio/pkg/bucket/policy"
 	iampolicy "github.com/minio/pkg/iam/policy"
 	"github.com/minio/pkg/sync/errgroup"
+	"github.com/minio/pkg/wildcard"
 )
 
 const (
@@ -68,8 +72,9 @@ const (
 	bucketTaggingConfig     = "tagging.xml"
 	bucketReplicationConfig = "replication.xml"
 
-	xMinIOErrCodeHeader = "x-minio-error-code"
-	xMinIOErrDescHeader = "x-minio-error-desc"
+	xMinIOErrCodeHeader      = "x-minio-error-code"
+	xMinIOErrDescHeader      = "x-minio-error-desc"
+	xMinIOCascadeWarnsHeader = "x-minio-cascade-warnings"
 )
 
 // Check if there are buckets on server without corresponding entry in etcd backend and
@@ -100,23 +105,222 @@ func initFederatorBackend(buckets []BucketInfo, objLayer ObjectLayer) {
 		return
 	}
 
-	bucketsSet := set.NewStringSet()
-	bucketsToBeUpdated := set.NewStringSet()
-	bucketsInConflict := set.NewStringSet()
-
 	// This means that domain is updated, we should update
 	// all bucket entries with new domain name.
 	domainMissing := err == dns.ErrDomainMissing
+	comparison := compareFederationDNS(buckets, dnsBuckets, globalDomainIPs, domainMissing)
+
+	// Add/update buckets that are not registered with the DNS
+	bucketsToBeUpdatedSlice := comparison.bucketsToBeUpdated.ToSlice()
+	g := errgroup.WithNErrs(len(bucketsToBeUpdatedSlice)).WithConcurrency(50)
+
+	ctx := GlobalContext
+	for index := range bucketsToBeUpdatedSlice {
+		index := index
+		g.Go(func() error {
+			return federationDNSPut(ctx, bucketsToBeUpdatedSlice[index])
+		}, index)
+	}
+
+	var failed []string
+	for index, err := range g.Wait() {
+		if err != nil {
+			logger.LogIf(ctx, fmt.Errorf("Unable to add bucket DNS entry for bucket %s: %w", bucketsToBeUpdatedSlice[index], err))
+			failed = append(failed, bucketsToBeUpdatedSlice[index])
+		}
+	}
+	if len(failed) > 0 {
+		// Queue buckets whose registration keeps failing for the
+		// periodic retrier instead of forgetting them until the next
+		// restart.
+		globalFederationPending.add(failed...)
+		logger.LogIf(ctx, fmt.Errorf("%d out of %d bucket DNS registrations are still pending (%v), they will be retried periodically", len(failed), len(bucketsToBeUpdatedSlice), failed))
+	}
+
+	for _, bucket := range comparison.bucketsInConflict.ToSlice() {
+		logger.LogIf(ctx, fmt.Errorf("Unable to add bucket DNS entry for bucket %s, an entry exists for the same bucket by a different tenant. This local bucket will be ignored. Bucket names are globally unique in federated deployments. Use path style requests on following addresses '%v' to access this bucket", bucket, globalDomainIPs.ToSlice()))
+	}
+
+	for _, bucket := range comparison.caseConflictBuckets.ToSlice() {
+		logger.LogIf(ctx, fmt.Errorf("Bucket DNS entry %s differs from a local bucket only by letter case. DNS names are case-insensitive so the entries shadow each other, the local bucket was not registered. Rename one of the buckets to resolve the conflict", bucket))
+	}
+
+	// Remove buckets that are in DNS for this server, but aren't local,
+	// with the same bounded fan-out as the update path so startup
+	// reconciliation of large federations does not spike connections to
+	// the DNS backend.
+	staleDNSBuckets := comparison.staleDNSBuckets.ToSlice()
+	gd := errgroup.WithNErrs(len(staleDNSBuckets)).WithConcurrency(50)
+	for index := range staleDNSBuckets {
+		index := index
+		gd.Go(func() error {
+			// We go to here, so we know the bucket no longer exists,
+			// but is registered in DNS to this server
+			return globalDNSConfig.Delete(staleDNSBuckets[index])
+		}, index)
+	}
+	for index, err := range gd.Wait() {
+		if err != nil {
+			logger.LogIf(ctx, fmt.Errorf("Failed to remove DNS entry for %s due to %w",
+				staleDNSBuckets[index], err))
+		}
+	}
+}
+
+// Bounded retry/backoff applied to a federated DNS registration before
+// the bucket is handed to the periodic retrier.
+const (
+	federationDNSPutAttempts   = 3
+	federationDNSPutRetryDelay = 250 * time.Millisecond
+	federationDNSRetryInterval = 5 * time.Minute
+)
+
+// federationDNSPut registers bucket in the federated DNS store, transient
+// etcd failures are retried with doubling backoff a bounded number of
+// times before the last error is returned.
+func federationDNSPut(ctx context.Context, bucket string) error {
+	delay := federationDNSPutRetryDelay
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = globalDNSConfig.Put(bucket); err == nil {
+			return nil
+		}
+		if attempt == federationDNSPutAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// federationPendingBuckets queues buckets whose federated DNS
+// registration kept failing, a background loop retries them periodically
+// so an etcd outage at startup does not leave buckets unregistered until
+// the next restart.
+type federationPendingBuckets struct {
+	mu      sync.Mutex
+	buckets set.StringSet
+	once    sync.Once
+}
+
+var globalFederationPending = &federationPendingBuckets{buckets: set.NewStringSet()}
+
+// add queues buckets for periodic DNS registration retries and starts
+// the retry loop on first use.
+func (f *federationPendingBuckets) add(buckets ...string) {
+	f.mu.Lock()
+	for _, bucket := range buckets {
+		f.buckets.Add(bucket)
+	}
+	f.mu.Unlock()
+	f.once.Do(func() {
+		go f.retryLoop(GlobalContext)
+	})
+}
+
+func (f *federationPendingBuckets) remove(bucket string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.buckets.Remove(bucket)
+}
+
+// count returns the number of registrations still pending, exported as a
+// metric.
+func (f *federationPendingBuckets) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.buckets)
+}
+
+func (f *federationPendingBuckets) snapshot() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.buckets.ToSlice()
+}
+
+func (f *federationPendingBuckets) retryLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(federationDNSRetryInterval):
+		}
+		for _, bucket := range f.snapshot() {
+			// The bucket may be gone by now, deleting a bucket cleans up
+			// its own DNS entry.
+			if objAPI := newObjectLayerFn(); objAPI != nil {
+				if _, err := objAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); isErrBucketNotFound(err) {
+					f.remove(bucket)
+					continue
+				}
+			}
+			if err := globalDNSConfig.Put(bucket); err != nil {
+				logger.LogOnceIf(ctx, fmt.Errorf("Unable to add bucket DNS entry for bucket %s: %w, will keep retrying", bucket, err), "federation-dns-put-"+bucket)
+				continue
+			}
+			f.remove(bucket)
+		}
+	}
+}
+
+// federationDNSComparison is the classification of local buckets against
+// the federated DNS store computed by compareFederationDNS.
+type federationDNSComparison struct {
+	bucketsSet          set.StringSet // all local buckets
+	bucketsToBeUpdated  set.StringSet // missing in DNS or registered with outdated domain IPs
+	bucketsInConflict   set.StringSet // registered in DNS by a different deployment
+	staleDNSBuckets     set.StringSet // registered in DNS for this server, but no longer local
+	caseConflictBuckets set.StringSet // DNS entries differing from a local bucket only by letter case
+}
+
+// federationCaseConflict returns the name of an existing DNS entry that
+// differs from bucket only by letter case, empty when there is none. DNS
+// names are case-insensitive, such entries shadow each other when
+// resolved.
+func federationCaseConflict(bucket string, dnsBuckets map[string][]dns.SrvRecord) string {
+	for name := range dnsBuckets {
+		if name != bucket && strings.EqualFold(name, bucket) {
+			return name
+		}
+	}
+	return ""
+}
+
+// compareFederationDNS classifies local buckets against the DNS store
+// entries without mutating either side. domainIPs are the local domain
+// IPs used for ownership checks, domainMissing indicates the configured
+// domain is new to the DNS store so unchanged entries still need to be
+// refreshed.
+func compareFederationDNS(buckets []BucketInfo, dnsBuckets map[string][]dns.SrvRecord, domainIPs set.StringSet, domainMissing bool) federationDNSComparison {
+	comparison := federationDNSComparison{
+		bucketsSet:          set.NewStringSet(),
+		bucketsToBeUpdated:  set.NewStringSet(),
+		bucketsInConflict:   set.NewStringSet(),
+		staleDNSBuckets:     set.NewStringSet(),
+		caseConflictBuckets: set.NewStringSet(),
+	}
+
 	if dnsBuckets != nil {
 		for _, bucket := range buckets {
-			bucketsSet.Add(bucket.Name)
+			comparison.bucketsSet.Add(bucket.Name)
 			r, ok := dnsBuckets[bucket.Name]
 			if !ok {
-				bucketsToBeUpdated.Add(bucket.Name)
+				// Registering this bucket would create an entry that
+				// resolves identically to an existing one, flag it
+				// instead.
+				if existing := federationCaseConflict(bucket.Name, dnsBuckets); existing != "" {
+					comparison.caseConflictBuckets.Add(existing)
+					continue
+				}
+				comparison.bucketsToBeUpdated.Add(bucket.Name)
 				continue
 			}
-			if !globalDomainIPs.Intersection(set.CreateStringSet(getHostsSlice(r)...)).IsEmpty() {
-				if globalDomainIPs.Difference(set.CreateStringSet(getHostsSlice(r)...)).IsEmpty() && !domainMissing {
+			if !domainIPs.Intersection(set.CreateStringSet(getHostsSlice(r)...)).IsEmpty() {
+				if domainIPs.Difference(set.CreateStringSet(getHostsSlice(r)...)).IsEmpty() && !domainMissing {
 					// No difference in terms of domainIPs and nothing
 					// has changed so we don't change anything on the etcd.
 					//
@@ -131,7 +335,7 @@ func initFederatorBackend(buckets []BucketInfo, objLayer ObjectLayer) {
 				// but if we do see a difference with local domain IPs with
 				// hostSlice from etcd then we should update with newer
 				// domainIPs, we proceed to do that here.
-				bucketsToBeUpdated.Add(bucket.Name)
+				comparison.bucketsToBeUpdated.Add(bucket.Name)
 				continue
 			}
 
@@ -140,57 +344,25 @@ func initFederatorBackend(buckets []BucketInfo, objLayer ObjectLayer) {
 			// bucket names are globally unique in federation at a given
 			// path prefix, name collision is not allowed. We simply log
 			// an error and continue.
-			bucketsInConflict.Add(bucket.Name)
-		}
-	}
-
-	// Add/update buckets that are not registered with the DNS
-	bucketsToBeUpdatedSlice := bucketsToBeUpdated.ToSlice()
-	g := errgroup.WithNErrs(len(bucketsToBeUpdatedSlice)).WithConcurrency(50)
-
-	for index := range bucketsToBeUpdatedSlice {
-		index := index
-		g.Go(func() error {
-			return globalDNSConfig.Put(bucketsToBeUpdatedSlice[index])
-		}, index)
-	}
-
-	ctx := GlobalContext
-	for _, err := range g.Wait() {
-		if err != nil {
-			logger.LogIf(ctx, err)
-			return
+			comparison.bucketsInConflict.Add(bucket.Name)
 		}
 	}
 
-	for _, bucket := range bucketsInConflict.ToSlice() {
-		logger.LogIf(ctx, fmt.Errorf("Unable to add bucket DNS entry for bucket %s, an entry exists for the same bucket by a different tenant. This local bucket will be ignored. Bucket names are globally unique in federated deployments. Use path style requests on following addresses '%v' to access this bucket", bucket, globalDomainIPs.ToSlice()))
-	}
-
-	var wg sync.WaitGroup
-	// Remove buckets that are in DNS for this server, but aren't local
+	// Buckets that are in DNS for this server, but aren't local
 	for bucket, records := range dnsBuckets {
-		if bucketsSet.Contains(bucket) {
+		if comparison.bucketsSet.Contains(bucket) {
 			continue
 		}
 
-		if globalDomainIPs.Intersection(set.CreateStringSet(getHostsSlice(records)...)).IsEmpty() {
+		if domainIPs.Intersection(set.CreateStringSet(getHostsSlice(records)...)).IsEmpty() {
 			// This is not for our server, so we can continue
 			continue
 		}
 
-		wg.Add(1)
-		go func(bucket string) {
-			defer wg.Done()
-			// We go to here, so we know the bucket no longer exists,
-			// but is registered in DNS to this server
-			if err := globalDNSConfig.Delete(bucket); err != nil {
-				logger.LogIf(GlobalContext, fmt.Errorf("Failed to remove DNS entry for %s due to %w",
-					bucket, err))
-			}
-		}(bucket)
+		comparison.staleDNSBuckets.Add(bucket)
 	}
-	wg.Wait()
+
+	return comparison
 }
 
 // GetBucketLocationHandler - GET Bucket location.
@@ -294,6 +466,10 @@ func (api objectAPIHandlers) ListMultipartUploadsHandler(w http.ResponseWriter,
 	writeSuccessResponseXML(w, encodedSuccessResponse)
 }
 
+// listBucketUsageAction grants just the usage extension of ListBuckets
+// (x-minio-usage) without handing out the full admin data usage API.
+const listBucketUsageAction = iampolicy.Action("s3:ListBucketUsage")
+
 // ListBucketsHandler - GET Service.
 // -----------
 // This implementation of the GET operation returns a list of all buckets
@@ -399,14 +575,134 @@ func (api objectAPIHandlers) ListBucketsHandler(w http.ResponseWriter, r *http.R
 		}
 	}
 
+	// Usage enrichment is read from the local data usage snapshot and
+	// skipped on the federated DNS listing, those buckets span peers.
+	var dui *DataUsageInfo
+	if v, _ := strconv.ParseBool(r.Header.Get(xhttp.MinIOUsage)); v && !(globalDNSConfig != nil && globalBucketFederation) {
+		allowed := owner
+		for _, action := range []iampolicy.Action{listBucketUsageAction, iampolicy.DataUsageInfoAdminAction} {
+			if allowed {
+				break
+			}
+			allowed = globalIAMSys.IsAllowed(iampolicy.Args{
+				AccountName:     cred.AccessKey,
+				Groups:          cred.Groups,
+				Action:          action,
+				ConditionValues: getConditionValues(r, "", cred),
+				IsOwner:         owner,
+				Claims:          cred.Claims,
+			})
+		}
+		if allowed {
+			if usage, err := loadDataUsageFromBackend(ctx, objectAPI); err == nil {
+				dui = &usage
+			}
+		}
+	}
+
 	// Generate response.
-	response := generateListBucketsResponse(bucketsInfo)
+	response := generateListBucketsResponse(bucketsInfo, dui)
 	encodedSuccessResponse := encodeResponse(response)
 
 	// Write response.
 	writeSuccessResponseXML(w, encodedSuccessResponse)
 }
 
+// multiDeleteResponseWriter writes multi-delete results to the client. By
+// default results are buffered and written as the usual S3 response at
+// Close(). When streaming is requested via the x-minio-streaming-delete
+// header, XML results are instead emitted element by element as deletions
+// complete, bounding memory use and giving clients early feedback on very
+// large requests. JSON responses cannot be emitted incrementally and are
+// always buffered.
+type multiDeleteResponseWriter struct {
+	w         http.ResponseWriter
+	flusher   http.Flusher
+	enc       *xml.Encoder
+	json      bool
+	quiet     bool
+	streaming bool
+	dryRun    bool
+	started   bool
+
+	deletedObjects []DeletedObject
+	deleteErrors   []DeleteError
+}
+
+func newMultiDeleteResponseWriter(w http.ResponseWriter, json, quiet, streaming, dryRun bool) *multiDeleteResponseWriter {
+	flusher, _ := w.(http.Flusher)
+	return &multiDeleteResponseWriter{w: w, flusher: flusher, json: json, quiet: quiet, streaming: streaming && !json, dryRun: dryRun}
+}
+
+// start writes the response headers and the DeleteResult opening tag once.
+func (m *multiDeleteResponseWriter) start() {
+	if m.started {
+		return
+	}
+	m.started = true
+	setCommonHeaders(m.w)
+	m.w.Header().Set(xhttp.ContentType, string(mimeXML))
+	m.w.WriteHeader(http.StatusOK)
+	m.w.Write([]byte(xml.Header))
+	if m.dryRun {
+		m.w.Write([]byte(`<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/" DryRun="true">`))
+	} else {
+		m.w.Write([]byte(`<DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`))
+	}
+	m.enc = xml.NewEncoder(m.w)
+}
+
+// WriteDeleted emits a successfully deleted object, suppressed in quiet mode.
+func (m *multiDeleteResponseWriter) WriteDeleted(dobj DeletedObject) {
+	if !m.streaming {
+		m.deletedObjects = append(m.deletedObjects, dobj)
+		return
+	}
+	if m.quiet || dobj.ObjectName == "" {
+		return
+	}
+	m.start()
+	m.enc.EncodeElement(dobj, xml.StartElement{Name: xml.Name{Local: "Deleted"}})
+}
+
+// WriteError emits a per-object delete error.
+func (m *multiDeleteResponseWriter) WriteError(derr DeleteError) {
+	if !m.streaming {
+		m.deleteErrors = append(m.deleteErrors, derr)
+		return
+	}
+	m.start()
+	m.enc.EncodeElement(derr, xml.StartElement{Name: xml.Name{Local: "Error"}})
+}
+
+// Flush pushes everything streamed so far out to the client, a no-op when
+// buffering.
+func (m *multiDeleteResponseWriter) Flush() {
+	if m.streaming && m.started && m.flusher != nil {
+		m.flusher.Flush()
+	}
+}
+
+// Close terminates the response, when buffering this writes the collected
+// results in one shot.
+func (m *multiDeleteResponseWriter) Close() error {
+	if !m.streaming {
+		response := generateMultiDeleteResponse(m.quiet, m.dryRun, m.deletedObjects, m.deleteErrors)
+		if m.json {
+			writeSuccessResponseJSON(m.w, encodeResponseJSON(response))
+		} else {
+			writeSuccessResponseXML(m.w, encodeResponse(response))
+		}
+		return nil
+	}
+	m.start()
+	_, err := m.w.Write([]byte("</DeleteResult>"))
+	if m.flusher != nil {
+		m.flusher.Flush()
+	}
+	return err
+}
+
 // DeleteMultipleObjectsHandler - deletes multiple objects.
 func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "DeleteMultipleObjects")
@@ -444,9 +740,38 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 		return
 	}
 
+	// Some lightweight clients prefer JSON over the S3 XML payload, accept
+	// either encoding and mirror it in the response.
+	contentType, _, _ := strings.Cut(r.Header.Get(xhttp.ContentType), ";")
+	isJSONRequest := strings.TrimSpace(contentType) == string(mimeJSON)
+
+	clientMD5, err := base64.StdEncoding.Strict().DecodeString(r.Header.Get(xhttp.ContentMD5))
+	if err != nil || len(clientMD5) != md5.Size {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidDigest), r.URL)
+		return
+	}
+
+	// Verify the received body against the supplied Content-MD5 before
+	// decoding, a mangled object list must not reach the delete path.
+	// http://docs.aws.amazon.com/AmazonS3/latest/API/multiobjectdeleteapi.html
+	deleteBody, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	if sum := md5.Sum(deleteBody); !bytes.Equal(sum[:], clientMD5) {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrBadDigest), r.URL)
+		return
+	}
+
 	// Unmarshal list of keys to be deleted.
 	deleteObjectsReq := &DeleteObjectsRequest{}
-	if err := xmlDecoder(r.Body, deleteObjectsReq, maxBodySize); err != nil {
+	if isJSONRequest {
+		if err := json.NewDecoder(bytes.NewReader(deleteBody)).Decode(deleteObjectsReq); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	} else if err := xmlDecoder(bytes.NewReader(deleteBody), deleteObjectsReq, maxBodySize); err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
@@ -466,19 +791,66 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 	checkRequestAuthType(ctx, r, policy.DeleteObjectAction, bucket, "")
 
 	// Before proceeding validate if bucket exists.
-	_, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{})
+	_, err = objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{})
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
 
+	// Compliance guard, the bucket categorically rejects bulk deletes
+	// regardless of per-object permissions.
+	if bulkDeleteDisabled(bucket) {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrBucketBulkDeleteDisabled), r.URL)
+		return
+	}
+
+	// MinIO extension: a DeleteMarkersOnly request carries no object list,
+	// the server enumerates delete markers under the given prefix and
+	// feeds them through the regular versioned delete path below, so
+	// object lock and replication are honored exactly as for explicit
+	// versioned deletes. Truncation and continuation markers are
+	// advertised on the response headers.
+	var deleteAction policy.Action = policy.DeleteObjectAction
+	if deleteObjectsReq.DeleteMarkersOnly != "" {
+		if len(deleteObjectsReq.Objects) != 0 {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMalformedXML), r.URL)
+			return
+		}
+		prefix := trimLeadingSlash(deleteObjectsReq.DeleteMarkersOnly)
+		deleteAction = policy.DeleteObjectVersionAction
+		if s3Error := checkRequestAuthType(ctx, r, deleteAction, bucket, prefix); s3Error != ErrNone {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+			return
+		}
+		lres, err := objectAPI.ListObjectVersions(ctx, bucket, prefix, deleteObjectsReq.KeyMarker, deleteObjectsReq.VersionIDMarker, "", maxDeleteList)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+		for _, oi := range lres.Objects {
+			if !oi.DeleteMarker {
+				continue
+			}
+			deleteObjectsReq.Objects = append(deleteObjectsReq.Objects, ObjectToDelete{
+				ObjectV: ObjectV{ObjectName: oi.Name, VersionID: oi.VersionID},
+			})
+		}
+		w.Header().Set(xhttp.MinIODeleteMarkersMatched, strconv.Itoa(len(deleteObjectsReq.Objects)))
+		if lres.IsTruncated {
+			w.Header().Set(xhttp.MinIODeleteMarkersTruncated, "true")
+			w.Header().Set(xhttp.MinIODeleteMarkersNextKeyMarker, lres.NextMarker)
+			w.Header().Set(xhttp.MinIODeleteMarkersNextVersionIDMarker, lres.NextVersionIDMarker)
+		}
+	}
+
 	deleteObjectsFn := objectAPI.DeleteObjects
 	if api.CacheAPI() != nil {
 		deleteObjectsFn = api.CacheAPI().DeleteObjects
 	}
 
-	// Return Malformed XML as S3 spec if the number of objects is empty
-	if len(deleteObjectsReq.Objects) == 0 || len(deleteObjectsReq.Objects) > maxDeleteList {
+	// Return Malformed XML as S3 spec if the number of objects is empty,
+	// except for a DeleteMarkersOnly prefix that matched nothing.
+	if (len(deleteObjectsReq.Objects) == 0 && deleteObjectsReq.DeleteMarkersOnly == "") || len(deleteObjectsReq.Objects) > maxDeleteList {
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrMalformedXML), r.URL)
 		return
 	}
@@ -489,11 +861,33 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 		getObjectInfoFn = api.CacheAPI().GetObjectInfo
 	}
 
+	// MinIO extension: clients may ask for each object's etag, size and
+	// encryption mode at the moment of deletion to be recorded on the
+	// response and the emitted removal events, for audit pipelines.
+	auditDelete := false
+	if value := r.Header.Get(xhttp.MinIODeleteAudit); value != "" {
+		auditDelete, _ = strconv.ParseBool(value)
+	}
+
+	// MinIO extension: clients may ask how many versions of each object
+	// remain after a version specific delete, so lifecycle tooling can
+	// decide follow-up actions without re-listing.
+	reportRemVersions := false
+	if value := r.Header.Get(xhttp.MinIODeleteRemainingVersions); value != "" {
+		reportRemVersions, _ = strconv.ParseBool(value)
+	}
+
+	// MinIO extension: a dry run performs the full auth, lock and
+	// replication-decision evaluation and reports the projected per
+	// object outcomes, but deletes nothing and emits no events.
+	dryRun := false
+	if value := r.Header.Get(xhttp.MinIODryRun); value != "" {
+		dryRun, _ = strconv.ParseBool(value)
+	}
+
 	var (
 		hasLockEnabled bool
 		dsc            ReplicateDecision
-		goi            ObjectInfo
-		gerr           error
 	)
 	replicateDeletes := hasReplicationRules(ctx, bucket, deleteObjectsReq.Objects)
 	if rcfg, _ := globalBucketObjectLockSys.Get(bucket); rcfg.LockEnabled {
@@ -508,9 +902,44 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 	deleteResults := make([]deleteResult, len(deleteObjectsReq.Objects))
 
 	vc, _ := globalBucketVersioningSys.Get(bucket)
+
+	// Stat every object upfront when a delete audit is requested, with
+	// bounded concurrency so large batches don't fan out into too many
+	// concurrent reads. A failed stat only means the audit attributes
+	// are omitted, the delete itself still proceeds.
+	var auditInfos []ObjectInfo
+	var auditErrs []error
+	if auditDelete {
+		auditInfos = make([]ObjectInfo, len(deleteObjectsReq.Objects))
+		auditErrs = make([]error, len(deleteObjectsReq.Objects))
+		ag := errgroup.WithNErrs(len(deleteObjectsReq.Objects)).WithConcurrency(16)
+		for index := range deleteObjectsReq.Objects {
+			index := index
+			object := deleteObjectsReq.Objects[index]
+			ag.Go(func() error {
+				auditInfos[index], auditErrs[index] = getObjectInfoFn(ctx, bucket, object.ObjectName, ObjectOptions{
+					VersionID:        object.VersionID,
+					Versioned:        vc.PrefixEnabled(object.ObjectName),
+					VersionSuspended: vc.Suspended(),
+				})
+				return nil
+			}, index)
+		}
+		ag.Wait()
+	}
+
+	// Object infos fetched in the lock/tier path, kept per index so the
+	// remaining version counts can be derived from them after the delete.
+	var gois []ObjectInfo
+	var gerrs []error
+	if reportRemVersions {
+		gois = make([]ObjectInfo, len(deleteObjectsReq.Objects))
+		gerrs = make([]error, len(deleteObjectsReq.Objects))
+	}
+
 	oss := make([]*objSweeper, len(deleteObjectsReq.Objects))
 	for index, object := range deleteObjectsReq.Objects {
-		if apiErrCode := checkRequestAuthTypeWithVID(ctx, r, policy.DeleteObjectAction, bucket, object.ObjectName, object.VersionID); apiErrCode != ErrNone {
+		if apiErrCode := checkRequestAuthTypeWithVID(ctx, r, deleteAction, bucket, object.ObjectName, object.VersionID); apiErrCode != ErrNone {
 			if apiErrCode == ErrSignatureDoesNotMatch || apiErrCode == ErrInvalidAccessKeyID {
 				writeErrorResponse(ctx, w, errorCodes.ToAPIErr(apiErrCode), r.URL)
 				return
@@ -543,16 +972,28 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 			VersionSuspended: vc.Suspended(),
 		}
 
-		if replicateDeletes || object.VersionID != "" && hasLockEnabled || !globalTierConfigMgr.Empty() {
+		// Fetched fresh for this entry only, never carried over to the
+		// next iteration of the loop. gerr starts non-nil so skipping the
+		// fetch can never pass as a successful one.
+		var goi ObjectInfo
+		gerr := errInvalidArgument
+		if replicateDeletes || object.VersionID != "" && (hasLockEnabled || reportRemVersions) || !globalTierConfigMgr.Empty() {
 			if !globalTierConfigMgr.Empty() && object.VersionID == "" && opts.VersionSuspended {
 				opts.VersionID = nullVersionID
 			}
-			goi, gerr = getObjectInfoFn(ctx, bucket, object.ObjectName, opts)
+			if auditDelete && opts.VersionID == object.VersionID {
+				// Reuse the stat fetched for the audit attributes.
+				goi, gerr = auditInfos[index], auditErrs[index]
+			} else {
+				goi, gerr = getObjectInfoFn(ctx, bucket, object.ObjectName, opts)
+			}
+			if reportRemVersions {
+				gois[index], gerrs[index] = goi, gerr
+			}
 		}
 
 		if !globalTierConfigMgr.Empty() {
-			oss[index] = newObjSweeper(bucket, object.ObjectName).WithVersion(opts.VersionID).WithVersioning(opts.Versioned, opts.VersionSuspended)
-			oss[index].SetTransitionState(goi.TransitionedObject)
+			oss[index] = newDeleteSweeper(bucket, object.ObjectName, opts, goi, gerr)
 		}
 
 		// All deletes on directory objects needs to be for `nullVersionID`
@@ -580,12 +1021,23 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 		if object.VersionID != "" && hasLockEnabled {
 			if apiErrCode := enforceRetentionBypassForDelete(ctx, r, bucket, object, goi, gerr); apiErrCode != ErrNone {
 				apiErr := errorCodes.ToAPIErr(apiErrCode)
-				deleteResults[index].errInfo = DeleteError{
+				derr := DeleteError{
 					Code:      apiErr.Code,
 					Message:   apiErr.Description,
 					Key:       object.ObjectName,
 					VersionID: object.VersionID,
 				}
+				if apiErrCode == ErrObjectLocked {
+					// Tell the client exactly when this version becomes
+					// deletable again.
+					if ret := objectlock.GetObjectRetentionMeta(goi.UserDefined); ret.Mode.Valid() {
+						derr.RetentionMode = string(ret.Mode)
+						if !ret.RetainUntilDate.IsZero() {
+							derr.RetainUntilDate = amztime.ISO8601Format(ret.RetainUntilDate.UTC())
+						}
+					}
+				}
+				deleteResults[index].errInfo = derr
 				continue
 			}
 		}
@@ -610,62 +1062,16 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 	ctx = bgContext(ctx)
 
 	deleteList := toNames(objectsToDelete)
-	dObjects, errs := deleteObjectsFn(ctx, bucket, deleteList, ObjectOptions{
+
+	opts := ObjectOptions{
 		PrefixEnabledFn:  vc.PrefixEnabled,
 		VersionSuspended: vc.Suspended(),
-	})
-
-	for i := range errs {
-		// DeleteMarkerVersionID is not used specifically to avoid
-		// lookup errors, since DeleteMarkerVersionID is only
-		// created during DeleteMarker creation when client didn't
-		// specify a versionID.
-		objToDel := ObjectToDelete{
-			ObjectV: ObjectV{
-				ObjectName: dObjects[i].ObjectName,
-				VersionID:  dObjects[i].VersionID,
-			},
-			VersionPurgeStatus:            dObjects[i].VersionPurgeStatus(),
-			VersionPurgeStatuses:          dObjects[i].ReplicationState.VersionPurgeStatusInternal,
-			DeleteMarkerReplicationStatus: dObjects[i].ReplicationState.ReplicationStatusInternal,
-			ReplicateDecisionStr:          dObjects[i].ReplicationState.ReplicateDecisionStr,
-		}
-		dindex := objectsToDelete[objToDel]
-		if errs[i] == nil || isErrObjectNotFound(errs[i]) || isErrVersionNotFound(errs[i]) {
-			if replicateDeletes {
-				dObjects[i].ReplicationState = deleteList[i].ReplicationState()
-			}
-			deleteResults[dindex].delInfo = dObjects[i]
-			continue
-		}
-		apiErr := toAPIError(ctx, errs[i])
-		deleteResults[dindex].errInfo = DeleteError{
-			Code:      apiErr.Code,
-			Message:   apiErr.Description,
-			Key:       deleteList[i].ObjectName,
-			VersionID: deleteList[i].VersionID,
-		}
-	}
-
-	// Generate response
-	deleteErrors := make([]DeleteError, 0, len(deleteObjectsReq.Objects))
-	deletedObjects := make([]DeletedObject, 0, len(deleteObjectsReq.Objects))
-	for _, deleteResult := range deleteResults {
-		if deleteResult.errInfo.Code != "" {
-			deleteErrors = append(deleteErrors, deleteResult.errInfo)
-		} else {
-			deletedObjects = append(deletedObjects, deleteResult.delInfo)
-		}
 	}
 
-	response := generateMultiDeleteResponse(deleteObjectsReq.Quiet, deletedObjects, deleteErrors)
-	encodedSuccessResponse := encodeResponse(response)
-
-	// Write success response.
-	writeSuccessResponseXML(w, encodedSuccessResponse)
-	for _, dobj := range deletedObjects {
+	// Schedule replication and notify listeners for a deleted object.
+	notifyDeleted := func(dobj DeletedObject) {
 		if dobj.ObjectName == "" {
-			continue
+			return
 		}
 
 		if replicateDeletes && (dobj.DeleteMarkerReplicationStatus() == replication.Pending || dobj.VersionPurgeStatus() == Pending) {
@@ -684,6 +1090,13 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 			DeleteMarker: dobj.DeleteMarker,
 		}
 
+		// Carry the audit attributes, when recorded, on the removal event.
+		objInfo.ETag = dobj.ETag
+		objInfo.Size = dobj.Size
+		if dobj.SSEAlgorithm != "" {
+			objInfo.UserDefined = map[string]string{xhttp.MinIODeleteAuditSSE: dobj.SSEAlgorithm}
+		}
+
 		if objInfo.DeleteMarker {
 			objInfo.VersionID = dobj.DeleteMarkerVersionID
 			eventName = event.ObjectRemovedDeleteMarkerCreated
@@ -698,57 +1111,208 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 			UserAgent:    r.UserAgent(),
 			Host:         handlers.GetSourceIP(r),
 		})
-	}
 
-	// Clean up transitioned objects from remote tier
-	for _, os := range oss {
-		if os == nil { // skip objects that weren't deleted due to invalid versionID etc.
-			continue
+		if dobj.DeleteMarker {
+			// The delete marker pushed another version into noncurrent
+			// state, prune the oldest noncurrent versions beyond the
+			// bucket's configured limit, if any.
+			enforceNoncurrentVersionsLimit(bucket, dobj.ObjectName, 0)
 		}
-		logger.LogIf(ctx, os.Sweep())
 	}
-}
-
-// PutBucketHandler - PUT Bucket
-// ----------
-// This implementation of the PUT operation creates a new bucket for authenticated request
-func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Request) {
-	ctx := newContext(r, w, "PutBucket")
 
-	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+	// MinIO extension: clients may opt in to receiving results
+	// incrementally as deletions complete, the S3-compatible buffered
+	// response remains the default.
+	streaming := false
+	if value := r.Header.Get(xhttp.MinIOStreamingDelete); value != "" {
+		streaming, _ = strconv.ParseBool(value)
+	}
 
-	objectAPI := api.ObjectAPI()
-	if objectAPI == nil {
-		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
-		return
+	if dryRun {
+		// Echo the flag so responses from a simulation are unmistakable.
+		w.Header().Set(xhttp.MinIODryRun, "true")
 	}
 
-	vars := mux.Vars(r)
-	bucket := vars["bucket"]
+	mw := newMultiDeleteResponseWriter(w, isJSONRequest, deleteObjectsReq.Quiet, streaming, dryRun)
 
-	objectLockEnabled := false
-	if vs := r.Header.Get(xhttp.AmzObjectLockEnabled); len(vs) > 0 {
-		v := strings.ToLower(vs)
-		switch v {
-		case "true", "false":
-			objectLockEnabled = v == "true"
-		default:
-			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
-			return
+	if streaming {
+		// Results rejected during pre-processing (auth, retention) are
+		// known upfront, stream them first.
+		for _, deleteResult := range deleteResults {
+			if deleteResult.errInfo.Code != "" {
+				mw.WriteError(deleteResult.errInfo)
+			}
 		}
 	}
 
-	forceCreate := false
-	if vs := r.Header.Get(xhttp.MinIOForceCreate); len(vs) > 0 {
-		v := strings.ToLower(vs)
-		switch v {
-		case "true", "false":
-			forceCreate = v == "true"
-		default:
-			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
-			return
-		}
-	}
+	if dryRun {
+		// Project the outcome for every object that passed the auth, lock
+		// and replication evaluation above. The delete path is never
+		// entered, so a simulation consumes none of its throttle budget
+		// either.
+		for _, object := range deleteList {
+			dindex := objectsToDelete[object]
+			dobj := DeletedObject{
+				ObjectName: object.ObjectName,
+				VersionID:  object.VersionID,
+			}
+			if object.VersionID == "" && vc.PrefixEnabled(object.ObjectName) {
+				// Deleting the latest version on a versioned bucket would
+				// create a delete marker instead of removing content.
+				dobj.DeleteMarker = true
+			}
+			if replicateDeletes {
+				dobj.ReplicationState = object.ReplicationState()
+			}
+			if auditDelete {
+				dobj.setDeleteAudit(auditInfos[dindex], auditErrs[dindex])
+			}
+			deleteResults[dindex].delInfo = dobj
+			if streaming {
+				mw.WriteDeleted(dobj)
+			}
+		}
+		mw.Flush()
+		deleteList = nil
+	}
+
+	// Delete in bounded sub-batches, streaming results and flushing after
+	// each batch so clients observe progress on very large requests and
+	// replication/events don't pile up until the very end.
+	const maxDeleteBatch = 100
+	for start := 0; start < len(deleteList); start += maxDeleteBatch {
+		end := start + maxDeleteBatch
+		if end > len(deleteList) {
+			end = len(deleteList)
+		}
+		batch := deleteList[start:end]
+
+		dObjects, errs := deleteObjectsFn(ctx, bucket, batch, opts)
+		for i := range errs {
+			dindex := objectsToDelete[batch[i]]
+			if errs[i] == nil || isErrObjectNotFound(errs[i]) || isErrVersionNotFound(errs[i]) {
+				if replicateDeletes {
+					dObjects[i].ReplicationState = batch[i].ReplicationState()
+				}
+				if auditDelete {
+					dObjects[i].setDeleteAudit(auditInfos[dindex], auditErrs[dindex])
+				}
+				if reportRemVersions {
+					dObjects[i].setRemainingVersions(gois[dindex], gerrs[dindex])
+				}
+				deleteResults[dindex].delInfo = dObjects[i]
+				if streaming {
+					mw.WriteDeleted(dObjects[i])
+				}
+				notifyDeleted(dObjects[i])
+				continue
+			}
+			apiErr := toAPIError(ctx, errs[i])
+			derr := DeleteError{
+				Code:      apiErr.Code,
+				Message:   apiErr.Description,
+				Key:       batch[i].ObjectName,
+				VersionID: batch[i].VersionID,
+			}
+			deleteResults[dindex].errInfo = derr
+			if streaming {
+				mw.WriteError(derr)
+			}
+		}
+
+		mw.Flush()
+	}
+
+	if !streaming {
+		// Emit the collected results in request order for the buffered
+		// S3-compatible response.
+		for _, deleteResult := range deleteResults {
+			if deleteResult.errInfo.Code != "" {
+				mw.WriteError(deleteResult.errInfo)
+			} else {
+				mw.WriteDeleted(deleteResult.delInfo)
+			}
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		logger.LogIf(ctx, err)
+	}
+
+	if dryRun {
+		// Nothing was deleted, leave transitioned objects untouched.
+		return
+	}
+
+	// Clean up transitioned objects from remote tier, sweeps are
+	// independent of each other so run them concurrently with a bounded
+	// number of workers to keep the tail latency of large batches down.
+	g := errgroup.WithNErrs(len(oss)).WithConcurrency(16)
+	for index := range oss {
+		index := index
+		g.Go(func() error {
+			if oss[index] == nil { // skip objects that weren't deleted due to invalid versionID etc.
+				return nil
+			}
+			return oss[index].Sweep()
+		}, index)
+	}
+	for _, err := range g.Wait() {
+		logger.LogIf(ctx, err)
+	}
+}
+
+// PutBucketHandler - PUT Bucket
+// ----------
+// This implementation of the PUT operation creates a new bucket for authenticated request
+func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucket")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectLockEnabled := false
+	if vs := r.Header.Get(xhttp.AmzObjectLockEnabled); len(vs) > 0 {
+		v := strings.ToLower(vs)
+		switch v {
+		case "true", "false":
+			objectLockEnabled = v == "true"
+		default:
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+			return
+		}
+	}
+
+	forceCreate := false
+	if vs := r.Header.Get(xhttp.MinIOForceCreate); len(vs) > 0 {
+		v := strings.ToLower(vs)
+		switch v {
+		case "true", "false":
+			forceCreate = v == "true"
+		default:
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+			return
+		}
+	}
+
+	versioningEnabled := false
+	if vs := r.Header.Get(xhttp.MinIOVersioning); len(vs) > 0 {
+		switch vs {
+		case "Enabled":
+			versioningEnabled = true
+		default:
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+			return
+		}
+	}
 
 	cred, owner, s3Error := checkRequestAuthTypeCredential(ctx, r, policy.CreateBucketAction)
 	if s3Error != ErrNone {
@@ -756,6 +1320,22 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if versioningEnabled && !objectLockEnabled {
+		// Creating a bucket with versioning requires the user having more permissions
+		if !globalIAMSys.IsAllowed(iampolicy.Args{
+			AccountName:     cred.AccessKey,
+			Groups:          cred.Groups,
+			Action:          iampolicy.PutBucketVersioningAction,
+			ConditionValues: getConditionValues(r, "", cred),
+			BucketName:      bucket,
+			IsOwner:         owner,
+			Claims:          cred.Claims,
+		}) {
+			writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrAccessDenied), r.URL)
+			return
+		}
+	}
+
 	if objectLockEnabled {
 		// Creating a bucket with locking requires the user having more permissions
 		for _, action := range []iampolicy.Action{iampolicy.PutBucketObjectLockConfigurationAction, iampolicy.PutBucketVersioningAction} {
@@ -775,20 +1355,30 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	// Parse incoming location constraint.
-	_, s3Error = parseLocationConstraint(r)
+	location, s3Error := parseLocationConstraint(r)
 	if s3Error != ErrNone {
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
 		return
 	}
 
+	// A "pool:N" location constraint pins all of the bucket's new objects
+	// to that server pool.
+	pinnedPool, err := parseLocationPoolHint(location)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, InvalidArgument{Bucket: bucket, Err: err}), r.URL)
+		return
+	}
+
 	// check if client is attempting to create more buckets, complain about it.
 	if currBuckets := globalBucketMetadataSys.Count(); currBuckets+1 > maxBuckets {
 		logger.LogIf(ctx, fmt.Errorf("An attempt to create %d buckets beyond recommended %d", currBuckets+1, maxBuckets))
 	}
 
 	opts := MakeBucketOptions{
-		LockEnabled: objectLockEnabled,
-		ForceCreate: forceCreate,
+		LockEnabled:       objectLockEnabled,
+		VersioningEnabled: versioningEnabled,
+		ForceCreate:       forceCreate,
+		PinnedPool:        pinnedPool,
 	}
 
 	if globalDNSConfig != nil {
@@ -797,6 +1387,18 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 			// ErrNotImplemented indicates a DNS backend that doesn't need to check if bucket already
 			// exists elsewhere
 			if err == dns.ErrNoEntriesFound || err == dns.ErrNotImplemented {
+				// A legacy DNS entry differing only by letter case would
+				// shadow the new bucket when resolved, reject the
+				// creation outright.
+				if err == dns.ErrNoEntriesFound {
+					if dnsBuckets, lerr := globalDNSConfig.List(); lerr == nil {
+						if existing := federationCaseConflict(bucket, dnsBuckets); existing != "" {
+							writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrBucketCaseConflict), r.URL)
+							return
+						}
+					}
+				}
+
 				// Proceed to creating a bucket.
 				if err = objectAPI.MakeBucket(ctx, bucket, opts); err != nil {
 					writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -804,6 +1406,19 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 				}
 
 				if err = globalDNSConfig.Put(bucket); err != nil {
+					objectAPI.DeleteBucket(context.Background(), bucket, DeleteBucketOptions{
+						Force:      true,
+						SRDeleteOp: getSRBucketDeleteOp(globalSiteReplicationSys.isEnabled()),
+					})
+					setErrorDetail(ctx, APIErrorDetail{Subsystem: "dns", Resource: bucket, Retriable: true})
+					writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+					return
+				}
+
+				// Apply the deployment's lifecycle template, rolling the
+				// creation back when it cannot be stored.
+				if err = applyLifecycleTemplate(ctx, r, bucket); err != nil {
+					globalDNSConfig.Delete(bucket)
 					objectAPI.DeleteBucket(context.Background(), bucket, DeleteBucketOptions{
 						Force:      true,
 						SRDeleteOp: getSRBucketDeleteOp(globalSiteReplicationSys.isEnabled()),
@@ -820,17 +1435,20 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 
 				writeSuccessResponseHeadersOnly(w)
 
-				sendEvent(eventArgs{
+				evArgs := eventArgs{
 					EventName:    event.BucketCreated,
 					BucketName:   bucket,
 					ReqParams:    extractReqParams(r),
 					RespElements: extractRespElements(w),
 					UserAgent:    r.UserAgent(),
 					Host:         handlers.GetSourceIP(r),
-				})
+				}
+				sendEvent(evArgs)
+				globalSiteReplicationSys.forwardBucketEvent(ctx, evArgs)
 
 				return
 			}
+			setErrorDetail(ctx, APIErrorDetail{Subsystem: "dns", Resource: bucket, Retriable: true})
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 			return
 
@@ -860,6 +1478,17 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Apply the deployment's lifecycle template, rolling the creation
+	// back when it cannot be stored.
+	if err := applyLifecycleTemplate(ctx, r, bucket); err != nil {
+		objectAPI.DeleteBucket(context.Background(), bucket, DeleteBucketOptions{
+			Force:      true,
+			SRDeleteOp: getSRBucketDeleteOp(globalSiteReplicationSys.isEnabled()),
+		})
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
 	// Load updated bucket metadata into memory.
 	globalNotificationSys.LoadBucketMetadata(GlobalContext, bucket)
 
@@ -871,14 +1500,16 @@ func (api objectAPIHandlers) PutBucketHandler(w http.ResponseWriter, r *http.Req
 
 	writeSuccessResponseHeadersOnly(w)
 
-	sendEvent(eventArgs{
+	evArgs := eventArgs{
 		EventName:    event.BucketCreated,
 		BucketName:   bucket,
 		ReqParams:    extractReqParams(r),
 		RespElements: extractRespElements(w),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
-	})
+	}
+	sendEvent(evArgs)
+	globalSiteReplicationSys.forwardBucketEvent(ctx, evArgs)
 }
 
 // PostPolicyBucketHandler - POST policy
@@ -992,6 +1623,12 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 						writeErrorResponse(ctx, w, apiErr, r.URL)
 						return
 					}
+					// Validate storage class metadata if present
+					if sc := fanOutEntryStorageClass(m); sc != "" && !storageclass.IsValid(sc) {
+						part.Close()
+						writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrInvalidStorageClass), r.URL)
+						return
+					}
 					fanOutEntries = append(fanOutEntries, m)
 				}
 				part.Close()
@@ -1141,6 +1778,10 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		}
 	}
 
+	// Maximum upload size declared by a policy content-length-range, -1
+	// when the policy does not restrict it.
+	policyMaxSize := int64(-1)
+
 	// Handle policy if it is set.
 	if len(policyBytes) > 0 {
 		postPolicyForm, err := parsePostPolicyForm(bytes.NewReader(policyBytes))
@@ -1163,9 +1804,27 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		if lengthRange.Valid {
 			hashReader.SetExpectedMin(lengthRange.Min)
 			hashReader.SetExpectedMax(lengthRange.Max)
+			policyMaxSize = lengthRange.Max
 		}
 	}
 
+	// Enforce the bucket's maximum object size, the effective maximum is
+	// the smaller of the bucket limit and any policy content-length-range.
+	if limit, exceeded := exceedsBucketMaxObjectSize(bucket, fileSize); exceeded {
+		writeErrorResponse(ctx, w, bucketMaxObjectSizeError(bucket, limit), r.URL)
+		return
+	} else if limit > 0 && (policyMaxSize < 0 || limit < policyMaxSize) {
+		hashReader.SetExpectedMax(limit)
+	}
+
+	// Enforce the bucket's maximum versions per object, protecting
+	// against misbehaving uploaders re-POSTing the same key. Off unless
+	// configured on the bucket.
+	if limit, exceeded := exceedsBucketMaxVersions(ctx, objectAPI, bucket, object); exceeded {
+		writeErrorResponse(ctx, w, bucketMaxVersionsError(bucket, limit), r.URL)
+		return
+	}
+
 	// Extract metadata to be saved from received Form.
 	metadata := make(map[string]string)
 	err = extractMetadataFromMime(ctx, textproto.MIMEHeader(formValues), metadata)
@@ -1174,11 +1833,21 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		return
 	}
 
+	// Merge in bucket default object tags.
+	if err = applyBucketDefaultTags(bucket, metadata); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
 	rawReader := hashReader
 	pReader := NewPutObjReader(rawReader)
 	var objectEncryptionKey crypto.ObjectKey
 
 	// Check if bucket encryption is enabled
+	if s3Err := enforceBucketSSEConfig(bucket, formValues); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
 	sseConfig, _ := globalBucketSSEConfigSys.Get(bucket)
 	sseConfig.Apply(formValues, sse.ApplyOptions{
 		AutoEncrypt: globalAutoEncryption,
@@ -1261,6 +1930,13 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 	}
 
 	if len(fanOutEntries) > 0 {
+		// Route entries carrying matching tags to the pools the bucket's
+		// fan-out routing rules select, buckets without the configuration
+		// follow regular pool selection.
+		if cfg, _, err := globalBucketMetadataSys.GetFanOutRoutingConfig(bucket); err == nil {
+			fanOutOpts.Routing = cfg
+		}
+
 		// Fan-out requires no copying, and must be carried from original source
 		// https://en.wikipedia.org/wiki/Copy_protection so the incoming stream
 		// is always going to be in-memory as we cannot re-read from what we
@@ -1273,16 +1949,29 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		// Maximum allowed fan-out object size.
 		const maxFanOutSize = 16 << 20
 
-		n, err := io.Copy(buf, ioutil.HardLimitReader(pReader, maxFanOutSize))
+		// Each read from the incoming stream must make progress within
+		// this window, a stalled client would otherwise pin the pooled
+		// buffer indefinitely.
+		const maxFanOutReadDeadline = 30 * time.Second
+
+		dr := ioutil.NewDeadlineReader(io.NopCloser(pReader), maxFanOutReadDeadline)
+		n, err := io.Copy(buf, ioutil.HardLimitReader(dr, maxFanOutSize))
 		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrOperationTimedOut), r.URL)
+				return
+			}
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 			return
 		}
 
-		concurrentSize := 100
-		if runtime.GOMAXPROCS(0) < concurrentSize {
-			concurrentSize = runtime.GOMAXPROCS(0)
-		}
+		// Fan-out concurrency is configurable and backs off when other
+		// fan-out uploads are already in progress, each one can hold an
+		// up to 16MiB payload buffer in memory.
+		inFlight := int(globalFanOutInFlight.Add(1))
+		defer globalFanOutInFlight.Add(-1)
+
+		concurrentSize := globalAPIConfig.getFanOutConcurrency(inFlight)
 
 		fanOutResp := make([]minio.PutObjectFanOutResponse, 0, len(fanOutEntries))
 		eventArgsList := make([]eventArgs, 0, len(fanOutEntries))
@@ -1360,10 +2049,22 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 
 	objInfo, err := objectAPI.PutObject(ctx, bucket, object, pReader, opts)
 	if err != nil {
+		if _, ok := err.(BucketQuotaExceeded); ok {
+			setErrorDetail(ctx, APIErrorDetail{Subsystem: "quota", Resource: bucket})
+		}
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
 
+	// Hand the object to the bucket's scanning webhook, if configured,
+	// before answering the client. Quarantined objects get the
+	// quarantine event instead of the created event.
+	quarantined, s3Err := enforceBucketScan(ctx, w, r, objectAPI, bucket, objInfo, false)
+	if s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
 	// We must not use the http.Header().Set method here because some (broken)
 	// clients expect the ETag header key to be literally "ETag" - not "Etag" (case-sensitive).
 	// Therefore, we have to set the ETag directly as map entry.
@@ -1378,16 +2079,28 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		w.Header().Set(xhttp.Location, obj)
 	}
 
-	// Notify object created event.
-	defer sendEvent(eventArgs{
-		EventName:    event.ObjectCreatedPost,
-		BucketName:   objInfo.Bucket,
-		Object:       objInfo,
-		ReqParams:    extractReqParams(r),
-		RespElements: extractRespElements(w),
-		UserAgent:    r.UserAgent(),
-		Host:         handlers.GetSourceIP(r),
-	})
+	// Expose any object-lock retention applied to the stored object,
+	// uploads to unlocked buckets carry no retention metadata.
+	var lockMode, lockRetainUntil string
+	if ret := objectlock.GetObjectRetentionMeta(objInfo.UserDefined); ret.Mode.Valid() {
+		lockMode = string(ret.Mode)
+		lockRetainUntil = amztime.ISO8601Format(ret.RetainUntilDate.UTC())
+		w.Header().Set(xhttp.AmzObjectLockMode, lockMode)
+		w.Header().Set(xhttp.AmzObjectLockRetainUntilDate, lockRetainUntil)
+	}
+
+	if !quarantined {
+		// Notify object created event.
+		defer sendEvent(eventArgs{
+			EventName:    event.ObjectCreatedPost,
+			BucketName:   objInfo.Bucket,
+			Object:       objInfo,
+			ReqParams:    extractReqParams(r),
+			RespElements: extractRespElements(w),
+			UserAgent:    r.UserAgent(),
+			Host:         handlers.GetSourceIP(r),
+		})
+	}
 
 	if objInfo.NumVersions > dataScannerExcessiveVersionsThreshold {
 		defer sendEvent(eventArgs{
@@ -1424,6 +2137,9 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 			Key:      objInfo.Name,
 			ETag:     `"` + objInfo.ETag + `"`,
 			Location: w.Header().Get(xhttp.Location),
+
+			ObjectLockMode:            lockMode,
+			ObjectLockRetainUntilDate: lockRetainUntil,
 		})
 		writeResponse(w, http.StatusCreated, resp, mimeXML)
 	case "200":
@@ -1460,21 +2176,39 @@ func (api objectAPIHandlers) GetBucketPolicyStatusHandler(w http.ResponseWriter,
 		return
 	}
 
-	// Check if anonymous (non-owner) has access to list objects.
-	readable := globalPolicySys.IsAllowed(policy.Args{
-		Action:          policy.ListBucketAction,
-		BucketName:      bucket,
-		ConditionValues: getConditionValues(r, "", auth.AnonymousCredentials),
-		IsOwner:         false,
-	})
+	// Reuse the status computed for the current policy generation if
+	// available, a changed policy carries a new update time and misses.
+	// Policies with condition functions evaluate against request-specific
+	// values, their verdict is computed per request and never cached.
+	p, updatedAt, perr := globalBucketMetadataSys.GetPolicyConfig(bucket)
+	if perr != nil {
+		updatedAt = time.Time{} // no policy stored
+	}
+	cacheable := !policyUsesConditions(p)
+	var readable, writable, ok bool
+	if cacheable {
+		readable, writable, ok = globalPolicyStatusCache.get(bucket, updatedAt)
+	}
+	if !ok {
+		// Check if anonymous (non-owner) has access to list objects.
+		readable = globalPolicySys.IsAllowed(policy.Args{
+			Action:          policy.ListBucketAction,
+			BucketName:      bucket,
+			ConditionValues: getConditionValues(r, "", auth.AnonymousCredentials),
+			IsOwner:         false,
+		})
 
-	// Check if anonymous (non-owner) has access to upload objects.
-	writable := globalPolicySys.IsAllowed(policy.Args{
-		Action:          policy.PutObjectAction,
-		BucketName:      bucket,
-		ConditionValues: getConditionValues(r, "", auth.AnonymousCredentials),
-		IsOwner:         false,
-	})
+		// Check if anonymous (non-owner) has access to upload objects.
+		writable = globalPolicySys.IsAllowed(policy.Args{
+			Action:          policy.PutObjectAction,
+			BucketName:      bucket,
+			ConditionValues: getConditionValues(r, "", auth.AnonymousCredentials),
+			IsOwner:         false,
+		})
+		if cacheable {
+			globalPolicyStatusCache.set(bucket, updatedAt, readable, writable)
+		}
+	}
 
 	encodedSuccessResponse := encodeResponse(PolicyStatus{
 		IsPublic: func() string {
@@ -1526,6 +2260,21 @@ func (api objectAPIHandlers) HeadBucketHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Expose the default object-lock retention when one is configured and
+	// the caller is authorized to read the lock configuration, saves an
+	// extra GetObjectLockConfiguration round trip.
+	if checkRequestAuthType(ctx, r, policy.GetBucketObjectLockConfigurationAction, bucket, "") == ErrNone {
+		if cfg, _, err := globalBucketMetadataSys.GetObjectLockConfig(bucket); err == nil && cfg.Rule != nil {
+			w.Header().Set(xhttp.MinIOObjectLockDefaultMode, string(cfg.Rule.DefaultRetention.Mode))
+			if days := cfg.Rule.DefaultRetention.Days; days != nil {
+				w.Header().Set(xhttp.MinIOObjectLockDefaultDays, strconv.FormatUint(*days, 10))
+			}
+			if years := cfg.Rule.DefaultRetention.Years; years != nil {
+				w.Header().Set(xhttp.MinIOObjectLockDefaultYears, strconv.FormatUint(*years, 10))
+			}
+		}
+	}
+
 	writeResponse(w, http.StatusOK, nil, mimeXML)
 }
 
@@ -1550,6 +2299,18 @@ func (api objectAPIHandlers) DeleteBucketHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	cascadeConfig := false
+	if value := r.Header.Get(xhttp.MinIOCascadeConfig); value != "" {
+		var err error
+		cascadeConfig, err = strconv.ParseBool(value)
+		if err != nil {
+			apiErr := errorCodes.ToAPIErr(ErrInvalidRequest)
+			apiErr.Description = err.Error()
+			writeErrorResponse(ctx, w, apiErr, r.URL)
+			return
+		}
+	}
+
 	forceDelete := false
 	if value := r.Header.Get(xhttp.MinIOForceDelete); value != "" {
 		var err error
@@ -1604,31 +2365,77 @@ func (api objectAPIHandlers) DeleteBucketHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	if forceDelete {
+		// Force deletes purge object versions outright, tag the request
+		// so the audit log entry makes the forced removal explicit.
+		if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+			reqInfo.SetTags("forceDelete", "true")
+		}
+	}
+
 	if globalDNSConfig != nil {
 		if err := globalDNSConfig.Delete(bucket); err != nil {
 			logger.LogIf(ctx, fmt.Errorf("Unable to delete bucket DNS entry %w, please delete it manually, bucket on MinIO no longer exists", err))
+			setErrorDetail(ctx, APIErrorDetail{Subsystem: "dns", Resource: bucket, Retriable: true})
 			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 			return
 		}
 	}
 
+	if cascadeConfig {
+		// Cascade failures are surfaced as warnings, the bucket itself
+		// is already gone at this point.
+		if warnings := cascadeDeleteBucketConfig(ctx, bucket); len(warnings) > 0 {
+			w.Header().Set(xMinIOCascadeWarnsHeader, strings.Join(warnings, "; "))
+		}
+	}
+
 	globalNotificationSys.DeleteBucketMetadata(ctx, bucket)
 	globalReplicationPool.deleteResyncMetadata(ctx, bucket)
 
 	// Call site replication hook.
-	logger.LogIf(ctx, globalSiteReplicationSys.DeleteBucketHook(ctx, bucket, forceDelete))
+	logger.LogIf(ctx, globalSiteReplicationSys.DeleteBucketHook(ctx, bucket, forceDelete, cascadeConfig))
 
 	// Write success response.
 	writeSuccessNoContent(w)
 
-	sendEvent(eventArgs{
+	evArgs := eventArgs{
 		EventName:    event.BucketRemoved,
 		BucketName:   bucket,
 		ReqParams:    extractReqParams(r),
 		RespElements: extractRespElements(w),
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
-	})
+	}
+	sendEvent(evArgs)
+	globalSiteReplicationSys.forwardBucketEvent(ctx, evArgs)
+}
+
+// cascadeDeleteBucketConfig removes the bucket's remote replication targets,
+// notification, quota and lifecycle configuration after the bucket itself has
+// been deleted. Every step is logged and failures are collected as warnings,
+// a stale config entry must not fail an otherwise successful bucket delete.
+func cascadeDeleteBucketConfig(ctx context.Context, bucket string) (warnings []string) {
+	if tgts, err := globalBucketTargetSys.ListBucketTargets(ctx, bucket); err == nil {
+		for _, tgt := range tgts.Targets {
+			if err := globalBucketTargetSys.RemoveTarget(ctx, bucket, tgt.Arn); err != nil {
+				logger.LogIf(ctx, fmt.Errorf("Unable to remove remote target %s for deleted bucket %s: %w", tgt.Arn, bucket, err))
+				warnings = append(warnings, fmt.Sprintf("remote target %s: %v", tgt.Arn, err))
+			}
+		}
+	}
+
+	for _, cfgFile := range []string{
+		bucketNotificationConfig,
+		bucketQuotaConfigFile,
+		bucketLifecycleConfig,
+	} {
+		if _, err := globalBucketMetadataSys.Delete(ctx, bucket, cfgFile); err != nil {
+			logger.LogIf(ctx, fmt.Errorf("Unable to remove %s for deleted bucket %s: %w", cfgFile, bucket, err))
+			warnings = append(warnings, fmt.Sprintf("%s: %v", cfgFile, err))
+		}
+	}
+	return warnings
 }
 
 // PutBucketObjectLockConfigHandler - PUT Bucket object lock configuration.
@@ -1654,7 +2461,13 @@ func (api objectAPIHandlers) PutBucketObjectLockConfigHandler(w http.ResponseWri
 		return
 	}
 
-	config, err := objectlock.ParseObjectLockConfig(r.Body)
+	body, s3Err := readBucketConfigBody(r)
+	if s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
+	config, err := objectlock.ParseObjectLockConfig(bytes.NewReader(body))
 	if err != nil {
 		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
 		apiErr.Description = err.Error()
@@ -1669,7 +2482,10 @@ func (api objectAPIHandlers) PutBucketObjectLockConfigHandler(w http.ResponseWri
 	}
 
 	// Deny object locking configuration settings on existing buckets without object lock enabled.
-	if _, _, err = globalBucketMetadataSys.GetObjectLockConfig(bucket); err != nil {
+	// Keep the previous configuration around for the audit diff below.
+	prevCfg, _, err := globalBucketMetadataSys.GetObjectLockConfig(bucket)
+	if err != nil {
+		setErrorDetail(ctx, APIErrorDetail{Subsystem: "object-lock", Resource: bucket})
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
@@ -1680,6 +2496,21 @@ func (api objectAPIHandlers) PutBucketObjectLockConfigHandler(w http.ResponseWri
 		return
 	}
 
+	// Record what changed on the audit entry and raise a dedicated
+	// internal audit event when the default retention got weaker.
+	auditBucketConfigChange(ctx, "objectLock", objectLockConfigSummary(prevCfg), objectLockConfigSummary(config))
+	if objectLockConfigWeakened(prevCfg, config) {
+		auditLogInternal(ctx, AuditLogOptions{
+			Event:   "BucketObjectLockConfigWeakened",
+			APIName: "PutBucketObjectLockConfig",
+			Bucket:  bucket,
+			Tags: map[string]interface{}{
+				"before": objectLockConfigSummary(prevCfg),
+				"after":  objectLockConfigSummary(config),
+			},
+		})
+	}
+
 	// Call site replication hook.
 	//
 	// We encode the xml bytes as base64 to ensure there are no encoding
@@ -1692,6 +2523,17 @@ func (api objectAPIHandlers) PutBucketObjectLockConfigHandler(w http.ResponseWri
 		UpdatedAt:        updatedAt,
 	}))
 
+	// MinIO extension: optionally stamp the new default retention on
+	// existing objects that carry none, as a background walk reporting
+	// progress through the batch job metrics. The job ID is returned on
+	// the response for progress tracking.
+	if applyExisting, _ := strconv.ParseBool(r.Header.Get(xhttp.MinIOObjectLockApplyExisting)); applyExisting {
+		if retention := config.ToRetention(); retention.Mode.Valid() || len(config.PrefixRules) > 0 {
+			jobID := applyRetentionToExistingObjects(GlobalContext, objectAPI, bucket, config)
+			w.Header().Set(xhttp.MinIOObjectLockApplyJobID, jobID)
+		}
+	}
+
 	// Write success response.
 	writeSuccessResponseHeadersOnly(w)
 }
@@ -1721,8 +2563,16 @@ func (api objectAPIHandlers) GetBucketObjectLockConfigHandler(w http.ResponseWri
 		return
 	}
 
+	if !globalBucketMetadataSys.Initialized() {
+		// A not-found while the initial metadata load is still running
+		// would be cached by clients, ask them to retry instead.
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
 	config, _, err := globalBucketMetadataSys.GetObjectLockConfig(bucket)
 	if err != nil {
+		setErrorDetail(ctx, APIErrorDetail{Subsystem: "object-lock", Resource: bucket})
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
@@ -1764,7 +2614,13 @@ func (api objectAPIHandlers) PutBucketTaggingHandler(w http.ResponseWriter, r *h
 		return
 	}
 
-	tags, err := tags.ParseBucketXML(io.LimitReader(r.Body, r.ContentLength))
+	body, s3Err := readBucketConfigBody(r)
+	if s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
+	tags, err := tags.ParseBucketXML(bytes.NewReader(body))
 	if err != nil {
 		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
 		apiErr.Description = err.Error()
@@ -1822,26 +2678,85 @@ func (api objectAPIHandlers) GetBucketTaggingHandler(w http.ResponseWriter, r *h
 		return
 	}
 
-	config, _, err := globalBucketMetadataSys.GetTaggingConfig(bucket)
+	if !globalBucketMetadataSys.Initialized() {
+		// NoSuchTagSet cannot be trusted until the load finished.
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	config, updatedAt, err := globalBucketMetadataSys.GetTaggingConfig(bucket)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
 
+	// If-Modified-Since : Return the tagging config only if it has been
+	// modified since the specified time, otherwise return a 304 (not
+	// modified). Lets clients poll tag configs cheaply across buckets.
+	if ifModifiedSinceHeader := r.Header.Get(xhttp.IfModifiedSince); ifModifiedSinceHeader != "" && !updatedAt.IsZero() {
+		if givenTime, perr := amztime.ParseHeader(ifModifiedSinceHeader); perr == nil {
+			if !ifModifiedSince(updatedAt, givenTime) {
+				w.Header().Set(xhttp.LastModified, updatedAt.UTC().Format(http.TimeFormat))
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+	}
+
+	// An optional 'tag-key' query parameter filters the returned tag
+	// set to keys matching the supplied pattern, '*' and '?' wildcards
+	// are honored. Full tag set is returned when no filter is given.
+	if tagKey := r.Form.Get("tag-key"); tagKey != "" {
+		filtered := make(map[string]string)
+		for k, v := range config.ToMap() {
+			if wildcard.Match(tagKey, k) {
+				filtered[k] = v
+			}
+		}
+		config, err = tags.MapToBucketTags(filtered)
+		if err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
 	configData, err := xml.Marshal(config)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
 
+	// Emit Last-Modified so clients can round-trip it in If-Modified-Since.
+	if !updatedAt.IsZero() {
+		w.Header().Set(xhttp.LastModified, updatedAt.UTC().Format(http.TimeFormat))
+	}
+
 	// Write success response.
 	writeSuccessResponseXML(w, configData)
 }
 
-// DeleteBucketTaggingHandler - DELETE Bucket tagging.
+// TaggingHistoryVersion is a single retained tagging configuration change
+// in a GetBucketTaggingHistory response. Deleted is set when the tag set
+// was removed at that point in time.
+type TaggingHistoryVersion struct {
+	UpdatedAt time.Time
+	Deleted   bool       `xml:",omitempty"`
+	Tagging   *tags.Tags `xml:",omitempty"`
+}
+
+// TaggingHistoryResponse - format for bucket tagging history response.
+type TaggingHistoryResponse struct {
+	XMLName  xml.Name                `xml:"http://s3.amazonaws.com/doc/2006-03-01/ TaggingHistory" json:"-"`
+	Versions []TaggingHistoryVersion `xml:"Version"`
+}
+
+// GetBucketTaggingHistoryHandler - GET Bucket tagging history.
 // ----------
-func (api objectAPIHandlers) DeleteBucketTaggingHandler(w http.ResponseWriter, r *http.Request) {
-	ctx := newContext(r, w, "DeleteBucketTagging")
+// MinIO extension returning the retained tagging configuration changes
+// for the bucket, oldest first. The live tag set is still served by
+// GetBucketTagging.
+func (api objectAPIHandlers) GetBucketTaggingHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketTaggingHistory")
 
 	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
 
@@ -1854,8 +2769,55 @@ func (api objectAPIHandlers) DeleteBucketTaggingHandler(w http.ResponseWriter, r
 		return
 	}
 
-	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
-		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+	// check if user has permissions to perform this operation
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	history, err := globalBucketMetadataSys.GetTaggingConfigHistory(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	var response TaggingHistoryResponse
+	for _, entry := range history {
+		version := TaggingHistoryVersion{UpdatedAt: entry.UpdatedAt}
+		if len(entry.TaggingConfigXML) == 0 {
+			version.Deleted = true
+		} else {
+			version.Tagging, err = tags.ParseBucketXML(bytes.NewReader(entry.TaggingConfigXML))
+			if err != nil {
+				writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+				return
+			}
+		}
+		response.Versions = append(response.Versions, version)
+	}
+
+	// Write success response.
+	writeSuccessResponseXML(w, encodeResponse(response))
+}
+
+// DeleteBucketTaggingHandler - DELETE Bucket tagging.
+// ----------
+func (api objectAPIHandlers) DeleteBucketTaggingHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketTagging")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
 		return
 	}
 
@@ -1874,3 +2836,957 @@ func (api objectAPIHandlers) DeleteBucketTaggingHandler(w http.ResponseWriter, r
 	// Write success response.
 	writeSuccessResponseHeadersOnly(w)
 }
+
+// PutBucketDefaultTagsHandler - PUT Bucket default object tags.
+// ----------
+func (api objectAPIHandlers) PutBucketDefaultTagsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketDefaultTags")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, err := parseBucketDefaultTagsConfig(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
+		apiErr.Description = err.Error()
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, bucketDefaultTagsConfig, configData)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Call site replication hook.
+	//
+	// We encode the xml bytes as base64 to ensure there are no encoding
+	// errors.
+	cfgStr := base64.StdEncoding.EncodeToString(configData)
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeDefaultTags,
+		Bucket:    bucket,
+		Tags:      &cfgStr,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketDefaultTagsHandler - GET Bucket default object tags.
+// ----------
+func (api objectAPIHandlers) GetBucketDefaultTagsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketDefaultTags")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// check if user has permissions to perform this operation
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, _, err := globalBucketMetadataSys.GetDefaultTagsConfig(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseXML(w, configData)
+}
+
+// DeleteBucketDefaultTagsHandler - DELETE Bucket default object tags.
+// ----------
+func (api objectAPIHandlers) DeleteBucketDefaultTagsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketDefaultTags")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketDefaultTagsConfig)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeDefaultTags,
+		Bucket:    bucket,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// PutBucketNoncurrentVersionsHandler - PUT Bucket noncurrent versions limit.
+// ----------
+func (api objectAPIHandlers) PutBucketNoncurrentVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketNoncurrentVersions")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketLifecycleAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, err := parseNoncurrentVersionsConfig(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
+		apiErr.Description = err.Error()
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, bucketNoncurrentVersionsConfig, configData)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Call site replication hook.
+	//
+	// We encode the xml bytes as base64 to ensure there are no encoding
+	// errors.
+	cfgStr := base64.StdEncoding.EncodeToString(configData)
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeNoncurrentVersions,
+		Bucket:    bucket,
+		Tags:      &cfgStr,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketNoncurrentVersionsHandler - GET Bucket noncurrent versions limit.
+// ----------
+func (api objectAPIHandlers) GetBucketNoncurrentVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketNoncurrentVersions")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// check if user has permissions to perform this operation
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketLifecycleAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, _, err := globalBucketMetadataSys.GetNoncurrentVersionsConfig(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseXML(w, configData)
+}
+
+// DeleteBucketNoncurrentVersionsHandler - DELETE Bucket noncurrent versions limit.
+// ----------
+func (api objectAPIHandlers) DeleteBucketNoncurrentVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketNoncurrentVersions")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketLifecycleAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketNoncurrentVersionsConfig)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeNoncurrentVersions,
+		Bucket:    bucket,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// PutBucketScanConfigHandler - PUT Bucket scanning webhook configuration.
+// ----------
+// Configures the external scanning webhook invoked for uploads to this
+// bucket, this is a MinIO extension.
+func (api objectAPIHandlers) PutBucketScanConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketScanConfig")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketNotificationAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, err := parseBucketScanConfig(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
+		apiErr.Description = err.Error()
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// The webhook endpoint is deployment-local, the configuration is
+	// deliberately not replicated to other sites.
+	if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketScanConfig, configData); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketScanConfigHandler - GET Bucket scanning webhook configuration.
+// ----------
+func (api objectAPIHandlers) GetBucketScanConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketScanConfig")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketNotificationAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, _, err := globalBucketMetadataSys.GetScanConfig(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseXML(w, configData)
+}
+
+// DeleteBucketScanConfigHandler - DELETE Bucket scanning webhook
+// configuration.
+// ----------
+func (api objectAPIHandlers) DeleteBucketScanConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketScanConfig")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketNotificationAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	if _, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketScanConfig); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// PutBucketMaxObjectSizeHandler - PUT Bucket maximum object size.
+// ----------
+// Caps the size of a single object uploaded to this bucket, this is a
+// MinIO extension.
+func (api objectAPIHandlers) PutBucketMaxObjectSizeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketMaxObjectSize")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, err := parseBucketMaxObjectSizeConfig(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
+		apiErr.Description = err.Error()
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, bucketMaxObjectSizeConfig, configData)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Call site replication hook.
+	//
+	// We encode the xml bytes as base64 to ensure there are no encoding
+	// errors.
+	cfgStr := base64.StdEncoding.EncodeToString(configData)
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeMaxObjectSize,
+		Bucket:    bucket,
+		Tags:      &cfgStr,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketMaxObjectSizeHandler - GET Bucket maximum object size.
+// ----------
+func (api objectAPIHandlers) GetBucketMaxObjectSizeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketMaxObjectSize")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, _, err := globalBucketMetadataSys.GetMaxObjectSizeConfig(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseXML(w, configData)
+}
+
+// PutBucketMaxVersionsHandler - PUT Bucket maximum versions per object.
+// ----------
+// Caps the number of versions a single object in this bucket may
+// accumulate, this is a MinIO extension.
+func (api objectAPIHandlers) PutBucketMaxVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketMaxVersions")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, err := parseBucketMaxVersionsConfig(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
+		apiErr.Description = err.Error()
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, bucketMaxVersionsConfig, configData)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Call site replication hook.
+	//
+	// We encode the xml bytes as base64 to ensure there are no encoding
+	// errors.
+	cfgStr := base64.StdEncoding.EncodeToString(configData)
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeMaxVersions,
+		Bucket:    bucket,
+		Tags:      &cfgStr,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketMaxVersionsHandler - GET Bucket maximum versions per object.
+// ----------
+func (api objectAPIHandlers) GetBucketMaxVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketMaxVersions")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, _, err := globalBucketMetadataSys.GetMaxVersionsConfig(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseXML(w, configData)
+}
+
+// DeleteBucketMaxVersionsHandler - DELETE Bucket maximum versions per object.
+// ----------
+func (api objectAPIHandlers) DeleteBucketMaxVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketMaxVersions")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketMaxVersionsConfig)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeMaxVersions,
+		Bucket:    bucket,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// DeleteBucketMaxObjectSizeHandler - DELETE Bucket maximum object size.
+// ----------
+func (api objectAPIHandlers) DeleteBucketMaxObjectSizeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketMaxObjectSize")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketMaxObjectSizeConfig)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeMaxObjectSize,
+		Bucket:    bucket,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// PutBucketBulkDeleteHandler - PUT Bucket bulk delete setting.
+// ----------
+// Controls whether DeleteMultipleObjects requests are accepted on this
+// bucket, this is a MinIO extension.
+func (api objectAPIHandlers) PutBucketBulkDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketBulkDelete")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, err := parseBucketBulkDeleteConfig(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
+		apiErr.Description = err.Error()
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, bucketBulkDeleteConfig, configData)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Call site replication hook.
+	//
+	// We encode the xml bytes as base64 to ensure there are no encoding
+	// errors.
+	cfgStr := base64.StdEncoding.EncodeToString(configData)
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeBulkDelete,
+		Bucket:    bucket,
+		Tags:      &cfgStr,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketBulkDeleteHandler - GET Bucket bulk delete setting.
+// ----------
+func (api objectAPIHandlers) GetBucketBulkDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketBulkDelete")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, _, err := globalBucketMetadataSys.GetBulkDeleteConfig(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseXML(w, configData)
+}
+
+// DeleteBucketBulkDeleteHandler - DELETE Bucket bulk delete setting.
+// ----------
+func (api objectAPIHandlers) DeleteBucketBulkDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketBulkDelete")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketBulkDeleteConfig)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeBulkDelete,
+		Bucket:    bucket,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// PutBucketFanOutRoutingHandler - PUT Bucket fan-out routing rules.
+// ----------
+// Routes fan-out POST entries to server pools based on the object tags
+// each entry carries, this is a MinIO extension.
+func (api objectAPIHandlers) PutBucketFanOutRoutingHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketFanOutRouting")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, err := parseBucketFanOutRoutingConfig(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		apiErr := errorCodes.ToAPIErr(ErrMalformedXML)
+		apiErr.Description = err.Error()
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, bucketFanOutRoutingConfig, configData)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Call site replication hook.
+	//
+	// We encode the xml bytes as base64 to ensure there are no encoding
+	// errors.
+	cfgStr := base64.StdEncoding.EncodeToString(configData)
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeFanOutRouting,
+		Bucket:    bucket,
+		Tags:      &cfgStr,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// GetBucketFanOutRoutingHandler - GET Bucket fan-out routing rules.
+// ----------
+func (api objectAPIHandlers) GetBucketFanOutRoutingHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketFanOutRouting")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	config, _, err := globalBucketMetadataSys.GetFanOutRoutingConfig(bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	configData, err := xml.Marshal(config)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseXML(w, configData)
+}
+
+// DeleteBucketFanOutRoutingHandler - DELETE Bucket fan-out routing rules.
+// ----------
+func (api objectAPIHandlers) DeleteBucketFanOutRoutingHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DeleteBucketFanOutRouting")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketTaggingAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	updatedAt, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketFanOutRoutingConfig)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
+		Type:      srBucketMetaTypeFanOutRouting,
+		Bucket:    bucket,
+		UpdatedAt: updatedAt,
+	}))
+
+	// Write success response.
+	writeSuccessResponseHeadersOnly(w)
+}
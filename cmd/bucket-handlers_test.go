@@ -19,15 +19,27 @@ package cmd
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/config/dns"
+	xhttp "github.com/minio/minio/internal/http"
 )
 
 // Wrapper for calling RemoveBucket HTTP handler tests for both Erasure multiple disks and single node setup.
@@ -556,11 +568,204 @@ func testListMultipartUploadsHandler(obj ObjectLayer, instanceType, bucketName s
 	ExecObjectLayerAPINilTest(t, nilBucket, "", instanceType, apiRouter, nilReq)
 }
 
+// Wrapper for calling TestListMultipartUploadsPaginationHandler tests for both Erasure multiple disks and single node setup.
+func TestListMultipartUploadsPaginationHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testListMultipartUploadsPaginationHandler, []string{"ListMultipartUploads"})
+}
+
+// testListMultipartUploadsPaginationHandler - validates that paginating
+// a multipart listing with encoding-type=url round-trips markers for
+// keys containing spaces, plus signs and unicode without skipping or
+// duplicating uploads.
+func testListMultipartUploadsPaginationHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	const (
+		uploadsPerKey = 60
+		pageSize      = 25
+	)
+
+	for _, key := range []string{
+		"key with spaces",
+		"key+with+plus",
+		"key-ユニコード-測試",
+	} {
+		wantUploadIDs := set.NewStringSet()
+		for i := 0; i < uploadsPerKey; i++ {
+			res, err := obj.NewMultipartUpload(context.Background(), bucketName, key, ObjectOptions{})
+			if err != nil {
+				t.Fatalf("%s: NewMultipartUpload failed for %s: <ERROR> %v", instanceType, key, err)
+			}
+			wantUploadIDs.Add(res.UploadID)
+		}
+
+		gotUploadIDs := set.NewStringSet()
+		keyMarker, uploadIDMarker := "", ""
+		for page := 0; ; page++ {
+			if page > 2*uploadsPerKey/pageSize {
+				t.Fatalf("%s: pagination did not terminate for %s", instanceType, key)
+			}
+
+			queryValue := url.Values{}
+			queryValue.Set("uploads", "")
+			queryValue.Set("prefix", key)
+			queryValue.Set("encoding-type", "url")
+			queryValue.Set("max-uploads", strconv.Itoa(pageSize))
+			if keyMarker != "" {
+				queryValue.Set("key-marker", keyMarker)
+			}
+			if uploadIDMarker != "" {
+				queryValue.Set("upload-id-marker", uploadIDMarker)
+			}
+
+			rec := httptest.NewRecorder()
+			req, err := newTestSignedRequestV4(http.MethodGet, makeTestTargetURL("", bucketName, "", queryValue),
+				0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+			if err != nil {
+				t.Fatalf("%s: Failed to create HTTP request for ListMultipartUploadsHandler: <ERROR> %v", instanceType, err)
+			}
+			apiRouter.ServeHTTP(rec, req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("%s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+			}
+
+			var resp ListMultipartUploadsResponse
+			if err = xml.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("%s: Failed to unmarshal response for %s: <ERROR> %v", instanceType, key, err)
+			}
+
+			for _, upload := range resp.Uploads {
+				decodedKey, err := url.QueryUnescape(upload.Key)
+				if err != nil {
+					t.Fatalf("%s: Failed to decode key %s: <ERROR> %v", instanceType, upload.Key, err)
+				}
+				if decodedKey != key {
+					t.Fatalf("%s: Expected key %s, got %s", instanceType, key, decodedKey)
+				}
+				if gotUploadIDs.Contains(upload.UploadID) {
+					t.Fatalf("%s: upload %s duplicated across pages for %s", instanceType, upload.UploadID, key)
+				}
+				gotUploadIDs.Add(upload.UploadID)
+			}
+
+			if !resp.IsTruncated {
+				break
+			}
+			if resp.NextKeyMarker == "" {
+				t.Fatalf("%s: truncated page carries no NextKeyMarker for %s", instanceType, key)
+			}
+			// SDKs decode url encoded markers before sending them back.
+			if keyMarker, err = url.QueryUnescape(resp.NextKeyMarker); err != nil {
+				t.Fatalf("%s: Failed to decode NextKeyMarker %s: <ERROR> %v", instanceType, resp.NextKeyMarker, err)
+			}
+			uploadIDMarker = resp.NextUploadIDMarker
+		}
+
+		if len(gotUploadIDs) != uploadsPerKey {
+			t.Fatalf("%s: Expected %d uploads for %s, got %d", instanceType, uploadsPerKey, key, len(gotUploadIDs))
+		}
+		if !wantUploadIDs.Equals(gotUploadIDs) {
+			t.Fatalf("%s: listed uploads do not match the created ones for %s", instanceType, key)
+		}
+	}
+}
+
 // Wrapper for calling TestListBucketsHandler tests for both Erasure multiple disks and single node setup.
 func TestListBucketsHandler(t *testing.T) {
 	ExecObjectLayerAPITest(t, testListBucketsHandler, []string{"ListBuckets"})
 }
 
+// Wrapper for calling TestListBucketsUsageHandler tests for both Erasure multiple disks and single node setup.
+func TestListBucketsUsageHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testListBucketsUsageHandler, []string{"ListBuckets"})
+}
+
+// testListBucketsUsageHandler - Tests the x-minio-usage extension of the bucket listing.
+func testListBucketsUsageHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	// Store a usage snapshot that covers the test bucket.
+	lastUpdate := UTCNow()
+	usageJSON, err := json.Marshal(DataUsageInfo{
+		LastUpdate: lastUpdate,
+		BucketsUsage: map[string]BucketUsageInfo{
+			bucketName: {Size: 1 << 20, ObjectsCount: 42, VersionsCount: 45},
+		},
+	})
+	if err != nil {
+		t.Fatalf("%s: Failed to marshal usage snapshot: <ERROR> %v", instanceType, err)
+	}
+	if err = saveConfig(GlobalContext, obj, dataUsageObjNamePath, usageJSON); err != nil {
+		t.Fatalf("%s: Failed to store usage snapshot: <ERROR> %v", instanceType, err)
+	}
+
+	// A second bucket missing from the snapshot reports -1.
+	unscannedBucket := "unscanned-bucket"
+	if err = obj.MakeBucket(GlobalContext, unscannedBucket, MakeBucketOptions{}); err != nil {
+		t.Fatalf("%s: Failed to create bucket: <ERROR> %v", instanceType, err)
+	}
+
+	rec := httptest.NewRecorder()
+	req, err := newTestSignedRequestV4(http.MethodGet, getListBucketURL(""), 0, nil,
+		credentials.AccessKey, credentials.SecretKey, map[string]string{xhttp.MinIOUsage: "true"})
+	if err != nil {
+		t.Fatalf("%s: Failed to create HTTP request for ListBucketsHandler: <ERROR> %v", instanceType, err)
+	}
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("%s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+
+	var resp ListBucketsResponse
+	if err = xml.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("%s: Failed to parse the ListBuckets response: <ERROR> %v", instanceType, err)
+	}
+	if resp.UsageUpdatedAt == "" {
+		t.Errorf("%s: Expected the staleness timestamp to be set", instanceType)
+	}
+	for _, bucket := range resp.Buckets.Buckets {
+		if bucket.Size == nil || bucket.ObjectsCount == nil || bucket.VersionsCount == nil {
+			t.Fatalf("%s: Expected usage elements on bucket %s", instanceType, bucket.Name)
+		}
+		switch bucket.Name {
+		case bucketName:
+			if *bucket.Size != 1<<20 || *bucket.ObjectsCount != 42 || *bucket.VersionsCount != 45 {
+				t.Errorf("%s: Unexpected usage for %s: size=%d objects=%d versions=%d",
+					instanceType, bucket.Name, *bucket.Size, *bucket.ObjectsCount, *bucket.VersionsCount)
+			}
+		case unscannedBucket:
+			if *bucket.Size != -1 || *bucket.ObjectsCount != -1 || *bucket.VersionsCount != -1 {
+				t.Errorf("%s: Expected -1 usage for the unscanned bucket, got size=%d objects=%d versions=%d",
+					instanceType, *bucket.Size, *bucket.ObjectsCount, *bucket.VersionsCount)
+			}
+		}
+	}
+
+	// Without the header the usage elements stay absent.
+	rec = httptest.NewRecorder()
+	req, err = newTestSignedRequestV4(http.MethodGet, getListBucketURL(""), 0, nil,
+		credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("%s: Failed to create HTTP request for ListBucketsHandler: <ERROR> %v", instanceType, err)
+	}
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("%s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	resp = ListBucketsResponse{}
+	if err = xml.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("%s: Failed to parse the ListBuckets response: <ERROR> %v", instanceType, err)
+	}
+	if resp.UsageUpdatedAt != "" {
+		t.Errorf("%s: Unexpected staleness timestamp on a plain listing", instanceType)
+	}
+	for _, bucket := range resp.Buckets.Buckets {
+		if bucket.Size != nil || bucket.ObjectsCount != nil || bucket.VersionsCount != nil {
+			t.Errorf("%s: Unexpected usage elements on bucket %s", instanceType, bucket.Name)
+		}
+	}
+}
+
 // testListBucketsHandler - Tests validate listing of buckets.
 func testListBucketsHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
 	credentials auth.Credentials, t *testing.T,
@@ -757,7 +962,7 @@ func testAPIDeleteMultipleObjectsHandler(obj ObjectLayer, instanceType, bucketNa
 		}
 	}
 
-	successResponse0 := generateMultiDeleteResponse(requestList[0].Quiet, deletedObjects, nil)
+	successResponse0 := generateMultiDeleteResponse(requestList[0].Quiet, false, deletedObjects, nil)
 	encodedSuccessResponse0 := encodeResponse(successResponse0)
 
 	successRequest1 := encodeResponse(requestList[1])
@@ -774,20 +979,20 @@ func testAPIDeleteMultipleObjectsHandler(obj ObjectLayer, instanceType, bucketNa
 		}
 	}
 
-	successResponse1 := generateMultiDeleteResponse(requestList[1].Quiet, deletedObjects, nil)
+	successResponse1 := generateMultiDeleteResponse(requestList[1].Quiet, false, deletedObjects, nil)
 	encodedSuccessResponse1 := encodeResponse(successResponse1)
 
 	// generate multi objects delete response for errors.
 	// errorRequest := encodeResponse(requestList[1])
-	errorResponse := generateMultiDeleteResponse(requestList[1].Quiet, deletedObjects, nil)
+	errorResponse := generateMultiDeleteResponse(requestList[1].Quiet, false, deletedObjects, nil)
 	encodedErrorResponse := encodeResponse(errorResponse)
 
 	anonRequest := encodeResponse(requestList[0])
-	anonResponse := generateMultiDeleteResponse(requestList[0].Quiet, nil, getDeleteErrorList(requestList[0].Objects))
+	anonResponse := generateMultiDeleteResponse(requestList[0].Quiet, false, nil, getDeleteErrorList(requestList[0].Objects))
 	encodedAnonResponse := encodeResponse(anonResponse)
 
 	anonRequestWithPartialPublicAccess := encodeResponse(requestList[2])
-	anonResponseWithPartialPublicAccess := generateMultiDeleteResponse(requestList[2].Quiet,
+	anonResponseWithPartialPublicAccess := generateMultiDeleteResponse(requestList[2].Quiet, false,
 		[]DeletedObject{
 			{ObjectName: "public/object"},
 		},
@@ -938,3 +1143,855 @@ func testAPIDeleteMultipleObjectsHandler(obj ObjectLayer, instanceType, bucketNa
 	// `ExecObjectLayerAPINilTest` manages the operation.
 	ExecObjectLayerAPINilTest(t, nilBucket, nilObject, instanceType, apiRouter, nilReq)
 }
+
+// Wrapper for calling DeleteMultipleObjects with the dry run extension
+// header for both Erasure multiple disks and single node setup.
+func TestAPIDeleteMultipleObjectsDryRunHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIDeleteMultipleObjectsDryRunHandler, []string{"DeleteMultipleObjects"})
+}
+
+// testAPIDeleteMultipleObjectsDryRunHandler - Tests that the x-minio-dry-run
+// header reports the projected outcomes without deleting anything.
+func testAPIDeleteMultipleObjectsDryRunHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	contentBytes := []byte("hello")
+	objects := []ObjectToDelete{}
+	for i := 0; i < 3; i++ {
+		objectName := "dry-run-object-" + strconv.Itoa(i)
+		if _, err := obj.PutObject(GlobalContext, bucketName, objectName,
+			mustGetPutObjReader(t, bytes.NewReader(contentBytes), int64(len(contentBytes)), "", ""), ObjectOptions{}); err != nil {
+			t.Fatalf("Put Object %d:  Error uploading object: <ERROR> %v", i, err)
+		}
+		objects = append(objects, ObjectToDelete{ObjectV: ObjectV{ObjectName: objectName}})
+	}
+
+	deleteObjects := func(quiet bool) *httptest.ResponseRecorder {
+		deleteReqBytes := encodeResponse(DeleteObjectsRequest{Objects: objects, Quiet: quiet})
+		req, err := newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+			int64(len(deleteReqBytes)), bytes.NewReader(deleteReqBytes), credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+		}
+		req.Header.Set(xhttp.MinIODryRun, "true")
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := deleteObjects(false)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	if rec.Header().Get(xhttp.MinIODryRun) != "true" {
+		t.Errorf("MinIO %s: Expected the dry run header to be echoed on the response", instanceType)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte(`DryRun="true"`)) {
+		t.Errorf("MinIO %s: Expected the response to be marked as a simulation, got `%s`", instanceType, rec.Body.String())
+	}
+	deleteResp := DeleteObjectsResponse{}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &deleteResp); err != nil {
+		t.Fatalf("MinIO %s: Failed parsing response body: <ERROR> %v", instanceType, err)
+	}
+	if len(deleteResp.DeletedObjects) != len(objects) {
+		t.Fatalf("MinIO %s: Expected %d projected deletions, got %d", instanceType, len(objects), len(deleteResp.DeletedObjects))
+	}
+
+	// Nothing was actually deleted.
+	for _, object := range objects {
+		if _, err := obj.GetObjectInfo(GlobalContext, bucketName, object.ObjectName, ObjectOptions{}); err != nil {
+			t.Fatalf("MinIO %s: Expected object %s to still exist: <ERROR> %v", instanceType, object.ObjectName, err)
+		}
+	}
+
+	// Quiet mode still suppresses the projected success entries.
+	rec = deleteObjects(true)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	deleteResp = DeleteObjectsResponse{}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &deleteResp); err != nil {
+		t.Fatalf("MinIO %s: Failed parsing response body: <ERROR> %v", instanceType, err)
+	}
+	if len(deleteResp.DeletedObjects) != 0 {
+		t.Errorf("MinIO %s: Expected no entries in quiet mode, got %d", instanceType, len(deleteResp.DeletedObjects))
+	}
+}
+
+// Wrapper for calling DeleteMultipleObjects with tampered Content-MD5 headers
+// for both Erasure multiple disks and single node setup.
+func TestAPIDeleteMultipleObjectsContentMD5Handler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIDeleteMultipleObjectsContentMD5Handler, []string{"DeleteMultipleObjects"})
+}
+
+// testAPIDeleteMultipleObjectsContentMD5Handler - Tests that the request body
+// is independently verified against the Content-MD5 header before the object
+// list is acted upon.
+func testAPIDeleteMultipleObjectsContentMD5Handler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	objectName := "test-object"
+	contentBytes := []byte("hello")
+	_, err := obj.PutObject(GlobalContext, bucketName, objectName, mustGetPutObjReader(t, bytes.NewReader(contentBytes), int64(len(contentBytes)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatalf("Error uploading object: <ERROR> %v", err)
+	}
+
+	deleteReqBytes := encodeResponse(DeleteObjectsRequest{
+		Quiet: false,
+		Objects: []ObjectToDelete{
+			{ObjectV: ObjectV{ObjectName: objectName}},
+		},
+	})
+
+	// Matching digest, newTestSignedRequestV4 derives Content-MD5 from the
+	// body - the delete must go through.
+	req, err := newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+		int64(len(deleteReqBytes)), bytes.NewReader(deleteReqBytes), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+	}
+	rec := httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+
+	// Digest of a different payload - the handler must reject the request
+	// before acting on the object list.
+	req, err = newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+		int64(len(deleteReqBytes)), bytes.NewReader(deleteReqBytes), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+	}
+	req.Header.Set(xhttp.ContentMD5, getMD5HashBase64([]byte("a different payload")))
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusBadRequest, rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), errorCodes[ErrBadDigest].Code) {
+		t.Errorf("MinIO %s: Expected a %s error, got: %s", instanceType, errorCodes[ErrBadDigest].Code, rec.Body.String())
+	}
+
+	// Content-MD5 that is not valid base64 of an MD5 checksum.
+	req, err = newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+		int64(len(deleteReqBytes)), bytes.NewReader(deleteReqBytes), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+	}
+	req.Header.Set(xhttp.ContentMD5, "not-a-checksum")
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusBadRequest, rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), errorCodes[ErrInvalidDigest].Code) {
+		t.Errorf("MinIO %s: Expected a %s error, got: %s", instanceType, errorCodes[ErrInvalidDigest].Code, rec.Body.String())
+	}
+}
+
+// Wrapper for calling DeleteMultipleObjects with the delete audit extension
+// for both Erasure multiple disks and single node setup.
+func TestAPIDeleteMultipleObjectsAuditHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIDeleteMultipleObjectsAuditHandler, []string{"DeleteMultipleObjects"})
+}
+
+// testAPIDeleteMultipleObjectsAuditHandler - Tests that the x-minio-delete-audit
+// header records each object's etag and size on the Deleted elements, and that
+// objects whose stat fails are still deleted with the attributes omitted.
+func testAPIDeleteMultipleObjectsAuditHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	contentBytes := []byte("hello")
+	objects := []ObjectToDelete{}
+	wantETags := map[string]string{}
+	for i := 0; i < 3; i++ {
+		objectName := "audit-object-" + strconv.Itoa(i)
+		objInfo, err := obj.PutObject(GlobalContext, bucketName, objectName,
+			mustGetPutObjReader(t, bytes.NewReader(contentBytes), int64(len(contentBytes)), "", ""), ObjectOptions{})
+		if err != nil {
+			t.Fatalf("Put Object %d:  Error uploading object: <ERROR> %v", i, err)
+		}
+		wantETags[objectName] = objInfo.ETag
+		objects = append(objects, ObjectToDelete{ObjectV: ObjectV{ObjectName: objectName}})
+	}
+	// A missing object deletes fine, just without audit attributes.
+	objects = append(objects, ObjectToDelete{ObjectV: ObjectV{ObjectName: "audit-missing"}})
+
+	deleteReqBytes := encodeResponse(DeleteObjectsRequest{Objects: objects})
+	req, err := newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+		int64(len(deleteReqBytes)), bytes.NewReader(deleteReqBytes), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+	}
+	req.Header.Set(xhttp.MinIODeleteAudit, "true")
+
+	rec := httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+
+	deleteResp := DeleteObjectsResponse{}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &deleteResp); err != nil {
+		t.Fatalf("MinIO %s: Failed parsing response body: <ERROR> %v", instanceType, err)
+	}
+	if len(deleteResp.DeletedObjects) != len(objects) {
+		t.Fatalf("MinIO %s: Expected %d deleted objects, got %d", instanceType, len(objects), len(deleteResp.DeletedObjects))
+	}
+	for _, dobj := range deleteResp.DeletedObjects {
+		if dobj.ObjectName == "audit-missing" {
+			if dobj.ETag != "" || dobj.Size != 0 {
+				t.Errorf("MinIO %s: Expected no audit attributes for %s, got etag %s size %d", instanceType, dobj.ObjectName, dobj.ETag, dobj.Size)
+			}
+			continue
+		}
+		if dobj.ETag != wantETags[dobj.ObjectName] {
+			t.Errorf("MinIO %s: Expected etag %s for %s, got %s", instanceType, wantETags[dobj.ObjectName], dobj.ObjectName, dobj.ETag)
+		}
+		if dobj.Size != int64(len(contentBytes)) {
+			t.Errorf("MinIO %s: Expected size %d for %s, got %d", instanceType, len(contentBytes), dobj.ObjectName, dobj.Size)
+		}
+	}
+
+	// Without the header the Deleted elements stay as before.
+	req, err = newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+		int64(len(deleteReqBytes)), bytes.NewReader(deleteReqBytes), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+	}
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	deleteResp = DeleteObjectsResponse{}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &deleteResp); err != nil {
+		t.Fatalf("MinIO %s: Failed parsing response body: <ERROR> %v", instanceType, err)
+	}
+	for _, dobj := range deleteResp.DeletedObjects {
+		if dobj.ETag != "" || dobj.Size != 0 || dobj.SSEAlgorithm != "" {
+			t.Errorf("MinIO %s: Expected no audit attributes for %s without the header", instanceType, dobj.ObjectName)
+		}
+	}
+}
+
+// Wrapper for calling DeleteMultipleObjects with the remaining versions
+// extension for both Erasure multiple disks and single node setup.
+func TestAPIDeleteMultipleObjectsRemainingVersionsHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIDeleteMultipleObjectsRemainingVersionsHandler, []string{"DeleteMultipleObjects"})
+}
+
+// testAPIDeleteMultipleObjectsRemainingVersionsHandler - Tests that the
+// x-minio-delete-remaining-versions header reports how many versions of the
+// object survive each version specific delete, and that the field stays
+// absent without the header.
+func testAPIDeleteMultipleObjectsRemainingVersionsHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketVersioningConfig,
+		[]byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`))
+
+	contentBytes := []byte("hello")
+	objectName := "versioned-object"
+	var versionIDs []string
+	for i := 0; i < 3; i++ {
+		objInfo, err := obj.PutObject(GlobalContext, bucketName, objectName,
+			mustGetPutObjReader(t, bytes.NewReader(contentBytes), int64(len(contentBytes)), "", ""), ObjectOptions{Versioned: true})
+		if err != nil {
+			t.Fatalf("Put Object %d:  Error uploading object: <ERROR> %v", i, err)
+		}
+		versionIDs = append(versionIDs, objInfo.VersionID)
+	}
+
+	deleteVersion := func(versionID string, withHeader bool) DeletedObject {
+		t.Helper()
+		deleteReqBytes := encodeResponse(DeleteObjectsRequest{Objects: []ObjectToDelete{
+			{ObjectV: ObjectV{ObjectName: objectName, VersionID: versionID}},
+		}})
+		req, err := newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+			int64(len(deleteReqBytes)), bytes.NewReader(deleteReqBytes), credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+		}
+		if withHeader {
+			req.Header.Set(xhttp.MinIODeleteRemainingVersions, "true")
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+		}
+		deleteResp := DeleteObjectsResponse{}
+		if err := xml.Unmarshal(rec.Body.Bytes(), &deleteResp); err != nil {
+			t.Fatalf("MinIO %s: Failed parsing response body: <ERROR> %v", instanceType, err)
+		}
+		if len(deleteResp.DeletedObjects) != 1 {
+			t.Fatalf("MinIO %s: Expected 1 deleted object, got %d", instanceType, len(deleteResp.DeletedObjects))
+		}
+		return deleteResp.DeletedObjects[0]
+	}
+
+	dobj := deleteVersion(versionIDs[0], true)
+	if dobj.RemainingVersions == nil {
+		t.Fatalf("MinIO %s: Expected remaining versions to be reported", instanceType)
+	}
+	if *dobj.RemainingVersions != 2 {
+		t.Errorf("MinIO %s: Expected 2 remaining versions, got %d", instanceType, *dobj.RemainingVersions)
+	}
+
+	// Without the header the field stays absent.
+	dobj = deleteVersion(versionIDs[1], false)
+	if dobj.RemainingVersions != nil {
+		t.Errorf("MinIO %s: Expected no remaining versions without the header, got %d", instanceType, *dobj.RemainingVersions)
+	}
+
+	dobj = deleteVersion(versionIDs[2], true)
+	if dobj.RemainingVersions == nil {
+		t.Fatalf("MinIO %s: Expected remaining versions to be reported", instanceType)
+	}
+	if *dobj.RemainingVersions != 0 {
+		t.Errorf("MinIO %s: Expected 0 remaining versions, got %d", instanceType, *dobj.RemainingVersions)
+	}
+}
+
+// Wrapper for calling DeleteMultipleObjects with the DeleteMarkersOnly
+// extension for both Erasure multiple disks and single node setup.
+func TestAPIDeleteMarkersOnlyHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIDeleteMarkersOnlyHandler, []string{"DeleteMultipleObjects"})
+}
+
+// testAPIDeleteMarkersOnlyHandler - Tests that a DeleteMarkersOnly request
+// enumerates and removes the delete markers under the given prefix, restoring
+// the underlying versions, and that mixing it with an object list is rejected.
+func testAPIDeleteMarkersOnlyHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketVersioningConfig,
+		[]byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`))
+
+	contentBytes := []byte("hello")
+	for i := 0; i < 3; i++ {
+		objectName := fmt.Sprintf("recover/obj-%d", i)
+		if _, err := obj.PutObject(GlobalContext, bucketName, objectName,
+			mustGetPutObjReader(t, bytes.NewReader(contentBytes), int64(len(contentBytes)), "", ""), ObjectOptions{Versioned: true}); err != nil {
+			t.Fatalf("Put Object %d:  Error uploading object: <ERROR> %v", i, err)
+		}
+		// An unversioned delete lays down a delete marker.
+		if _, err := obj.DeleteObject(GlobalContext, bucketName, objectName, ObjectOptions{Versioned: true}); err != nil {
+			t.Fatalf("Delete Object %d: <ERROR> %v", i, err)
+		}
+	}
+
+	postDelete := func(body []byte) *httptest.ResponseRecorder {
+		t.Helper()
+		req, err := newTestSignedRequestV4(http.MethodPost, getDeleteMultipleObjectsURL("", bucketName),
+			int64(len(body)), bytes.NewReader(body), credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for DeleteMultipleObjects: <ERROR> %v", err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Mixing DeleteMarkersOnly with an explicit object list is malformed.
+	rec := postDelete(encodeResponse(DeleteObjectsRequest{
+		DeleteMarkersOnly: "recover/",
+		Objects:           []ObjectToDelete{{ObjectV: ObjectV{ObjectName: "recover/obj-0"}}},
+	}))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusBadRequest, rec.Code)
+	}
+
+	// Remove every delete marker under the prefix.
+	rec = postDelete(encodeResponse(DeleteObjectsRequest{DeleteMarkersOnly: "recover/"}))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	if matched := rec.Header().Get(xhttp.MinIODeleteMarkersMatched); matched != "3" {
+		t.Errorf("MinIO %s: Expected 3 matched delete markers, got `%s`", instanceType, matched)
+	}
+	if rec.Header().Get(xhttp.MinIODeleteMarkersTruncated) != "" {
+		t.Errorf("MinIO %s: Expected the enumeration to not be truncated", instanceType)
+	}
+	deleteResp := DeleteObjectsResponse{}
+	if err := xml.Unmarshal(rec.Body.Bytes(), &deleteResp); err != nil {
+		t.Fatalf("MinIO %s: Failed parsing response body: <ERROR> %v", instanceType, err)
+	}
+	if len(deleteResp.DeletedObjects) != 3 {
+		t.Fatalf("MinIO %s: Expected 3 deleted markers, got %d", instanceType, len(deleteResp.DeletedObjects))
+	}
+
+	// With the markers gone the put versions are current again.
+	for i := 0; i < 3; i++ {
+		objectName := fmt.Sprintf("recover/obj-%d", i)
+		if _, err := obj.GetObjectInfo(GlobalContext, bucketName, objectName, ObjectOptions{}); err != nil {
+			t.Errorf("MinIO %s: Expected object %s to be restored, got: <ERROR> %v", instanceType, objectName, err)
+		}
+	}
+
+	// Re-running over the now clean prefix matches nothing and succeeds.
+	rec = postDelete(encodeResponse(DeleteObjectsRequest{DeleteMarkersOnly: "recover/"}))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	if matched := rec.Header().Get(xhttp.MinIODeleteMarkersMatched); matched != "0" {
+		t.Errorf("MinIO %s: Expected 0 matched delete markers, got `%s`", instanceType, matched)
+	}
+}
+
+// Wrapper for calling GetBucketTagging HTTP handler tests for both Erasure multiple disks and single node setup.
+func TestAPIGetBucketTaggingHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIGetBucketTaggingHandler, []string{"GetBucketTagging", "PutBucketPolicy"})
+}
+
+// testAPIGetBucketTaggingHandler - Tests that anonymous requests are served
+// only when a bucket policy grants s3:GetBucketTagging to them.
+func testAPIGetBucketTaggingHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	// Set the tagging configuration directly, the handler under test only reads it.
+	taggingBytes := []byte(`<Tagging><TagSet><Tag><Key>site</Key><Value>public</Value></Tag></TagSet></Tagging>`)
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketTaggingConfig, taggingBytes); err != nil {
+		t.Fatalf("Failed to set bucket tagging: <ERROR> %v", err)
+	}
+
+	// Anonymous request is denied while no bucket policy grants the action.
+	req, err := newTestRequest(http.MethodGet, getGetBucketTaggingURL("", bucketName), 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for GetBucketTagging: <ERROR> %v", err)
+	}
+	rec := httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusForbidden, rec.Code)
+	}
+
+	// Grant s3:GetBucketTagging to anonymous principals.
+	policyBytes := []byte(fmt.Sprintf(`{"Version": "2012-10-17", "Statement": [{"Action": "s3:GetBucketTagging", "Effect": "Allow", "Resource": "arn:aws:s3:::%s", "Principal": "*"}]}`, bucketName))
+	req, err = newTestSignedRequestV4(http.MethodPut, getPutPolicyURL("", bucketName), int64(len(policyBytes)), bytes.NewReader(policyBytes),
+		credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for PutBucketPolicyHandler: <ERROR> %v", err)
+	}
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected the response status to be `%d`, but instead found `%d`", http.StatusNoContent, rec.Code)
+	}
+
+	// The same anonymous request is now served.
+	req, err = newTestRequest(http.MethodGet, getGetBucketTaggingURL("", bucketName), 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for GetBucketTagging: <ERROR> %v", err)
+	}
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte("<Key>site</Key>")) {
+		t.Errorf("MinIO %s: Expected the tag set in the response, got `%s`", instanceType, rec.Body.String())
+	}
+}
+
+// Wrapper for calling PutBucketTagging with gzip-encoded bodies for both Erasure multiple disks and single node setup.
+func TestAPIPutBucketTaggingGzipHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIPutBucketTaggingGzipHandler, []string{"PutBucketTagging"})
+}
+
+// testAPIPutBucketTaggingGzipHandler - Tests that bodies sent with
+// 'Content-Encoding: gzip' are transparently decompressed before parsing,
+// and that oversized decompressed payloads are rejected.
+func testAPIPutBucketTaggingGzipHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	putTagging := func(body []byte, gzipped bool) *httptest.ResponseRecorder {
+		req, err := newTestSignedRequestV4(http.MethodPut, getPutBucketTaggingURL("", bucketName),
+			int64(len(body)), bytes.NewReader(body), credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for PutBucketTagging: <ERROR> %v", err)
+		}
+		if gzipped {
+			req.Header.Set(xhttp.ContentEncoding, "gzip")
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+	gzipped := func(content []byte) []byte {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		if _, err := gw.Write(content); err != nil {
+			t.Fatalf("Failed to compress request body: <ERROR> %v", err)
+		}
+		if err := gw.Close(); err != nil {
+			t.Fatalf("Failed to compress request body: <ERROR> %v", err)
+		}
+		return buf.Bytes()
+	}
+
+	taggingBytes := []byte(`<Tagging><TagSet><Tag><Key>site</Key><Value>public</Value></Tag></TagSet></Tagging>`)
+	if rec := putTagging(gzipped(taggingBytes), true); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	tcfg, _, err := globalBucketMetadataSys.GetTaggingConfig(bucketName)
+	if err != nil {
+		t.Fatalf("MinIO %s: Expected the tagging config to be set: <ERROR> %v", instanceType, err)
+	}
+	if tcfg.ToMap()["site"] != "public" {
+		t.Errorf("MinIO %s: Expected tag site=public, got %v", instanceType, tcfg.ToMap())
+	}
+
+	// Plain bodies keep working.
+	if rec := putTagging(taggingBytes, false); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+
+	// A payload that inflates beyond the cap is rejected, not parsed.
+	bomb := gzipped(bytes.Repeat([]byte{' '}, maxBucketConfigSize+1))
+	rec := putTagging(bomb, true)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusBadRequest, rec.Code)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte("EntityTooLarge")) {
+		t.Errorf("MinIO %s: Expected an EntityTooLarge error, got `%s`", instanceType, rec.Body.String())
+	}
+}
+
+// Wrapper for calling GetBucketTaggingHistory HTTP handler tests for both Erasure multiple disks and single node setup.
+func TestAPIGetBucketTaggingHistoryHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIGetBucketTaggingHistoryHandler, []string{"GetBucketTaggingHistory"})
+}
+
+// testAPIGetBucketTaggingHistoryHandler - Tests that tagging configuration
+// changes are recorded in a bounded history and served oldest first.
+func testAPIGetBucketTaggingHistoryHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	getHistory := func() TaggingHistoryResponse {
+		req, err := newTestSignedRequestV4(http.MethodGet, getGetBucketTaggingHistoryURL("", bucketName), 0, nil,
+			credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for GetBucketTaggingHistory: <ERROR> %v", err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+		}
+		var response TaggingHistoryResponse
+		if err = xml.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("MinIO %s: Failed parsing response body: <ERROR> %v", instanceType, err)
+		}
+		return response
+	}
+
+	// Two updates followed by a delete leave a three entry trail.
+	for _, value := range []string{"dev", "prod"} {
+		taggingBytes := []byte(fmt.Sprintf(`<Tagging><TagSet><Tag><Key>env</Key><Value>%s</Value></Tag></TagSet></Tagging>`, value))
+		if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketTaggingConfig, taggingBytes); err != nil {
+			t.Fatalf("Failed to set bucket tagging: <ERROR> %v", err)
+		}
+	}
+	if _, err := globalBucketMetadataSys.Delete(GlobalContext, bucketName, bucketTaggingConfig); err != nil {
+		t.Fatalf("Failed to delete bucket tagging: <ERROR> %v", err)
+	}
+
+	response := getHistory()
+	if len(response.Versions) != 3 {
+		t.Fatalf("MinIO %s: Expected 3 history entries, got %d", instanceType, len(response.Versions))
+	}
+	for i, value := range []string{"dev", "prod"} {
+		if response.Versions[i].Tagging == nil || response.Versions[i].Tagging.ToMap()["env"] != value {
+			t.Errorf("MinIO %s: Expected entry %d to carry env=%s, got %v", instanceType, i, value, response.Versions[i].Tagging)
+		}
+	}
+	if !response.Versions[2].Deleted {
+		t.Errorf("MinIO %s: Expected the last entry to mark the tag set deletion", instanceType)
+	}
+
+	// The history is bounded to the most recent changes.
+	for i := 0; i < maxTaggingHistoryEntries+2; i++ {
+		taggingBytes := []byte(fmt.Sprintf(`<Tagging><TagSet><Tag><Key>rev</Key><Value>v%d</Value></Tag></TagSet></Tagging>`, i))
+		if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketTaggingConfig, taggingBytes); err != nil {
+			t.Fatalf("Failed to set bucket tagging: <ERROR> %v", err)
+		}
+	}
+	response = getHistory()
+	if len(response.Versions) != maxTaggingHistoryEntries {
+		t.Fatalf("MinIO %s: Expected the history to be trimmed to %d entries, got %d", instanceType, maxTaggingHistoryEntries, len(response.Versions))
+	}
+	last := response.Versions[len(response.Versions)-1]
+	if last.Tagging == nil || last.Tagging.ToMap()["rev"] != fmt.Sprintf("v%d", maxTaggingHistoryEntries+1) {
+		t.Errorf("MinIO %s: Expected the newest change last in the history, got %v", instanceType, last.Tagging)
+	}
+}
+
+// Wrapper for calling GetBucketObjectLockConfig HTTP handler tests for both Erasure multiple disks and single node setup.
+func TestAPIGetBucketObjectLockConfigHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIGetBucketObjectLockConfigHandler, []string{"GetBucketObjectLockConfig", "PutBucketPolicy"})
+}
+
+// testAPIGetBucketObjectLockConfigHandler - Tests that anonymous requests are
+// served only when a bucket policy grants s3:GetBucketObjectLockConfiguration
+// to them.
+func testAPIGetBucketObjectLockConfigHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	// Set the object lock configuration directly, the handler under test only reads it.
+	lockBytes := []byte(`<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`)
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, objectLockConfig, lockBytes); err != nil {
+		t.Fatalf("Failed to set object lock configuration: <ERROR> %v", err)
+	}
+
+	// Anonymous request is denied while no bucket policy grants the action.
+	req, err := newTestRequest(http.MethodGet, getGetBucketObjectLockConfigURL("", bucketName), 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for GetBucketObjectLockConfig: <ERROR> %v", err)
+	}
+	rec := httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusForbidden, rec.Code)
+	}
+
+	// Grant s3:GetBucketObjectLockConfiguration to anonymous principals.
+	policyBytes := []byte(fmt.Sprintf(`{"Version": "2012-10-17", "Statement": [{"Action": "s3:GetBucketObjectLockConfiguration", "Effect": "Allow", "Resource": "arn:aws:s3:::%s", "Principal": "*"}]}`, bucketName))
+	req, err = newTestSignedRequestV4(http.MethodPut, getPutPolicyURL("", bucketName), int64(len(policyBytes)), bytes.NewReader(policyBytes),
+		credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for PutBucketPolicyHandler: <ERROR> %v", err)
+	}
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("Expected the response status to be `%d`, but instead found `%d`", http.StatusNoContent, rec.Code)
+	}
+
+	// The same anonymous request is now served.
+	req, err = newTestRequest(http.MethodGet, getGetBucketObjectLockConfigURL("", bucketName), 0, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for GetBucketObjectLockConfig: <ERROR> %v", err)
+	}
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte("<ObjectLockEnabled>Enabled</ObjectLockEnabled>")) {
+		t.Errorf("MinIO %s: Expected the object lock configuration in the response, got `%s`", instanceType, rec.Body.String())
+	}
+}
+
+func TestAPIHeadBucketObjectLockDefaults(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIHeadBucketObjectLockDefaults, []string{"HeadBucket"})
+}
+
+// testAPIHeadBucketObjectLockDefaults - Tests that HeadBucket surfaces the
+// bucket's default object-lock retention as headers for authorized callers.
+func testAPIHeadBucketObjectLockDefaults(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	headBucket := func() *httptest.ResponseRecorder {
+		req, err := newTestSignedRequestV4(http.MethodHead, getHEADBucketURL("", bucketName), 0, nil,
+			credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for HeadBucket: <ERROR> %v", err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+		}
+		return rec
+	}
+
+	// Without a default retention no lock headers are returned.
+	rec := headBucket()
+	if got := rec.Header().Get(xhttp.MinIOObjectLockDefaultMode); got != "" {
+		t.Errorf("MinIO %s: Expected no default retention mode header, got `%s`", instanceType, got)
+	}
+
+	// Configure a default retention of 30 days in governance mode.
+	lockBytes := []byte(`<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`)
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, objectLockConfig, lockBytes); err != nil {
+		t.Fatalf("Failed to set object lock configuration: <ERROR> %v", err)
+	}
+
+	rec = headBucket()
+	if got := rec.Header().Get(xhttp.MinIOObjectLockDefaultMode); got != "GOVERNANCE" {
+		t.Errorf("MinIO %s: Expected default retention mode `GOVERNANCE`, got `%s`", instanceType, got)
+	}
+	if got := rec.Header().Get(xhttp.MinIOObjectLockDefaultDays); got != "30" {
+		t.Errorf("MinIO %s: Expected default retention days `30`, got `%s`", instanceType, got)
+	}
+	if got := rec.Header().Get(xhttp.MinIOObjectLockDefaultYears); got != "" {
+		t.Errorf("MinIO %s: Expected no default retention years header, got `%s`", instanceType, got)
+	}
+}
+
+// Test the federation DNS comparison used by initFederatorBackend and
+// the admin DNS consistency report.
+func TestCompareFederationDNS(t *testing.T) {
+	domainIPs := set.CreateStringSet("10.0.0.1:9000", "10.0.0.2:9000")
+	records := func(hosts ...string) (records []dns.SrvRecord) {
+		for _, host := range hosts {
+			h, p, err := net.SplitHostPort(host)
+			if err != nil {
+				t.Fatal(err)
+			}
+			records = append(records, dns.SrvRecord{Host: h, Port: json.Number(p)})
+		}
+		return records
+	}
+	buckets := []BucketInfo{
+		{Name: "healthy"},
+		{Name: "missing"},
+		{Name: "outdated"},
+		{Name: "conflict"},
+		{Name: "CaseBucket"},
+	}
+	dnsBuckets := map[string][]dns.SrvRecord{
+		"healthy":    records("10.0.0.1:9000", "10.0.0.2:9000"),
+		"outdated":   records("10.0.0.1:9000"),
+		"conflict":   records("192.168.1.1:9000"),
+		"stale":      records("10.0.0.1:9000", "10.0.0.2:9000"),
+		"foreign":    records("192.168.1.1:9000"),
+		"casebucket": records("192.168.1.1:9000"),
+	}
+
+	comparison := compareFederationDNS(buckets, dnsBuckets, domainIPs, false)
+	if got, want := comparison.bucketsToBeUpdated.ToSlice(), []string{"missing", "outdated"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bucketsToBeUpdated: expected %v, got %v", want, got)
+	}
+	if got, want := comparison.bucketsInConflict.ToSlice(), []string{"conflict"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bucketsInConflict: expected %v, got %v", want, got)
+	}
+	if got, want := comparison.staleDNSBuckets.ToSlice(), []string{"stale"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("staleDNSBuckets: expected %v, got %v", want, got)
+	}
+	if got, want := comparison.caseConflictBuckets.ToSlice(), []string{"casebucket"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("caseConflictBuckets: expected %v, got %v", want, got)
+	}
+
+	// With the domain missing from the DNS store even unchanged entries
+	// must be refreshed.
+	comparison = compareFederationDNS(buckets, dnsBuckets, domainIPs, true)
+	if got, want := comparison.bucketsToBeUpdated.ToSlice(), []string{"healthy", "missing", "outdated"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bucketsToBeUpdated with domain missing: expected %v, got %v", want, got)
+	}
+
+	// Without DNS entries nothing is classified.
+	comparison = compareFederationDNS(buckets, nil, domainIPs, false)
+	if !comparison.bucketsToBeUpdated.IsEmpty() || !comparison.bucketsInConflict.IsEmpty() || !comparison.staleDNSBuckets.IsEmpty() {
+		t.Errorf("expected empty comparison for nil dnsBuckets, got %+v", comparison)
+	}
+}
+
+// Test detection of federated DNS entries differing only by letter case.
+func TestFederationCaseConflict(t *testing.T) {
+	dnsBuckets := map[string][]dns.SrvRecord{
+		"foobar": {},
+		"other":  {},
+	}
+	if got, want := federationCaseConflict("FooBar", dnsBuckets), "foobar"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if got := federationCaseConflict("foobar", dnsBuckets); got != "" {
+		t.Errorf("exact match is not a case conflict, got %q", got)
+	}
+	if got := federationCaseConflict("unrelated", dnsBuckets); got != "" {
+		t.Errorf("expected no conflict, got %q", got)
+	}
+}
+
+// flakyDNSStore is a dns.Store stub whose first failPuts Put calls fail
+// with a transient error.
+type flakyDNSStore struct {
+	mu       sync.Mutex
+	failPuts int
+	entries  map[string][]dns.SrvRecord
+}
+
+func (s *flakyDNSStore) Put(bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failPuts > 0 {
+		s.failPuts--
+		return errors.New("etcd: request timed out")
+	}
+	s.entries[bucket] = []dns.SrvRecord{{Host: "10.0.0.1", Port: json.Number("9000")}}
+	return nil
+}
+
+func (s *flakyDNSStore) Get(bucket string) ([]dns.SrvRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if records, ok := s.entries[bucket]; ok {
+		return records, nil
+	}
+	return nil, dns.ErrNoEntriesFound
+}
+
+func (s *flakyDNSStore) Delete(bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, bucket)
+	return nil
+}
+
+func (s *flakyDNSStore) List() (map[string][]dns.SrvRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dnsBuckets := make(map[string][]dns.SrvRecord, len(s.entries))
+	for bucket, records := range s.entries {
+		dnsBuckets[bucket] = records
+	}
+	return dnsBuckets, nil
+}
+
+func (s *flakyDNSStore) DeleteRecord(record dns.SrvRecord) error { return nil }
+func (s *flakyDNSStore) Close() error                            { return nil }
+func (s *flakyDNSStore) String() string                          { return "flakyDNSStore" }
+
+// Tests that initFederatorBackend keeps going past DNS Put failures,
+// retries transient errors and queues permanently failing buckets for
+// the periodic retrier.
+func TestInitFederatorBackendPartialFailures(t *testing.T) {
+	prevDNSConfig, prevDomainIPs := globalDNSConfig, globalDomainIPs
+	defer func() {
+		globalDNSConfig, globalDomainIPs = prevDNSConfig, prevDomainIPs
+	}()
+	globalDomainIPs = set.CreateStringSet("10.0.0.1")
+
+	buckets := []BucketInfo{{Name: "bucket-a"}, {Name: "bucket-b"}, {Name: "bucket-c"}}
+
+	// A single transient failure must be absorbed by the bounded retry,
+	// all buckets end up registered and nothing is left pending.
+	store := &flakyDNSStore{failPuts: 1, entries: make(map[string][]dns.SrvRecord)}
+	globalDNSConfig = store
+	initFederatorBackend(buckets, nil)
+	for _, bucket := range buckets {
+		if _, err := store.Get(bucket.Name); err != nil {
+			t.Errorf("bucket %s was not registered: %v", bucket.Name, err)
+		}
+	}
+	if n := globalFederationPending.count(); n != 0 {
+		t.Errorf("expected no pending registrations, got %d", n)
+	}
+
+	// Puts that keep failing beyond the bounded retries must leave the
+	// buckets queued for the periodic retrier instead of forgotten.
+	store = &flakyDNSStore{failPuts: 1 << 30, entries: make(map[string][]dns.SrvRecord)}
+	globalDNSConfig = store
+	initFederatorBackend(buckets, nil)
+	if n := globalFederationPending.count(); n != len(buckets) {
+		t.Errorf("expected %d pending registrations, got %d", len(buckets), n)
+	}
+	for _, bucket := range globalFederationPending.snapshot() {
+		globalFederationPending.remove(bucket)
+	}
+}
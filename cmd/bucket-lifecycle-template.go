@@ -0,0 +1,120 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/minio/minio/internal/bucket/lifecycle"
+	"github.com/minio/minio/internal/config"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/env"
+)
+
+// A deployment-wide lifecycle template applied to newly created buckets,
+// loaded from the file named by _MINIO_LIFECYCLE_TEMPLATE_FILE. With
+// _MINIO_LIFECYCLE_TEMPLATE_AUTO enabled the template is applied to every
+// new bucket, otherwise only when the creation request asks for it via
+// the x-minio-lifecycle-template header.
+const (
+	lifecycleTemplateFileEnv = "_MINIO_LIFECYCLE_TEMPLATE_FILE"
+	lifecycleTemplateAutoEnv = "_MINIO_LIFECYCLE_TEMPLATE_AUTO"
+)
+
+type lifecycleTemplate struct {
+	mu   sync.RWMutex
+	data []byte
+	auto bool
+}
+
+var globalLifecycleTemplate = &lifecycleTemplate{}
+
+func (l *lifecycleTemplate) set(data []byte, auto bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.data = data
+	l.auto = auto
+}
+
+func (l *lifecycleTemplate) get() (data []byte, auto bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.data, l.auto
+}
+
+// validateLifecycleTemplate parses and validates a lifecycle template
+// document independently of any bucket, returning the canonical XML that
+// would be stored as bucket metadata.
+func validateLifecycleTemplate(data []byte) ([]byte, error) {
+	lc, err := lifecycle.ParseLifecycleConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if err = lc.Validate(); err != nil {
+		return nil, err
+	}
+	return xml.Marshal(lc)
+}
+
+func init() {
+	fileName := env.Get(lifecycleTemplateFileEnv, "")
+	if fileName == "" {
+		return
+	}
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		logger.Info("unable to read lifecycle template %s: %v, no template will be applied", fileName, err)
+		return
+	}
+	configData, err := validateLifecycleTemplate(data)
+	if err != nil {
+		logger.Info("invalid lifecycle template %s: %v, no template will be applied", fileName, err)
+		return
+	}
+	globalLifecycleTemplate.set(configData, env.Get(lifecycleTemplateAutoEnv, config.EnableOff) == config.EnableOn)
+}
+
+// applyLifecycleTemplate stores the deployment's lifecycle template on a
+// newly created bucket, either because the deployment applies it
+// automatically or because the creation request asked for it. Callers
+// roll the bucket creation back when it fails.
+func applyLifecycleTemplate(ctx context.Context, r *http.Request, bucket string) error {
+	data, apply := globalLifecycleTemplate.get()
+	if value := r.Header.Get(xhttp.MinIOLifecycleTemplate); value != "" {
+		apply, _ = strconv.ParseBool(value)
+	}
+	if !apply {
+		return nil
+	}
+	if data == nil {
+		return InvalidArgument{
+			Bucket: bucket,
+			Err:    errors.New("no lifecycle template is configured on this deployment"),
+		}
+	}
+	_, err := globalBucketMetadataSys.Update(ctx, bucket, bucketLifecycleConfig, data)
+	return err
+}
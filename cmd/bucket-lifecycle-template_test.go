@@ -0,0 +1,106 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+const testLifecycleTemplate = `<LifecycleConfiguration><Rule><ID>expire-temp</ID><Status>Enabled</Status><Filter><Prefix>temp/</Prefix></Filter><Expiration><Days>7</Days></Expiration></Rule></LifecycleConfiguration>`
+
+func TestValidateLifecycleTemplate(t *testing.T) {
+	if _, err := validateLifecycleTemplate([]byte(testLifecycleTemplate)); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := validateLifecycleTemplate([]byte("not-xml")); err == nil {
+		t.Fatal("expected error for malformed template")
+	}
+	// A syntactically valid document still has to pass rule validation.
+	if _, err := validateLifecycleTemplate([]byte(`<LifecycleConfiguration></LifecycleConfiguration>`)); err == nil {
+		t.Fatal("expected error for template without rules")
+	}
+}
+
+// Wrapper for calling MakeBucket with the lifecycle template extension for
+// both Erasure multiple disks and single node setup.
+func TestAPIPutBucketLifecycleTemplateHandler(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIPutBucketLifecycleTemplateHandler, []string{"MakeBucket"})
+}
+
+// testAPIPutBucketLifecycleTemplateHandler - Tests that bucket creations
+// asking for the deployment's lifecycle template via the
+// x-minio-lifecycle-template header get it applied, and that asking for a
+// template on a deployment without one rolls the creation back.
+func testAPIPutBucketLifecycleTemplateHandler(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	configData, err := validateLifecycleTemplate([]byte(testLifecycleTemplate))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	globalLifecycleTemplate.set(configData, false)
+	defer globalLifecycleTemplate.set(nil, false)
+
+	makeBucket := func(bucket, headerValue string) *httptest.ResponseRecorder {
+		t.Helper()
+		req, err := newTestSignedRequestV4(http.MethodPut, getMakeBucketURL("", bucket),
+			0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for MakeBucket: <ERROR> %v", err)
+		}
+		if headerValue != "" {
+			req.Header.Set(xhttp.MinIOLifecycleTemplate, headerValue)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Creation asking for the template gets it applied.
+	bucketWithTemplate := getRandomBucketName()
+	if rec := makeBucket(bucketWithTemplate, "true"); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	if _, _, err := globalBucketMetadataSys.GetLifecycleConfig(bucketWithTemplate); err != nil {
+		t.Fatalf("MinIO %s: Expected lifecycle template to be applied: <ERROR> %v", instanceType, err)
+	}
+
+	// Creation without the header leaves the bucket without lifecycle.
+	bucketPlain := getRandomBucketName()
+	if rec := makeBucket(bucketPlain, ""); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	if _, _, err := globalBucketMetadataSys.GetLifecycleConfig(bucketPlain); err == nil {
+		t.Fatalf("MinIO %s: Expected no lifecycle config without the header", instanceType)
+	}
+
+	// Asking for a template when none is configured rolls the creation back.
+	globalLifecycleTemplate.set(nil, false)
+	bucketRolledBack := getRandomBucketName()
+	if rec := makeBucket(bucketRolledBack, "true"); rec.Code == http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the creation to fail without a configured template", instanceType)
+	}
+	if _, err := obj.GetBucketInfo(GlobalContext, bucketRolledBack, BucketOptions{}); err == nil {
+		t.Fatalf("MinIO %s: Expected the bucket creation to be rolled back", instanceType)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// name of the config file carrying the bucket maximum object size.
+const bucketMaxObjectSizeConfig = "max-object-size.xml"
+
+// srBucketMetaTypeMaxObjectSize - represents replication of the bucket
+// maximum object size configuration across sites, the encoded config
+// travels in the SRBucketMeta Tags field.
+const srBucketMetaTypeMaxObjectSize = "max-object-size"
+
+// MaxObjectSizeConfig caps the size of a single object in the bucket.
+// Uploads larger than the limit are rejected with EntityTooLarge.
+type MaxObjectSizeConfig struct {
+	XMLName xml.Name `xml:"MaxObjectSizeConfiguration"`
+	// MaxObjectSize is the largest permitted object size in bytes.
+	MaxObjectSize int64 `xml:"MaxObjectSize"`
+}
+
+// parseBucketMaxObjectSizeConfig unmarshals and validates 'max-object-size.xml'.
+func parseBucketMaxObjectSizeConfig(reader io.Reader) (*MaxObjectSizeConfig, error) {
+	cfg := MaxObjectSizeConfig{}
+	if err := xml.NewDecoder(reader).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	if cfg.MaxObjectSize <= 0 {
+		return nil, errors.New("maximum object size must be greater than zero")
+	}
+	if cfg.MaxObjectSize > globalMaxObjectSize {
+		return nil, fmt.Errorf("maximum object size cannot exceed the server limit of %d bytes", globalMaxObjectSize)
+	}
+	return &cfg, nil
+}
+
+// exceedsBucketMaxObjectSize returns the configured limit and true when
+// size is larger than the bucket's maximum object size. Buckets without
+// the configuration never report an excess.
+func exceedsBucketMaxObjectSize(bucket string, size int64) (limit int64, exceeded bool) {
+	cfg, _, err := globalBucketMetadataSys.GetMaxObjectSizeConfig(bucket)
+	if err != nil {
+		return 0, false
+	}
+	return cfg.MaxObjectSize, size > cfg.MaxObjectSize
+}
+
+// bucketMaxObjectSizeError returns the EntityTooLarge API error carrying
+// the bucket's configured limit in the message.
+func bucketMaxObjectSizeError(bucket string, limit int64) APIError {
+	apiErr := errorCodes.ToAPIErr(ErrEntityTooLarge)
+	apiErr.Description = fmt.Sprintf("Your proposed upload exceeds the maximum object size of %d bytes configured on bucket %s", limit, bucket)
+	return apiErr
+}
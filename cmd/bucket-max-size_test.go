@@ -0,0 +1,106 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+)
+
+func TestParseBucketMaxObjectSizeConfig(t *testing.T) {
+	testCases := []struct {
+		config    string
+		expectErr bool
+	}{
+		{`<MaxObjectSizeConfiguration><MaxObjectSize>1048576</MaxObjectSize></MaxObjectSizeConfiguration>`, false},
+		// Zero limit.
+		{`<MaxObjectSizeConfiguration><MaxObjectSize>0</MaxObjectSize></MaxObjectSizeConfiguration>`, true},
+		// Negative limit.
+		{`<MaxObjectSizeConfiguration><MaxObjectSize>-1</MaxObjectSize></MaxObjectSizeConfiguration>`, true},
+		// Beyond the server limit.
+		{fmt.Sprintf(`<MaxObjectSizeConfiguration><MaxObjectSize>%d</MaxObjectSize></MaxObjectSizeConfiguration>`, int64(globalMaxObjectSize)+1), true},
+		// Missing limit.
+		{`<MaxObjectSizeConfiguration></MaxObjectSizeConfiguration>`, true},
+	}
+	for i, testCase := range testCases {
+		_, err := parseBucketMaxObjectSizeConfig(strings.NewReader(testCase.config))
+		if testCase.expectErr && err == nil {
+			t.Errorf("Test %d: expected an error, got none", i+1)
+		}
+		if !testCase.expectErr && err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i+1, err)
+		}
+	}
+}
+
+// Wrapper for calling TestAPIBucketMaxObjectSize tests for both Erasure
+// multiple disks and single node setup.
+func TestAPIBucketMaxObjectSize(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIBucketMaxObjectSize, []string{"PutObject"})
+}
+
+// testAPIBucketMaxObjectSize - Tests that PutObject rejects uploads larger
+// than the bucket's configured maximum object size.
+func testAPIBucketMaxObjectSize(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	const limit = 16
+	cfg := []byte(fmt.Sprintf(`<MaxObjectSizeConfiguration><MaxObjectSize>%d</MaxObjectSize></MaxObjectSizeConfiguration>`, limit))
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketMaxObjectSizeConfig, cfg); err != nil {
+		t.Fatalf("Failed to set bucket maximum object size configuration: <ERROR> %v", err)
+	}
+
+	putObject := func(objectName string, size int) *httptest.ResponseRecorder {
+		data := strings.Repeat("a", size)
+		req, err := newTestSignedRequestV4(http.MethodPut, getPutObjectURL("", bucketName, objectName),
+			int64(size), strings.NewReader(data), credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for PutObject: <ERROR> %v", err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// An upload within the limit succeeds.
+	if rec := putObject("small-object", limit); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+
+	// An upload beyond the limit is rejected with EntityTooLarge.
+	rec := putObject("large-object", limit+1)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusBadRequest, rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, "EntityTooLarge") || !strings.Contains(body, bucketName) {
+		t.Errorf("MinIO %s: Expected an EntityTooLarge error naming the bucket, got `%s`", instanceType, body)
+	}
+
+	// Removing the configuration lifts the limit.
+	if _, err := globalBucketMetadataSys.Delete(GlobalContext, bucketName, bucketMaxObjectSizeConfig); err != nil {
+		t.Fatalf("Failed to delete bucket maximum object size configuration: <ERROR> %v", err)
+	}
+	if rec := putObject("large-object", limit+1); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+}
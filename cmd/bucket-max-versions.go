@@ -0,0 +1,82 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// name of the config file carrying the bucket maximum versions limit.
+const bucketMaxVersionsConfig = "max-versions.xml"
+
+// srBucketMetaTypeMaxVersions - represents replication of the bucket
+// maximum versions configuration across sites, the encoded config
+// travels in the SRBucketMeta Tags field.
+const srBucketMetaTypeMaxVersions = "max-versions"
+
+// MaxVersionsConfig caps the number of versions a single object in the
+// bucket may accumulate. POST policy uploads to an object already at
+// the limit are rejected, protecting against misbehaving web uploaders
+// re-POSTing the same key. Buckets without the configuration are
+// unlimited.
+type MaxVersionsConfig struct {
+	XMLName xml.Name `xml:"MaxVersionsConfiguration"`
+	// MaxVersions is the largest permitted number of versions per object.
+	MaxVersions int64 `xml:"MaxVersions"`
+}
+
+// parseBucketMaxVersionsConfig unmarshals and validates 'max-versions.xml'.
+func parseBucketMaxVersionsConfig(reader io.Reader) (*MaxVersionsConfig, error) {
+	cfg := MaxVersionsConfig{}
+	if err := xml.NewDecoder(reader).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	if cfg.MaxVersions <= 0 {
+		return nil, errors.New("maximum versions must be greater than zero")
+	}
+	return &cfg, nil
+}
+
+// exceedsBucketMaxVersions returns the configured limit and true when
+// the object already carries at least the bucket's maximum number of
+// versions, so one more upload would exceed it. Buckets without the
+// configuration never report an excess.
+func exceedsBucketMaxVersions(ctx context.Context, api ObjectLayer, bucket, object string) (limit int64, exceeded bool) {
+	cfg, _, err := globalBucketMetadataSys.GetMaxVersionsConfig(bucket)
+	if err != nil {
+		return 0, false
+	}
+	oi, err := api.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		// A missing object has no versions to count against the limit.
+		return cfg.MaxVersions, false
+	}
+	return cfg.MaxVersions, int64(oi.NumVersions) >= cfg.MaxVersions
+}
+
+// bucketMaxVersionsError returns the API error rejecting an upload to an
+// object already at the bucket's version limit.
+func bucketMaxVersionsError(bucket string, limit int64) APIError {
+	apiErr := errorCodes.ToAPIErr(ErrInvalidRequest)
+	apiErr.Description = fmt.Sprintf("The object already has the maximum of %d versions configured on bucket %s", limit, bucket)
+	return apiErr
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+)
+
+func TestParseBucketMaxVersionsConfig(t *testing.T) {
+	testCases := []struct {
+		config    string
+		expectErr bool
+	}{
+		{`<MaxVersionsConfiguration><MaxVersions>100</MaxVersions></MaxVersionsConfiguration>`, false},
+		// Zero limit.
+		{`<MaxVersionsConfiguration><MaxVersions>0</MaxVersions></MaxVersionsConfiguration>`, true},
+		// Negative limit.
+		{`<MaxVersionsConfiguration><MaxVersions>-1</MaxVersions></MaxVersionsConfiguration>`, true},
+		// Missing limit.
+		{`<MaxVersionsConfiguration></MaxVersionsConfiguration>`, true},
+	}
+	for i, testCase := range testCases {
+		_, err := parseBucketMaxVersionsConfig(strings.NewReader(testCase.config))
+		if testCase.expectErr && err == nil {
+			t.Errorf("Test %d: expected an error, got none", i+1)
+		}
+		if !testCase.expectErr && err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i+1, err)
+		}
+	}
+}
+
+// Wrapper for calling TestAPIBucketMaxVersions tests for both Erasure
+// multiple disks and single node setup.
+func TestAPIBucketMaxVersions(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIBucketMaxVersions, []string{"PostPolicy"})
+}
+
+// testAPIBucketMaxVersions - Tests that POST policy uploads to an object
+// already at the bucket's version limit are rejected.
+func testAPIBucketMaxVersions(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	objectName := "re-posted-object"
+	cfg := []byte(`<MaxVersionsConfiguration><MaxVersions>1</MaxVersions></MaxVersionsConfiguration>`)
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketMaxVersionsConfig, cfg); err != nil {
+		t.Fatalf("Failed to set bucket maximum versions configuration: <ERROR> %v", err)
+	}
+
+	postObject := func() *httptest.ResponseRecorder {
+		req, err := newPostRequestV4("", bucketName, objectName, []byte("hello"), credentials.AccessKey, credentials.SecretKey)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for PostPolicyHandler: <ERROR> %v", err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// The first upload of the key succeeds.
+	if rec := postObject(); rec.Code != http.StatusNoContent {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusNoContent, rec.Code)
+	}
+
+	// Re-POSTing the same key exceeds the limit of one version.
+	rec := postObject()
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusBadRequest, rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, fmt.Sprintf("maximum of %d versions", 1)) || !strings.Contains(body, bucketName) {
+		t.Errorf("MinIO %s: Expected a version limit error naming the bucket, got `%s`", instanceType, body)
+	}
+
+	// Removing the configuration lifts the limit.
+	if _, err := globalBucketMetadataSys.Delete(GlobalContext, bucketName, bucketMaxVersionsConfig); err != nil {
+		t.Fatalf("Failed to delete bucket maximum versions configuration: <ERROR> %v", err)
+	}
+	if rec := postObject(); rec.Code != http.StatusNoContent {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusNoContent, rec.Code)
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/minio/minio/internal/bucket/lifecycle"
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+	"github.com/minio/minio/internal/bucket/replication"
+	"github.com/minio/minio/internal/event"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/mux"
+	"github.com/minio/pkg/bucket/policy"
+)
+
+// bucketMetadataBundleVersion is the format version of the bundle returned
+// by ?metadata-export, bumped when the layout changes incompatibly.
+const bucketMetadataBundleVersion = 1
+
+// maxBucketMetadataBundleSize caps the size of an imported bundle.
+const maxBucketMetadataBundleSize = 4 << 20 // 4 MiB
+
+// BucketMetadataBundleEntry carries one bucket config inside a bundle. The
+// config bytes travel base64 encoded through the JSON marshaling of []byte.
+type BucketMetadataBundleEntry struct {
+	Name   string `json:"name"`
+	Config []byte `json:"config"`
+}
+
+// BucketMetadataBundle is the export format of ?metadata-export: every
+// config set on the bucket, minus secrets, in a single importable document.
+// Remote target credentials never leave the cluster, so the replication
+// config is exported without its targets file and targets must be recreated
+// on the importing cluster before replication resumes.
+type BucketMetadataBundle struct {
+	Version    int                         `json:"version"`
+	Bucket     string                      `json:"bucket"`
+	ExportedAt time.Time                   `json:"exportedAt"`
+	Configs    []BucketMetadataBundleEntry `json:"configs"`
+	// Signature is a hex HMAC-SHA256 over the configs, keyed with the
+	// exporting cluster's root credentials. Import verifies it with the
+	// local credentials, so a bundle is only accepted by clusters sharing
+	// the root credentials of the cluster that produced it.
+	Signature string `json:"signature"`
+}
+
+// sign returns the bundle signature computed with the cluster's root
+// credentials.
+func (b *BucketMetadataBundle) sign() (string, error) {
+	payload, err := json.Marshal(b.Configs)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, []byte(globalActiveCred.SecretKey))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// verify reports whether the bundle signature was produced with this
+// cluster's root credentials.
+func (b *BucketMetadataBundle) verify() bool {
+	want, err := b.sign()
+	if err != nil {
+		return false
+	}
+	return hmac.Equal([]byte(want), []byte(b.Signature))
+}
+
+// exportableBucketConfigs lists the configs included in a bundle, in the
+// order they are exported and applied. Object lock precedes the configs
+// that depend on versioning being enabled.
+var exportableBucketConfigs = []string{
+	objectLockConfig,
+	bucketPolicyConfig,
+	bucketNotificationConfig,
+	bucketLifecycleConfig,
+	bucketSSEConfig,
+	bucketTaggingConfig,
+	bucketReplicationConfig,
+}
+
+// exportBucketMetadataBundle collects the bucket's configs into a signed
+// bundle. Configs not set on the bucket are omitted.
+func exportBucketMetadataBundle(ctx context.Context, bucket string) (*BucketMetadataBundle, error) {
+	meta, _, err := globalBucketMetadataSys.GetConfig(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	configData := map[string][]byte{
+		objectLockConfig:         meta.ObjectLockConfigXML,
+		bucketPolicyConfig:       meta.PolicyConfigJSON,
+		bucketNotificationConfig: meta.NotificationConfigXML,
+		bucketLifecycleConfig:    meta.LifecycleConfigXML,
+		bucketSSEConfig:          meta.EncryptionConfigXML,
+		bucketTaggingConfig:      meta.TaggingConfigXML,
+		bucketReplicationConfig:  meta.ReplicationConfigXML,
+	}
+
+	bundle := &BucketMetadataBundle{
+		Version:    bucketMetadataBundleVersion,
+		Bucket:     bucket,
+		ExportedAt: UTCNow(),
+	}
+	for _, name := range exportableBucketConfigs {
+		if len(configData[name]) == 0 {
+			continue
+		}
+		bundle.Configs = append(bundle.Configs, BucketMetadataBundleEntry{
+			Name:   name,
+			Config: configData[name],
+		})
+	}
+
+	bundle.Signature, err = bundle.sign()
+	if err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// validateImportedBucketConfig parses and validates one bundle entry
+// against the importing bucket, mirroring the checks the corresponding PUT
+// config handler performs. It does not modify any state.
+func validateImportedBucketConfig(ctx context.Context, bucket, name string, data []byte) error {
+	switch name {
+	case objectLockConfig:
+		if _, err := objectlock.ParseObjectLockConfig(bytes.NewReader(data)); err != nil {
+			return err
+		}
+		// Object locking must have been enabled when the importing
+		// bucket was created, only retention defaults are importable.
+		if _, _, err := globalBucketMetadataSys.GetObjectLockConfig(bucket); err != nil {
+			return err
+		}
+		return nil
+	case bucketPolicyConfig:
+		if len(data) > maxBucketPolicySize {
+			return fmt.Errorf("bucket policy too large")
+		}
+		bucketPolicy, err := policy.ParseConfig(bytes.NewReader(data), bucket)
+		if err != nil {
+			return err
+		}
+		if bucketPolicy.Version == "" {
+			return fmt.Errorf("bucket policy version cannot be empty")
+		}
+		return nil
+	case bucketNotificationConfig:
+		_, err := event.ParseConfig(bytes.NewReader(data), globalSite.Region, globalEventNotifier.targetList)
+		return err
+	case bucketLifecycleConfig:
+		bucketLifecycle, err := lifecycle.ParseLifecycleConfig(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		if err := bucketLifecycle.Validate(); err != nil {
+			return err
+		}
+		// Transition rules need their tiers defined on this cluster.
+		return validateTransitionTier(bucketLifecycle)
+	case bucketSSEConfig:
+		if _, err := validateBucketSSEConfig(bytes.NewReader(data)); err != nil {
+			return err
+		}
+		if GlobalKMS == nil {
+			return errKMSNotConfigured
+		}
+		return nil
+	case bucketTaggingConfig:
+		_, err := tags.ParseBucketXML(bytes.NewReader(data))
+		return err
+	case bucketReplicationConfig:
+		// Remote target credentials are not part of the bundle, targets
+		// must be recreated on this cluster before replication resumes.
+		_, err := replication.ParseConfig(bytes.NewReader(data))
+		return err
+	}
+	return fmt.Errorf("unknown config %q", name)
+}
+
+// importBucketMetaHook propagates an imported config to site replication
+// peers, for the config types the site replication protocol carries.
+func importBucketMetaHook(ctx context.Context, bucket, name string, configData []byte, updatedAt time.Time) {
+	cfgStr := base64.StdEncoding.EncodeToString(configData)
+	var m *madmin.SRBucketMeta
+	switch name {
+	case bucketPolicyConfig:
+		m = &madmin.SRBucketMeta{Type: madmin.SRBucketMetaTypePolicy, Bucket: bucket, Policy: configData, UpdatedAt: updatedAt}
+	case bucketTaggingConfig:
+		m = &madmin.SRBucketMeta{Type: madmin.SRBucketMetaTypeTags, Bucket: bucket, Tags: &cfgStr, UpdatedAt: updatedAt}
+	case objectLockConfig:
+		m = &madmin.SRBucketMeta{Type: madmin.SRBucketMetaTypeObjectLockConfig, Bucket: bucket, ObjectLockConfig: &cfgStr, UpdatedAt: updatedAt}
+	case bucketSSEConfig:
+		m = &madmin.SRBucketMeta{Type: madmin.SRBucketMetaTypeSSEConfig, Bucket: bucket, SSEConfig: &cfgStr, UpdatedAt: updatedAt}
+	}
+	if m != nil {
+		logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, *m))
+	}
+}
+
+// Status values reported per config by ?metadata-import.
+const (
+	importStatusValid   = "valid"   // dry run: config validated
+	importStatusApplied = "applied" // config validated and stored
+	importStatusInvalid = "invalid" // config failed validation
+	importStatusFailed  = "failed"  // config validated but storing failed
+	importStatusSkipped = "skipped" // not attempted, another config is invalid
+)
+
+// BucketMetadataImportStatus is the per-config outcome of
+// ?metadata-import.
+type BucketMetadataImportStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BucketMetadataImportResult is the response of ?metadata-import.
+type BucketMetadataImportResult struct {
+	Bucket  string                       `json:"bucket"`
+	DryRun  bool                         `json:"dryRun,omitempty"`
+	Applied bool                         `json:"applied"`
+	Configs []BucketMetadataImportStatus `json:"configs"`
+}
+
+// GetBucketMetadataExportHandler - GET Bucket metadata export.
+// ----------
+// Returns a signed JSON bundle of every config set on the bucket, minus
+// secrets, for import on another cluster, this is a MinIO extension.
+func (api objectAPIHandlers) GetBucketMetadataExportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "GetBucketMetadataExport")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// The bundle includes the bucket policy, require the strongest
+	// bucket config read permission.
+	if s3Error := checkRequestAuthType(ctx, r, policy.GetBucketPolicyAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	bundle, err := exportBucketMetadataBundle(ctx, bucket)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// Write success response.
+	writeSuccessResponseJSON(w, data)
+}
+
+// PutBucketMetadataImportHandler - PUT Bucket metadata import.
+// ----------
+// Validates a bundle produced by ?metadata-export and applies its configs
+// to the bucket. Every config is validated before any is stored, an
+// invalid config fails the whole import. A dry run, requested through the
+// x-minio-dry-run header, reports the per-config outcomes without storing
+// anything. This is a MinIO extension.
+func (api objectAPIHandlers) PutBucketMetadataImportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "PutBucketMetadataImport")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	vars := mux.Vars(r)
+	bucket := vars["bucket"]
+
+	objectAPI := api.ObjectAPI()
+	if objectAPI == nil {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	// Check if bucket exists.
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket, BucketOptions{}); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	// The bundle carries the bucket policy, require the strongest bucket
+	// config write permission.
+	if s3Error := checkRequestAuthType(ctx, r, policy.PutBucketPolicyAction, bucket, ""); s3Error != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Error), r.URL)
+		return
+	}
+
+	dryRun := false
+	if value := r.Header.Get(xhttp.MinIODryRun); value != "" {
+		dryRun, _ = strconv.ParseBool(value)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBucketMetadataBundleSize+1))
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+	if len(data) > maxBucketMetadataBundleSize {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrEntityTooLarge), r.URL)
+		return
+	}
+
+	var bundle BucketMetadataBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		apiErr := errorCodes.ToAPIErr(ErrBucketMetadataBundleInvalid)
+		apiErr.Description = err.Error()
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+	if bundle.Version != bucketMetadataBundleVersion {
+		apiErr := errorCodes.ToAPIErr(ErrBucketMetadataBundleInvalid)
+		apiErr.Description = fmt.Sprintf("unsupported bundle version %d", bundle.Version)
+		writeErrorResponse(ctx, w, apiErr, r.URL)
+		return
+	}
+	if !bundle.verify() {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrBucketMetadataBundleInvalid), r.URL)
+		return
+	}
+
+	result := BucketMetadataImportResult{
+		Bucket:  bucket,
+		DryRun:  dryRun,
+		Configs: make([]BucketMetadataImportStatus, len(bundle.Configs)),
+	}
+
+	// Validate every config before applying any, an invalid config fails
+	// the whole import.
+	valid := true
+	for i, entry := range bundle.Configs {
+		result.Configs[i] = BucketMetadataImportStatus{Name: entry.Name, Status: importStatusValid}
+		if err := validateImportedBucketConfig(ctx, bucket, entry.Name, entry.Config); err != nil {
+			result.Configs[i].Status = importStatusInvalid
+			result.Configs[i].Error = err.Error()
+			valid = false
+		}
+	}
+
+	if valid && !dryRun {
+		result.Applied = true
+		for i, entry := range bundle.Configs {
+			updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, entry.Name, entry.Config)
+			if err != nil {
+				result.Configs[i].Status = importStatusFailed
+				result.Configs[i].Error = err.Error()
+				result.Applied = false
+				continue
+			}
+			result.Configs[i].Status = importStatusApplied
+			importBucketMetaHook(ctx, bucket, entry.Name, entry.Config, updatedAt)
+		}
+	} else if !valid {
+		for i := range result.Configs {
+			if result.Configs[i].Status == importStatusValid {
+				result.Configs[i].Status = importStatusSkipped
+			}
+		}
+	}
+
+	resp, err := json.Marshal(result)
+	if err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+		return
+	}
+
+	if dryRun {
+		// Mark the response as a simulation, unmistakably.
+		w.Header().Set(xhttp.MinIODryRun, "true")
+	}
+	writeSuccessResponseJSON(w, resp)
+}
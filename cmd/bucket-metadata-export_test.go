@@ -0,0 +1,187 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func TestBucketMetadataBundleSignature(t *testing.T) {
+	bundle := &BucketMetadataBundle{
+		Version: bucketMetadataBundleVersion,
+		Bucket:  "bucket",
+		Configs: []BucketMetadataBundleEntry{
+			{Name: bucketTaggingConfig, Config: []byte("<Tagging></Tagging>")},
+		},
+	}
+	sig, err := bundle.sign()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bundle.Signature = sig
+	if !bundle.verify() {
+		t.Fatal("expected a freshly signed bundle to verify")
+	}
+
+	// Any change to a config must invalidate the signature.
+	bundle.Configs[0].Config[1] = 't'
+	if bundle.verify() {
+		t.Fatal("expected a tampered bundle to fail verification")
+	}
+}
+
+// Wrapper for calling TestAPIBucketMetadataExportImport tests for both
+// Erasure multiple disks and single node setup.
+func TestAPIBucketMetadataExportImport(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIBucketMetadataExportImport, []string{"GetBucketMetadataExport", "PutBucketMetadataImport"})
+}
+
+// testAPIBucketMetadataExportImport - exports a bucket's metadata bundle,
+// removes the config, then dry-runs and applies the import and verifies
+// the config round-trips.
+func testAPIBucketMetadataExportImport(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	taggingCfg := []byte(`<Tagging><TagSet><Tag><Key>site</Key><Value>public</Value></Tag></TagSet></Tagging>`)
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketTaggingConfig, taggingCfg); err != nil {
+		t.Fatalf("Failed to set bucket tagging configuration: <ERROR> %v", err)
+	}
+
+	exportURL := makeTestTargetURL("", bucketName, "", url.Values{"metadata-export": {""}})
+	req, err := newTestSignedRequestV4(http.MethodGet, exportURL, 0, nil, credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for metadata export: <ERROR> %v", err)
+	}
+	rec := httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Export: expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var bundle BucketMetadataBundle
+	if err = json.Unmarshal(rec.Body.Bytes(), &bundle); err != nil {
+		t.Fatalf("Failed to unmarshal exported bundle: <ERROR> %v", err)
+	}
+	found := false
+	for _, entry := range bundle.Configs {
+		if entry.Name == bucketTaggingConfig {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected tagging config in exported bundle, got %v", bundle.Configs)
+	}
+	bundleData := rec.Body.Bytes()
+
+	// Remove the config to simulate importing into a fresh bucket.
+	if _, err = globalBucketMetadataSys.Delete(GlobalContext, bucketName, bucketTaggingConfig); err != nil {
+		t.Fatalf("Failed to delete bucket tagging configuration: <ERROR> %v", err)
+	}
+
+	importURL := makeTestTargetURL("", bucketName, "", url.Values{"metadata-import": {""}})
+
+	// A dry run reports the outcomes without storing anything.
+	req, err = newTestSignedRequestV4(http.MethodPut, importURL, int64(len(bundleData)), bytes.NewReader(bundleData), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for metadata import: <ERROR> %v", err)
+	}
+	req.Header.Set(xhttp.MinIODryRun, "true")
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Dry run import: expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get(xhttp.MinIODryRun) != "true" {
+		t.Fatalf("Dry run import: expected %s response header to be echoed", xhttp.MinIODryRun)
+	}
+	var result BucketMetadataImportResult
+	if err = json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal import result: <ERROR> %v", err)
+	}
+	if result.Applied {
+		t.Fatal("Dry run import: expected nothing to be applied")
+	}
+	for _, st := range result.Configs {
+		if st.Status != importStatusValid {
+			t.Fatalf("Dry run import: expected config %s to be %q, got %q (%s)", st.Name, importStatusValid, st.Status, st.Error)
+		}
+	}
+	if _, _, err = globalBucketMetadataSys.GetTaggingConfig(bucketName); err == nil {
+		t.Fatal("Dry run import: expected tagging config to remain absent")
+	}
+
+	// The real import applies the configs.
+	req, err = newTestSignedRequestV4(http.MethodPut, importURL, int64(len(bundleData)), bytes.NewReader(bundleData), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for metadata import: <ERROR> %v", err)
+	}
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Import: expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if err = json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal import result: <ERROR> %v", err)
+	}
+	if !result.Applied {
+		t.Fatalf("Import: expected the bundle to be applied, got %+v", result)
+	}
+	for _, st := range result.Configs {
+		if st.Status != importStatusApplied {
+			t.Fatalf("Import: expected config %s to be %q, got %q (%s)", st.Name, importStatusApplied, st.Status, st.Error)
+		}
+	}
+	tcfg, _, err := globalBucketMetadataSys.GetTaggingConfig(bucketName)
+	if err != nil {
+		t.Fatalf("Import: expected tagging config to be restored: <ERROR> %v", err)
+	}
+	if tcfg.ToMap()["site"] != "public" {
+		t.Fatalf("Import: unexpected tagging config after import: %v", tcfg.ToMap())
+	}
+
+	// A tampered bundle must be rejected outright.
+	var tamperedBundle BucketMetadataBundle
+	if err = json.Unmarshal(bundleData, &tamperedBundle); err != nil {
+		t.Fatalf("Failed to unmarshal exported bundle: <ERROR> %v", err)
+	}
+	tamperedBundle.Configs[0].Config[1] ^= 0xff
+	tampered, err := json.Marshal(tamperedBundle)
+	if err != nil {
+		t.Fatalf("Failed to marshal tampered bundle: <ERROR> %v", err)
+	}
+	req, err = newTestSignedRequestV4(http.MethodPut, importURL, int64(len(tampered)), bytes.NewReader(tampered), credentials.AccessKey, credentials.SecretKey, nil)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP request for metadata import: <ERROR> %v", err)
+	}
+	rec = httptest.NewRecorder()
+	apiRouter.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Tampered import: expected status 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !bytes.Contains(rec.Body.Bytes(), []byte("XMinioBucketMetadataBundleInvalid")) {
+		t.Fatalf("Tampered import: expected XMinioBucketMetadataBundleInvalid error, got %s", rec.Body.String())
+	}
+}
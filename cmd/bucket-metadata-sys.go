@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/minio/madmin-go/v2"
@@ -46,6 +47,57 @@ type BucketMetadataSys struct {
 
 	sync.RWMutex
 	metadataMap map[string]BucketMetadata
+
+	// Warm-up state of the initial metadata load after startup,
+	// loadedBuckets trails totalBuckets until every bucket's metadata
+	// was read once. Accessed atomically.
+	loadStarted   int32
+	totalBuckets  int32
+	loadedBuckets int32
+	// warmedUp is closed once the initial load finished.
+	warmedUp     chan struct{}
+	warmedUpOnce sync.Once
+}
+
+// bucketMetadataWarmupWait bounds how long metadata updates wait for
+// the initial load to finish before proceeding anyway.
+const bucketMetadataWarmupWait = 3 * time.Second
+
+// Initialized returns false while the initial metadata load of all
+// buckets is running. During that window a missing config cannot be
+// told apart from a not yet loaded one.
+func (sys *BucketMetadataSys) Initialized() bool {
+	if atomic.LoadInt32(&sys.loadStarted) == 0 {
+		// Initial load not started, metadata is read on demand.
+		return true
+	}
+	select {
+	case <-sys.warmedUp:
+		return true
+	default:
+		return false
+	}
+}
+
+// WarmupProgress returns how many buckets of the initial load have
+// been processed so far.
+func (sys *BucketMetadataSys) WarmupProgress() (loaded, total int) {
+	return int(atomic.LoadInt32(&sys.loadedBuckets)), int(atomic.LoadInt32(&sys.totalBuckets))
+}
+
+// waitInitialized waits up to maxWait for the initial metadata load to
+// finish, returning early when the caller's context is canceled.
+func (sys *BucketMetadataSys) waitInitialized(ctx context.Context, maxWait time.Duration) {
+	if sys.Initialized() {
+		return
+	}
+	t := time.NewTimer(maxWait)
+	defer t.Stop()
+	select {
+	case <-sys.warmedUp:
+	case <-t.C:
+	case <-ctx.Done():
+	}
 }
 
 // Count returns number of bucket metadata map entries.
@@ -62,6 +114,7 @@ func (sys *BucketMetadataSys) Remove(buckets ...string) {
 	for _, bucket := range buckets {
 		delete(sys.metadataMap, bucket)
 		globalBucketMonitor.DeleteBucket(bucket)
+		globalPolicyStatusCache.delete(bucket)
 	}
 	sys.Unlock()
 }
@@ -77,6 +130,7 @@ func (sys *BucketMetadataSys) RemoveStaleBuckets(diskBuckets set.StringSet) {
 		} // doesn't exist on disk remove from memory.
 		delete(sys.metadataMap, bucket)
 		globalBucketMonitor.DeleteBucket(bucket)
+		globalPolicyStatusCache.delete(bucket)
 	}
 }
 
@@ -103,6 +157,10 @@ func (sys *BucketMetadataSys) updateAndParse(ctx context.Context, bucket string,
 		return updatedAt, errInvalidArgument
 	}
 
+	// Updates racing the initial metadata load could operate on stale
+	// state, wait briefly for the load to finish.
+	sys.waitInitialized(ctx, bucketMetadataWarmupWait)
+
 	meta, err := loadBucketMetadataParse(ctx, objAPI, bucket, parse)
 	if err != nil {
 		if !globalIsErasure && !globalIsDistErasure && errors.Is(err, errVolumeNotFound) {
@@ -128,6 +186,36 @@ func (sys *BucketMetadataSys) updateAndParse(ctx context.Context, bucket string,
 	case bucketTaggingConfig:
 		meta.TaggingConfigXML = configData
 		meta.TaggingConfigUpdatedAt = updatedAt
+		// Record the change in the bounded history, an empty
+		// configData marks the deletion of the tag set.
+		meta.TaggingConfigHistory = append(meta.TaggingConfigHistory, TaggingHistoryEntry{
+			TaggingConfigXML: configData,
+			UpdatedAt:        updatedAt,
+		})
+		if extra := len(meta.TaggingConfigHistory) - maxTaggingHistoryEntries; extra > 0 {
+			meta.TaggingConfigHistory = append([]TaggingHistoryEntry{}, meta.TaggingConfigHistory[extra:]...)
+		}
+	case bucketDefaultTagsConfig:
+		meta.DefaultTagsConfigXML = configData
+		meta.DefaultTagsConfigUpdatedAt = updatedAt
+	case bucketNoncurrentVersionsConfig:
+		meta.NoncurrentVersionsConfigXML = configData
+		meta.NoncurrentVersionsConfigUpdatedAt = updatedAt
+	case bucketScanConfig:
+		meta.ScanConfigXML = configData
+		meta.ScanConfigUpdatedAt = updatedAt
+	case bucketMaxObjectSizeConfig:
+		meta.MaxObjectSizeConfigXML = configData
+		meta.MaxObjectSizeConfigUpdatedAt = updatedAt
+	case bucketMaxVersionsConfig:
+		meta.MaxVersionsConfigXML = configData
+		meta.MaxVersionsConfigUpdatedAt = updatedAt
+	case bucketBulkDeleteConfig:
+		meta.BulkDeleteConfigXML = configData
+		meta.BulkDeleteConfigUpdatedAt = updatedAt
+	case bucketFanOutRoutingConfig:
+		meta.FanOutRoutingConfigXML = configData
+		meta.FanOutRoutingConfigUpdatedAt = updatedAt
 	case bucketQuotaConfigFile:
 		meta.QuotaConfigJSON = configData
 		meta.QuotaConfigUpdatedAt = updatedAt
@@ -152,6 +240,10 @@ func (sys *BucketMetadataSys) updateAndParse(ctx context.Context, bucket string,
 		return updatedAt, fmt.Errorf("Unknown bucket %s metadata update requested %s", bucket, configFile)
 	}
 
+	// Bump the config epoch atomically with the update, site replication
+	// peers reject hooks that do not carry the successor of their epoch.
+	meta.ConfigEpoch++
+
 	if err := meta.Save(ctx, objAPI); err != nil {
 		return updatedAt, err
 	}
@@ -168,6 +260,26 @@ func (sys *BucketMetadataSys) Delete(ctx context.Context, bucket string, configF
 	return sys.updateAndParse(ctx, bucket, configFile, nil, false)
 }
 
+// ConfigEpoch returns the current metadata config epoch of the bucket,
+// zero when the bucket is unknown.
+func (sys *BucketMetadataSys) ConfigEpoch(bucket string) uint64 {
+	meta, err := sys.Get(bucket)
+	if err != nil {
+		return 0
+	}
+	return meta.ConfigEpoch
+}
+
+// PinnedPool returns the pool the bucket's new objects are pinned to
+// (1-based), zero when the bucket is unknown or not pinned.
+func (sys *BucketMetadataSys) PinnedPool(bucket string) int {
+	meta, err := sys.Get(bucket)
+	if err != nil {
+		return 0
+	}
+	return meta.PinnedPool
+}
+
 // Update update bucket metadata for the specified bucket.
 // The configData data should not be modified after being sent here.
 func (sys *BucketMetadataSys) Update(ctx context.Context, bucket string, configFile string, configData []byte) (updatedAt time.Time, err error) {
@@ -229,6 +341,128 @@ func (sys *BucketMetadataSys) GetTaggingConfig(bucket string) (*tags.Tags, time.
 	return meta.taggingConfig, meta.TaggingConfigUpdatedAt, nil
 }
 
+// GetTaggingConfigHistory returns the retained tagging configuration
+// changes for the bucket, oldest first.
+// The returned entries may not be modified.
+func (sys *BucketMetadataSys) GetTaggingConfigHistory(bucket string) ([]TaggingHistoryEntry, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, BucketTaggingNotFound{Bucket: bucket}
+		}
+		return nil, err
+	}
+	return meta.TaggingConfigHistory, nil
+}
+
+// GetDefaultTagsConfig returns configured bucket default object tags
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetDefaultTagsConfig(bucket string) (*DefaultTagsConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketDefaultTagsNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.defaultTagsConfig == nil {
+		return nil, time.Time{}, BucketDefaultTagsNotFound{Bucket: bucket}
+	}
+	return meta.defaultTagsConfig, meta.DefaultTagsConfigUpdatedAt, nil
+}
+
+// GetNoncurrentVersionsConfig returns the configured bucket noncurrent
+// versions limit. The returned object may not be modified.
+func (sys *BucketMetadataSys) GetNoncurrentVersionsConfig(bucket string) (*NoncurrentVersionsConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketNoncurrentVersionsConfigNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.noncurrentVersionsConfig == nil {
+		return nil, time.Time{}, BucketNoncurrentVersionsConfigNotFound{Bucket: bucket}
+	}
+	return meta.noncurrentVersionsConfig, meta.NoncurrentVersionsConfigUpdatedAt, nil
+}
+
+// GetScanConfig returns the configured bucket scanning webhook settings.
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetScanConfig(bucket string) (*ScanConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketScanConfigNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.scanConfig == nil {
+		return nil, time.Time{}, BucketScanConfigNotFound{Bucket: bucket}
+	}
+	return meta.scanConfig, meta.ScanConfigUpdatedAt, nil
+}
+
+// GetMaxObjectSizeConfig returns configured maximum object size for this bucket.
+func (sys *BucketMetadataSys) GetMaxObjectSizeConfig(bucket string) (*MaxObjectSizeConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketMaxObjectSizeConfigNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.maxObjectSizeConfig == nil {
+		return nil, time.Time{}, BucketMaxObjectSizeConfigNotFound{Bucket: bucket}
+	}
+	return meta.maxObjectSizeConfig, meta.MaxObjectSizeConfigUpdatedAt, nil
+}
+
+// GetMaxVersionsConfig returns configured maximum versions per object for this bucket.
+func (sys *BucketMetadataSys) GetMaxVersionsConfig(bucket string) (*MaxVersionsConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketMaxVersionsConfigNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.maxVersionsConfig == nil {
+		return nil, time.Time{}, BucketMaxVersionsConfigNotFound{Bucket: bucket}
+	}
+	return meta.maxVersionsConfig, meta.MaxVersionsConfigUpdatedAt, nil
+}
+
+// GetBulkDeleteConfig returns the configured bulk delete setting for this bucket.
+func (sys *BucketMetadataSys) GetBulkDeleteConfig(bucket string) (*BulkDeleteConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketBulkDeleteConfigNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.bulkDeleteConfig == nil {
+		return nil, time.Time{}, BucketBulkDeleteConfigNotFound{Bucket: bucket}
+	}
+	return meta.bulkDeleteConfig, meta.BulkDeleteConfigUpdatedAt, nil
+}
+
+// GetFanOutRoutingConfig returns the configured fan-out routing rules for this bucket.
+func (sys *BucketMetadataSys) GetFanOutRoutingConfig(bucket string) (*FanOutRoutingConfig, time.Time, error) {
+	meta, _, err := sys.GetConfig(GlobalContext, bucket)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil, time.Time{}, BucketFanOutRoutingConfigNotFound{Bucket: bucket}
+		}
+		return nil, time.Time{}, err
+	}
+	if meta.fanOutRoutingConfig == nil {
+		return nil, time.Time{}, BucketFanOutRoutingConfigNotFound{Bucket: bucket}
+	}
+	return meta.fanOutRoutingConfig, meta.FanOutRoutingConfigUpdatedAt, nil
+}
+
 // GetObjectLockConfig returns configured object lock config
 // The returned object may not be modified.
 func (sys *BucketMetadataSys) GetObjectLockConfig(bucket string) (*objectlock.Config, time.Time, error) {
@@ -466,6 +700,10 @@ func (sys *BucketMetadataSys) concurrentLoad(ctx context.Context, buckets []Buck
 		}
 	}
 
+	// Failed buckets count as processed too, the warm-up would never
+	// finish otherwise.
+	atomic.AddInt32(&sys.loadedBuckets, int32(len(buckets)))
+
 	// Hold lock here to update in-memory map at once,
 	// instead of serializing the Go routines.
 	sys.Lock()
@@ -528,6 +766,9 @@ func (sys *BucketMetadataSys) refreshBucketsMetadataLoop(ctx context.Context) {
 
 // Loads bucket metadata for all buckets into BucketMetadataSys.
 func (sys *BucketMetadataSys) init(ctx context.Context, buckets []BucketInfo) {
+	atomic.StoreInt32(&sys.totalBuckets, int32(len(buckets)))
+	atomic.StoreInt32(&sys.loadStarted, 1)
+
 	count := 100 // load 100 bucket metadata at a time.
 	for {
 		if len(buckets) < count {
@@ -538,6 +779,10 @@ func (sys *BucketMetadataSys) init(ctx context.Context, buckets []BucketInfo) {
 		buckets = buckets[count:]
 	}
 
+	sys.warmedUpOnce.Do(func() {
+		close(sys.warmedUp)
+	})
+
 	if globalIsDistErasure {
 		go sys.refreshBucketsMetadataLoop(ctx)
 	}
@@ -556,5 +801,6 @@ func (sys *BucketMetadataSys) Reset() {
 func NewBucketMetadataSys() *BucketMetadataSys {
 	return &BucketMetadataSys{
 		metadataMap: make(map[string]BucketMetadata),
+		warmedUp:    make(chan struct{}),
 	}
 }
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBucketMetadataSysWarmup(t *testing.T) {
+	sys := NewBucketMetadataSys()
+
+	// Initial load not started, metadata is read on demand and the
+	// subsystem must not report itself as warming.
+	if !sys.Initialized() {
+		t.Fatal("expected Initialized() before the initial load starts")
+	}
+
+	// Simulate the initial load being underway.
+	atomic.StoreInt32(&sys.loadStarted, 1)
+	atomic.StoreInt32(&sys.totalBuckets, 10)
+	atomic.StoreInt32(&sys.loadedBuckets, 4)
+
+	if sys.Initialized() {
+		t.Fatal("expected !Initialized() while the initial load is running")
+	}
+	if loaded, total := sys.WarmupProgress(); loaded != 4 || total != 10 {
+		t.Fatalf("unexpected progress %d/%d, expected 4/10", loaded, total)
+	}
+
+	// waitInitialized must respect its bound while warming.
+	start := time.Now()
+	sys.waitInitialized(context.Background(), 10*time.Millisecond)
+	if waited := time.Since(start); waited > time.Second {
+		t.Fatalf("waitInitialized exceeded its bound, waited %v", waited)
+	}
+
+	// Finishing the load must unblock waiters and flip Initialized.
+	sys.warmedUpOnce.Do(func() {
+		close(sys.warmedUp)
+	})
+	if !sys.Initialized() {
+		t.Fatal("expected Initialized() after the initial load finished")
+	}
+	start = time.Now()
+	sys.waitInitialized(context.Background(), time.Minute)
+	if waited := time.Since(start); waited > time.Second {
+		t.Fatalf("waitInitialized blocked after warm-up, waited %v", waited)
+	}
+}
@@ -52,6 +52,10 @@ const (
 	bucketMetadataFile    = ".metadata.bin"
 	bucketMetadataFormat  = 1
 	bucketMetadataVersion = 1
+
+	// maxTaggingHistoryEntries is the number of tagging configuration
+	// changes retained per bucket for auditing.
+	maxTaggingHistoryEntries = 10
 )
 
 var (
@@ -67,41 +71,84 @@ var (
 // bucketMetadataFormat refers to the format.
 // bucketMetadataVersion can be used to track a rolling upgrade of a field.
 type BucketMetadata struct {
-	Name                        string
-	Created                     time.Time
-	LockEnabled                 bool // legacy not used anymore.
-	PolicyConfigJSON            []byte
-	NotificationConfigXML       []byte
-	LifecycleConfigXML          []byte
-	ObjectLockConfigXML         []byte
-	VersioningConfigXML         []byte
-	EncryptionConfigXML         []byte
-	TaggingConfigXML            []byte
-	QuotaConfigJSON             []byte
-	ReplicationConfigXML        []byte
-	BucketTargetsConfigJSON     []byte
-	BucketTargetsConfigMetaJSON []byte
-	PolicyConfigUpdatedAt       time.Time
-	ObjectLockConfigUpdatedAt   time.Time
-	EncryptionConfigUpdatedAt   time.Time
-	TaggingConfigUpdatedAt      time.Time
-	QuotaConfigUpdatedAt        time.Time
-	ReplicationConfigUpdatedAt  time.Time
-	VersioningConfigUpdatedAt   time.Time
-	LifecycleConfigUpdatedAt    time.Time
+	Name                              string
+	Created                           time.Time
+	LockEnabled                       bool // legacy not used anymore.
+	PolicyConfigJSON                  []byte
+	NotificationConfigXML             []byte
+	LifecycleConfigXML                []byte
+	ObjectLockConfigXML               []byte
+	VersioningConfigXML               []byte
+	EncryptionConfigXML               []byte
+	TaggingConfigXML                  []byte
+	DefaultTagsConfigXML              []byte
+	NoncurrentVersionsConfigXML       []byte
+	ScanConfigXML                     []byte
+	MaxObjectSizeConfigXML            []byte
+	MaxVersionsConfigXML              []byte
+	BulkDeleteConfigXML               []byte
+	FanOutRoutingConfigXML            []byte
+	QuotaConfigJSON                   []byte
+	ReplicationConfigXML              []byte
+	BucketTargetsConfigJSON           []byte
+	BucketTargetsConfigMetaJSON       []byte
+	PolicyConfigUpdatedAt             time.Time
+	ObjectLockConfigUpdatedAt         time.Time
+	EncryptionConfigUpdatedAt         time.Time
+	TaggingConfigUpdatedAt            time.Time
+	DefaultTagsConfigUpdatedAt        time.Time
+	NoncurrentVersionsConfigUpdatedAt time.Time
+	ScanConfigUpdatedAt               time.Time
+	MaxObjectSizeConfigUpdatedAt      time.Time
+	MaxVersionsConfigUpdatedAt        time.Time
+	BulkDeleteConfigUpdatedAt         time.Time
+	FanOutRoutingConfigUpdatedAt      time.Time
+	QuotaConfigUpdatedAt              time.Time
+	ReplicationConfigUpdatedAt        time.Time
+	VersioningConfigUpdatedAt         time.Time
+	LifecycleConfigUpdatedAt          time.Time
+
+	// TaggingConfigHistory holds the most recent tagging configuration
+	// changes, oldest first, bounded to maxTaggingHistoryEntries. The
+	// last entry mirrors the current TaggingConfigXML.
+	TaggingConfigHistory []TaggingHistoryEntry
+
+	// ConfigEpoch is a monotonic counter bumped on every metadata config
+	// update, used by site replication to detect concurrent conflicting
+	// updates from different sites without relying on wall clocks.
+	ConfigEpoch uint64
+
+	// PinnedPool pins the bucket's new objects to a server pool (1-based,
+	// in command line order), set through a "pool:N" location constraint
+	// at bucket creation. Zero means no pinning.
+	PinnedPool int
 
 	// Unexported fields. Must be updated atomically.
-	policyConfig           *policy.Policy
-	notificationConfig     *event.Config
-	lifecycleConfig        *lifecycle.Lifecycle
-	objectLockConfig       *objectlock.Config
-	versioningConfig       *versioning.Versioning
-	sseConfig              *bucketsse.BucketSSEConfig
-	taggingConfig          *tags.Tags
-	quotaConfig            *madmin.BucketQuota
-	replicationConfig      *replication.Config
-	bucketTargetConfig     *madmin.BucketTargets
-	bucketTargetConfigMeta map[string]string
+	policyConfig             *policy.Policy
+	notificationConfig       *event.Config
+	lifecycleConfig          *lifecycle.Lifecycle
+	objectLockConfig         *objectlock.Config
+	versioningConfig         *versioning.Versioning
+	sseConfig                *bucketsse.BucketSSEConfig
+	taggingConfig            *tags.Tags
+	defaultTagsConfig        *DefaultTagsConfig
+	noncurrentVersionsConfig *NoncurrentVersionsConfig
+	scanConfig               *ScanConfig
+	maxObjectSizeConfig      *MaxObjectSizeConfig
+	maxVersionsConfig        *MaxVersionsConfig
+	bulkDeleteConfig         *BulkDeleteConfig
+	fanOutRoutingConfig      *FanOutRoutingConfig
+	quotaConfig              *madmin.BucketQuota
+	replicationConfig        *replication.Config
+	bucketTargetConfig       *madmin.BucketTargets
+	bucketTargetConfigMeta   map[string]string
+}
+
+// TaggingHistoryEntry records one tagging configuration change on a
+// bucket. An empty TaggingConfigXML marks the deletion of the tag set.
+type TaggingHistoryEntry struct {
+	TaggingConfigXML []byte
+	UpdatedAt        time.Time
 }
 
 // newBucketMetadata creates BucketMetadata with the supplied name and Created to Now.
@@ -259,6 +306,69 @@ func (b *BucketMetadata) parseAllConfigs(ctx context.Context, objectAPI ObjectLa
 		b.taggingConfig = nil
 	}
 
+	if len(b.DefaultTagsConfigXML) != 0 {
+		b.defaultTagsConfig, err = parseBucketDefaultTagsConfig(bytes.NewReader(b.DefaultTagsConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.defaultTagsConfig = nil
+	}
+
+	if len(b.NoncurrentVersionsConfigXML) != 0 {
+		b.noncurrentVersionsConfig, err = parseNoncurrentVersionsConfig(bytes.NewReader(b.NoncurrentVersionsConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.noncurrentVersionsConfig = nil
+	}
+
+	if len(b.ScanConfigXML) != 0 {
+		b.scanConfig, err = parseBucketScanConfig(bytes.NewReader(b.ScanConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.scanConfig = nil
+	}
+
+	if len(b.MaxObjectSizeConfigXML) != 0 {
+		b.maxObjectSizeConfig, err = parseBucketMaxObjectSizeConfig(bytes.NewReader(b.MaxObjectSizeConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.maxObjectSizeConfig = nil
+	}
+
+	if len(b.MaxVersionsConfigXML) != 0 {
+		b.maxVersionsConfig, err = parseBucketMaxVersionsConfig(bytes.NewReader(b.MaxVersionsConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.maxVersionsConfig = nil
+	}
+
+	if len(b.BulkDeleteConfigXML) != 0 {
+		b.bulkDeleteConfig, err = parseBucketBulkDeleteConfig(bytes.NewReader(b.BulkDeleteConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.bulkDeleteConfig = nil
+	}
+
+	if len(b.FanOutRoutingConfigXML) != 0 {
+		b.fanOutRoutingConfig, err = parseBucketFanOutRoutingConfig(bytes.NewReader(b.FanOutRoutingConfigXML))
+		if err != nil {
+			return err
+		}
+	} else {
+		b.fanOutRoutingConfig = nil
+	}
+
 	if bytes.Equal(b.ObjectLockConfigXML, enabledBucketObjectLockConfig) {
 		b.VersioningConfigXML = enabledBucketVersioningConfig
 	}
@@ -413,6 +523,14 @@ func (b *BucketMetadata) defaultTimestamps() {
 		b.TaggingConfigUpdatedAt = b.Created
 	}
 
+	if b.DefaultTagsConfigUpdatedAt.IsZero() {
+		b.DefaultTagsConfigUpdatedAt = b.Created
+	}
+
+	if b.NoncurrentVersionsConfigUpdatedAt.IsZero() {
+		b.NoncurrentVersionsConfigUpdatedAt = b.Created
+	}
+
 	if b.ObjectLockConfigUpdatedAt.IsZero() {
 		b.ObjectLockConfigUpdatedAt = b.Created
 	}
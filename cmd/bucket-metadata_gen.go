@@ -84,6 +84,48 @@ func (z *BucketMetadata) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "TaggingConfigXML")
 				return
 			}
+		case "DefaultTagsConfigXML":
+			z.DefaultTagsConfigXML, err = dc.ReadBytes(z.DefaultTagsConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "DefaultTagsConfigXML")
+				return
+			}
+		case "NoncurrentVersionsConfigXML":
+			z.NoncurrentVersionsConfigXML, err = dc.ReadBytes(z.NoncurrentVersionsConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "NoncurrentVersionsConfigXML")
+				return
+			}
+		case "ScanConfigXML":
+			z.ScanConfigXML, err = dc.ReadBytes(z.ScanConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "ScanConfigXML")
+				return
+			}
+		case "MaxObjectSizeConfigXML":
+			z.MaxObjectSizeConfigXML, err = dc.ReadBytes(z.MaxObjectSizeConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxObjectSizeConfigXML")
+				return
+			}
+		case "MaxVersionsConfigXML":
+			z.MaxVersionsConfigXML, err = dc.ReadBytes(z.MaxVersionsConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxVersionsConfigXML")
+				return
+			}
+		case "BulkDeleteConfigXML":
+			z.BulkDeleteConfigXML, err = dc.ReadBytes(z.BulkDeleteConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "BulkDeleteConfigXML")
+				return
+			}
+		case "FanOutRoutingConfigXML":
+			z.FanOutRoutingConfigXML, err = dc.ReadBytes(z.FanOutRoutingConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "FanOutRoutingConfigXML")
+				return
+			}
 		case "QuotaConfigJSON":
 			z.QuotaConfigJSON, err = dc.ReadBytes(z.QuotaConfigJSON)
 			if err != nil {
@@ -132,6 +174,48 @@ func (z *BucketMetadata) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "TaggingConfigUpdatedAt")
 				return
 			}
+		case "DefaultTagsConfigUpdatedAt":
+			z.DefaultTagsConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "DefaultTagsConfigUpdatedAt")
+				return
+			}
+		case "NoncurrentVersionsConfigUpdatedAt":
+			z.NoncurrentVersionsConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "NoncurrentVersionsConfigUpdatedAt")
+				return
+			}
+		case "ScanConfigUpdatedAt":
+			z.ScanConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "ScanConfigUpdatedAt")
+				return
+			}
+		case "MaxObjectSizeConfigUpdatedAt":
+			z.MaxObjectSizeConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "MaxObjectSizeConfigUpdatedAt")
+				return
+			}
+		case "MaxVersionsConfigUpdatedAt":
+			z.MaxVersionsConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "MaxVersionsConfigUpdatedAt")
+				return
+			}
+		case "BulkDeleteConfigUpdatedAt":
+			z.BulkDeleteConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "BulkDeleteConfigUpdatedAt")
+				return
+			}
+		case "FanOutRoutingConfigUpdatedAt":
+			z.FanOutRoutingConfigUpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "FanOutRoutingConfigUpdatedAt")
+				return
+			}
 		case "QuotaConfigUpdatedAt":
 			z.QuotaConfigUpdatedAt, err = dc.ReadTime()
 			if err != nil {
@@ -156,6 +240,66 @@ func (z *BucketMetadata) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "LifecycleConfigUpdatedAt")
 				return
 			}
+		case "TaggingConfigHistory":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "TaggingConfigHistory")
+				return
+			}
+			if cap(z.TaggingConfigHistory) >= int(zb0002) {
+				z.TaggingConfigHistory = (z.TaggingConfigHistory)[:zb0002]
+			} else {
+				z.TaggingConfigHistory = make([]TaggingHistoryEntry, zb0002)
+			}
+			for za0001 := range z.TaggingConfigHistory {
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "TaggingConfigHistory", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "TaggingConfigHistory", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "TaggingConfigXML":
+						z.TaggingConfigHistory[za0001].TaggingConfigXML, err = dc.ReadBytes(z.TaggingConfigHistory[za0001].TaggingConfigXML)
+						if err != nil {
+							err = msgp.WrapError(err, "TaggingConfigHistory", za0001, "TaggingConfigXML")
+							return
+						}
+					case "UpdatedAt":
+						z.TaggingConfigHistory[za0001].UpdatedAt, err = dc.ReadTime()
+						if err != nil {
+							err = msgp.WrapError(err, "TaggingConfigHistory", za0001, "UpdatedAt")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "TaggingConfigHistory", za0001)
+							return
+						}
+					}
+				}
+			}
+		case "ConfigEpoch":
+			z.ConfigEpoch, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ConfigEpoch")
+				return
+			}
+		case "PinnedPool":
+			z.PinnedPool, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "PinnedPool")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -169,9 +313,9 @@ func (z *BucketMetadata) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *BucketMetadata) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 22
+	// map header, size 39
 	// write "Name"
-	err = en.Append(0xde, 0x0, 0x16, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	err = en.Append(0xde, 0x0, 0x27, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
 	if err != nil {
 		return
 	}
@@ -270,6 +414,76 @@ func (z *BucketMetadata) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "TaggingConfigXML")
 		return
 	}
+	// write "DefaultTagsConfigXML"
+	err = en.Append(0xb4, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.DefaultTagsConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "DefaultTagsConfigXML")
+		return
+	}
+	// write "NoncurrentVersionsConfigXML"
+	err = en.Append(0xbb, 0x4e, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.NoncurrentVersionsConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "NoncurrentVersionsConfigXML")
+		return
+	}
+	// write "ScanConfigXML"
+	err = en.Append(0xad, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.ScanConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "ScanConfigXML")
+		return
+	}
+	// write "MaxObjectSizeConfigXML"
+	err = en.Append(0xb6, 0x4d, 0x61, 0x78, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.MaxObjectSizeConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "MaxObjectSizeConfigXML")
+		return
+	}
+	// write "MaxVersionsConfigXML"
+	err = en.Append(0xb4, 0x4d, 0x61, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.MaxVersionsConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "MaxVersionsConfigXML")
+		return
+	}
+	// write "BulkDeleteConfigXML"
+	err = en.Append(0xb3, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.BulkDeleteConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "BulkDeleteConfigXML")
+		return
+	}
+	// write "FanOutRoutingConfigXML"
+	err = en.Append(0xb6, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.FanOutRoutingConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "FanOutRoutingConfigXML")
+		return
+	}
 	// write "QuotaConfigJSON"
 	err = en.Append(0xaf, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
 	if err != nil {
@@ -350,6 +564,76 @@ func (z *BucketMetadata) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "TaggingConfigUpdatedAt")
 		return
 	}
+	// write "DefaultTagsConfigUpdatedAt"
+	err = en.Append(0xba, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.DefaultTagsConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "DefaultTagsConfigUpdatedAt")
+		return
+	}
+	// write "NoncurrentVersionsConfigUpdatedAt"
+	err = en.Append(0xd9, 0x21, 0x4e, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.NoncurrentVersionsConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "NoncurrentVersionsConfigUpdatedAt")
+		return
+	}
+	// write "ScanConfigUpdatedAt"
+	err = en.Append(0xb3, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.ScanConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "ScanConfigUpdatedAt")
+		return
+	}
+	// write "MaxObjectSizeConfigUpdatedAt"
+	err = en.Append(0xbc, 0x4d, 0x61, 0x78, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.MaxObjectSizeConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "MaxObjectSizeConfigUpdatedAt")
+		return
+	}
+	// write "MaxVersionsConfigUpdatedAt"
+	err = en.Append(0xba, 0x4d, 0x61, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.MaxVersionsConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "MaxVersionsConfigUpdatedAt")
+		return
+	}
+	// write "BulkDeleteConfigUpdatedAt"
+	err = en.Append(0xb9, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.BulkDeleteConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "BulkDeleteConfigUpdatedAt")
+		return
+	}
+	// write "FanOutRoutingConfigUpdatedAt"
+	err = en.Append(0xbc, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.FanOutRoutingConfigUpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "FanOutRoutingConfigUpdatedAt")
+		return
+	}
 	// write "QuotaConfigUpdatedAt"
 	err = en.Append(0xb4, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
 	if err != nil {
@@ -390,15 +674,68 @@ func (z *BucketMetadata) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "LifecycleConfigUpdatedAt")
 		return
 	}
+	// write "TaggingConfigHistory"
+	err = en.Append(0xb4, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.TaggingConfigHistory)))
+	if err != nil {
+		err = msgp.WrapError(err, "TaggingConfigHistory")
+		return
+	}
+	for za0001 := range z.TaggingConfigHistory {
+		// map header, size 2
+		// write "TaggingConfigXML"
+		err = en.Append(0x82, 0xb0, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+		if err != nil {
+			return
+		}
+		err = en.WriteBytes(z.TaggingConfigHistory[za0001].TaggingConfigXML)
+		if err != nil {
+			err = msgp.WrapError(err, "TaggingConfigHistory", za0001, "TaggingConfigXML")
+			return
+		}
+		// write "UpdatedAt"
+		err = en.Append(0xa9, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteTime(z.TaggingConfigHistory[za0001].UpdatedAt)
+		if err != nil {
+			err = msgp.WrapError(err, "TaggingConfigHistory", za0001, "UpdatedAt")
+			return
+		}
+	}
+	// write "ConfigEpoch"
+	err = en.Append(0xab, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ConfigEpoch)
+	if err != nil {
+		err = msgp.WrapError(err, "ConfigEpoch")
+		return
+	}
+	// write "PinnedPool"
+	err = en.Append(0xaa, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.PinnedPool)
+	if err != nil {
+		err = msgp.WrapError(err, "PinnedPool")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *BucketMetadata) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 22
+	// map header, size 39
 	// string "Name"
-	o = append(o, 0xde, 0x0, 0x16, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = append(o, 0xde, 0x0, 0x27, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
 	o = msgp.AppendString(o, z.Name)
 	// string "Created"
 	o = append(o, 0xa7, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64)
@@ -427,6 +764,27 @@ func (z *BucketMetadata) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "TaggingConfigXML"
 	o = append(o, 0xb0, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
 	o = msgp.AppendBytes(o, z.TaggingConfigXML)
+	// string "DefaultTagsConfigXML"
+	o = append(o, 0xb4, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.DefaultTagsConfigXML)
+	// string "NoncurrentVersionsConfigXML"
+	o = append(o, 0xbb, 0x4e, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.NoncurrentVersionsConfigXML)
+	// string "ScanConfigXML"
+	o = append(o, 0xad, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.ScanConfigXML)
+	// string "MaxObjectSizeConfigXML"
+	o = append(o, 0xb6, 0x4d, 0x61, 0x78, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.MaxObjectSizeConfigXML)
+	// string "MaxVersionsConfigXML"
+	o = append(o, 0xb4, 0x4d, 0x61, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.MaxVersionsConfigXML)
+	// string "BulkDeleteConfigXML"
+	o = append(o, 0xb3, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.BulkDeleteConfigXML)
+	// string "FanOutRoutingConfigXML"
+	o = append(o, 0xb6, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.FanOutRoutingConfigXML)
 	// string "QuotaConfigJSON"
 	o = append(o, 0xaf, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x53, 0x4f, 0x4e)
 	o = msgp.AppendBytes(o, z.QuotaConfigJSON)
@@ -451,6 +809,27 @@ func (z *BucketMetadata) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "TaggingConfigUpdatedAt"
 	o = append(o, 0xb6, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
 	o = msgp.AppendTime(o, z.TaggingConfigUpdatedAt)
+	// string "DefaultTagsConfigUpdatedAt"
+	o = append(o, 0xba, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.DefaultTagsConfigUpdatedAt)
+	// string "NoncurrentVersionsConfigUpdatedAt"
+	o = append(o, 0xd9, 0x21, 0x4e, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.NoncurrentVersionsConfigUpdatedAt)
+	// string "ScanConfigUpdatedAt"
+	o = append(o, 0xb3, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.ScanConfigUpdatedAt)
+	// string "MaxObjectSizeConfigUpdatedAt"
+	o = append(o, 0xbc, 0x4d, 0x61, 0x78, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.MaxObjectSizeConfigUpdatedAt)
+	// string "MaxVersionsConfigUpdatedAt"
+	o = append(o, 0xba, 0x4d, 0x61, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.MaxVersionsConfigUpdatedAt)
+	// string "BulkDeleteConfigUpdatedAt"
+	o = append(o, 0xb9, 0x42, 0x75, 0x6c, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.BulkDeleteConfigUpdatedAt)
+	// string "FanOutRoutingConfigUpdatedAt"
+	o = append(o, 0xbc, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.FanOutRoutingConfigUpdatedAt)
 	// string "QuotaConfigUpdatedAt"
 	o = append(o, 0xb4, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
 	o = msgp.AppendTime(o, z.QuotaConfigUpdatedAt)
@@ -463,6 +842,24 @@ func (z *BucketMetadata) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "LifecycleConfigUpdatedAt"
 	o = append(o, 0xb8, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
 	o = msgp.AppendTime(o, z.LifecycleConfigUpdatedAt)
+	// string "TaggingConfigHistory"
+	o = append(o, 0xb4, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.TaggingConfigHistory)))
+	for za0001 := range z.TaggingConfigHistory {
+		// map header, size 2
+		// string "TaggingConfigXML"
+		o = append(o, 0x82, 0xb0, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+		o = msgp.AppendBytes(o, z.TaggingConfigHistory[za0001].TaggingConfigXML)
+		// string "UpdatedAt"
+		o = append(o, 0xa9, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+		o = msgp.AppendTime(o, z.TaggingConfigHistory[za0001].UpdatedAt)
+	}
+	// string "ConfigEpoch"
+	o = append(o, 0xab, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68)
+	o = msgp.AppendUint64(o, z.ConfigEpoch)
+	// string "PinnedPool"
+	o = append(o, 0xaa, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c)
+	o = msgp.AppendInt(o, z.PinnedPool)
 	return
 }
 
@@ -544,6 +941,48 @@ func (z *BucketMetadata) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "TaggingConfigXML")
 				return
 			}
+		case "DefaultTagsConfigXML":
+			z.DefaultTagsConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.DefaultTagsConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "DefaultTagsConfigXML")
+				return
+			}
+		case "NoncurrentVersionsConfigXML":
+			z.NoncurrentVersionsConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.NoncurrentVersionsConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "NoncurrentVersionsConfigXML")
+				return
+			}
+		case "ScanConfigXML":
+			z.ScanConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.ScanConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "ScanConfigXML")
+				return
+			}
+		case "MaxObjectSizeConfigXML":
+			z.MaxObjectSizeConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.MaxObjectSizeConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxObjectSizeConfigXML")
+				return
+			}
+		case "MaxVersionsConfigXML":
+			z.MaxVersionsConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.MaxVersionsConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxVersionsConfigXML")
+				return
+			}
+		case "BulkDeleteConfigXML":
+			z.BulkDeleteConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.BulkDeleteConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "BulkDeleteConfigXML")
+				return
+			}
+		case "FanOutRoutingConfigXML":
+			z.FanOutRoutingConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.FanOutRoutingConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "FanOutRoutingConfigXML")
+				return
+			}
 		case "QuotaConfigJSON":
 			z.QuotaConfigJSON, bts, err = msgp.ReadBytesBytes(bts, z.QuotaConfigJSON)
 			if err != nil {
@@ -592,6 +1031,48 @@ func (z *BucketMetadata) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "TaggingConfigUpdatedAt")
 				return
 			}
+		case "DefaultTagsConfigUpdatedAt":
+			z.DefaultTagsConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DefaultTagsConfigUpdatedAt")
+				return
+			}
+		case "NoncurrentVersionsConfigUpdatedAt":
+			z.NoncurrentVersionsConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NoncurrentVersionsConfigUpdatedAt")
+				return
+			}
+		case "ScanConfigUpdatedAt":
+			z.ScanConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ScanConfigUpdatedAt")
+				return
+			}
+		case "MaxObjectSizeConfigUpdatedAt":
+			z.MaxObjectSizeConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxObjectSizeConfigUpdatedAt")
+				return
+			}
+		case "MaxVersionsConfigUpdatedAt":
+			z.MaxVersionsConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxVersionsConfigUpdatedAt")
+				return
+			}
+		case "BulkDeleteConfigUpdatedAt":
+			z.BulkDeleteConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BulkDeleteConfigUpdatedAt")
+				return
+			}
+		case "FanOutRoutingConfigUpdatedAt":
+			z.FanOutRoutingConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FanOutRoutingConfigUpdatedAt")
+				return
+			}
 		case "QuotaConfigUpdatedAt":
 			z.QuotaConfigUpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
 			if err != nil {
@@ -616,6 +1097,66 @@ func (z *BucketMetadata) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "LifecycleConfigUpdatedAt")
 				return
 			}
+		case "TaggingConfigHistory":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TaggingConfigHistory")
+				return
+			}
+			if cap(z.TaggingConfigHistory) >= int(zb0002) {
+				z.TaggingConfigHistory = (z.TaggingConfigHistory)[:zb0002]
+			} else {
+				z.TaggingConfigHistory = make([]TaggingHistoryEntry, zb0002)
+			}
+			for za0001 := range z.TaggingConfigHistory {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TaggingConfigHistory", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "TaggingConfigHistory", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "TaggingConfigXML":
+						z.TaggingConfigHistory[za0001].TaggingConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.TaggingConfigHistory[za0001].TaggingConfigXML)
+						if err != nil {
+							err = msgp.WrapError(err, "TaggingConfigHistory", za0001, "TaggingConfigXML")
+							return
+						}
+					case "UpdatedAt":
+						z.TaggingConfigHistory[za0001].UpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "TaggingConfigHistory", za0001, "UpdatedAt")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "TaggingConfigHistory", za0001)
+							return
+						}
+					}
+				}
+			}
+		case "ConfigEpoch":
+			z.ConfigEpoch, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ConfigEpoch")
+				return
+			}
+		case "PinnedPool":
+			z.PinnedPool, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PinnedPool")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -630,6 +1171,138 @@ func (z *BucketMetadata) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *BucketMetadata) Msgsize() (s int) {
-	s = 3 + 5 + msgp.StringPrefixSize + len(z.Name) + 8 + msgp.TimeSize + 12 + msgp.BoolSize + 17 + msgp.BytesPrefixSize + len(z.PolicyConfigJSON) + 22 + msgp.BytesPrefixSize + len(z.NotificationConfigXML) + 19 + msgp.BytesPrefixSize + len(z.LifecycleConfigXML) + 20 + msgp.BytesPrefixSize + len(z.ObjectLockConfigXML) + 20 + msgp.BytesPrefixSize + len(z.VersioningConfigXML) + 20 + msgp.BytesPrefixSize + len(z.EncryptionConfigXML) + 17 + msgp.BytesPrefixSize + len(z.TaggingConfigXML) + 16 + msgp.BytesPrefixSize + len(z.QuotaConfigJSON) + 21 + msgp.BytesPrefixSize + len(z.ReplicationConfigXML) + 24 + msgp.BytesPrefixSize + len(z.BucketTargetsConfigJSON) + 28 + msgp.BytesPrefixSize + len(z.BucketTargetsConfigMetaJSON) + 22 + msgp.TimeSize + 26 + msgp.TimeSize + 26 + msgp.TimeSize + 23 + msgp.TimeSize + 21 + msgp.TimeSize + 27 + msgp.TimeSize + 26 + msgp.TimeSize + 25 + msgp.TimeSize
+	s = 3 + 5 + msgp.StringPrefixSize + len(z.Name) + 8 + msgp.TimeSize + 12 + msgp.BoolSize + 17 + msgp.BytesPrefixSize + len(z.PolicyConfigJSON) + 22 + msgp.BytesPrefixSize + len(z.NotificationConfigXML) + 19 + msgp.BytesPrefixSize + len(z.LifecycleConfigXML) + 20 + msgp.BytesPrefixSize + len(z.ObjectLockConfigXML) + 20 + msgp.BytesPrefixSize + len(z.VersioningConfigXML) + 20 + msgp.BytesPrefixSize + len(z.EncryptionConfigXML) + 17 + msgp.BytesPrefixSize + len(z.TaggingConfigXML) + 21 + msgp.BytesPrefixSize + len(z.DefaultTagsConfigXML) + 28 + msgp.BytesPrefixSize + len(z.NoncurrentVersionsConfigXML) + 14 + msgp.BytesPrefixSize + len(z.ScanConfigXML) + 23 + msgp.BytesPrefixSize + len(z.MaxObjectSizeConfigXML) + 21 + msgp.BytesPrefixSize + len(z.MaxVersionsConfigXML) + 20 + msgp.BytesPrefixSize + len(z.BulkDeleteConfigXML) + 23 + msgp.BytesPrefixSize + len(z.FanOutRoutingConfigXML) + 16 + msgp.BytesPrefixSize + len(z.QuotaConfigJSON) + 21 + msgp.BytesPrefixSize + len(z.ReplicationConfigXML) + 24 + msgp.BytesPrefixSize + len(z.BucketTargetsConfigJSON) + 28 + msgp.BytesPrefixSize + len(z.BucketTargetsConfigMetaJSON) + 22 + msgp.TimeSize + 26 + msgp.TimeSize + 26 + msgp.TimeSize + 23 + msgp.TimeSize + 27 + msgp.TimeSize + 35 + msgp.TimeSize + 20 + msgp.TimeSize + 29 + msgp.TimeSize + 27 + msgp.TimeSize + 26 + msgp.TimeSize + 29 + msgp.TimeSize + 21 + msgp.TimeSize + 27 + msgp.TimeSize + 26 + msgp.TimeSize + 25 + msgp.TimeSize + 21 + msgp.ArrayHeaderSize
+	for za0001 := range z.TaggingConfigHistory {
+		s += 1 + 17 + msgp.BytesPrefixSize + len(z.TaggingConfigHistory[za0001].TaggingConfigXML) + 10 + msgp.TimeSize
+	}
+	s += 12 + msgp.Uint64Size + 11 + msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *TaggingHistoryEntry) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "TaggingConfigXML":
+			z.TaggingConfigXML, err = dc.ReadBytes(z.TaggingConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "TaggingConfigXML")
+				return
+			}
+		case "UpdatedAt":
+			z.UpdatedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "UpdatedAt")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *TaggingHistoryEntry) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "TaggingConfigXML"
+	err = en.Append(0x82, 0xb0, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.TaggingConfigXML)
+	if err != nil {
+		err = msgp.WrapError(err, "TaggingConfigXML")
+		return
+	}
+	// write "UpdatedAt"
+	err = en.Append(0xa9, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.UpdatedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "UpdatedAt")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *TaggingHistoryEntry) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "TaggingConfigXML"
+	o = append(o, 0x82, 0xb0, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x58, 0x4d, 0x4c)
+	o = msgp.AppendBytes(o, z.TaggingConfigXML)
+	// string "UpdatedAt"
+	o = append(o, 0xa9, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.UpdatedAt)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *TaggingHistoryEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "TaggingConfigXML":
+			z.TaggingConfigXML, bts, err = msgp.ReadBytesBytes(bts, z.TaggingConfigXML)
+			if err != nil {
+				err = msgp.WrapError(err, "TaggingConfigXML")
+				return
+			}
+		case "UpdatedAt":
+			z.UpdatedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "UpdatedAt")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *TaggingHistoryEntry) Msgsize() (s int) {
+	s = 1 + 17 + msgp.BytesPrefixSize + len(z.TaggingConfigXML) + 10 + msgp.TimeSize
 	return
 }
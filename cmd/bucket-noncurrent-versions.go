@@ -0,0 +1,160 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+
+	"github.com/minio/minio/internal/event"
+	"github.com/minio/minio/internal/logger"
+)
+
+// name of the config file carrying the bucket noncurrent versions limit.
+const bucketNoncurrentVersionsConfig = "noncurrent-versions.xml"
+
+// srBucketMetaTypeNoncurrentVersions - represents replication of the
+// bucket noncurrent versions limit configuration across sites, the
+// encoded config travels in the SRBucketMeta Tags field.
+const srBucketMetaTypeNoncurrentVersions = "noncurrent-versions"
+
+// noncurrentVersionsPruneUserAgent is sent with ObjectRemoved events for
+// versions pruned due to the bucket's noncurrent versions limit, so
+// listeners can tell them apart from client and ILM deletes.
+const noncurrentVersionsPruneUserAgent = "Internal: [VersionLimit-Prune]"
+
+// NoncurrentVersionsConfig limits the number of noncurrent versions
+// retained per object. When a PUT or multi-delete leaves an object with
+// more noncurrent versions than the limit, the oldest versions beyond
+// the limit are pruned immediately instead of waiting for ILM.
+type NoncurrentVersionsConfig struct {
+	XMLName               xml.Name `xml:"NoncurrentVersionsConfiguration"`
+	MaxNoncurrentVersions int      `xml:"MaxNoncurrentVersions"`
+}
+
+// parseNoncurrentVersionsConfig unmarshals and validates 'noncurrent-versions.xml'.
+func parseNoncurrentVersionsConfig(reader io.Reader) (*NoncurrentVersionsConfig, error) {
+	cfg := NoncurrentVersionsConfig{}
+	if err := xml.NewDecoder(reader).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	if cfg.MaxNoncurrentVersions <= 0 {
+		return nil, errors.New("MaxNoncurrentVersions must be a positive integer")
+	}
+	return &cfg, nil
+}
+
+// enforceNoncurrentVersionsLimit prunes, in the background, the oldest
+// noncurrent versions of object beyond the bucket's configured limit.
+// numVersions is the version count when already known to the caller,
+// pass 0 when unknown. No-op for buckets without the configuration.
+func enforceNoncurrentVersionsLimit(bucket, object string, numVersions int) {
+	cfg, _, err := globalBucketMetadataSys.GetNoncurrentVersionsConfig(bucket)
+	if err != nil {
+		return
+	}
+	if numVersions > 0 && numVersions-1 <= cfg.MaxNoncurrentVersions {
+		return
+	}
+	go pruneExcessNoncurrentVersions(GlobalContext, newObjectLayerFn(), bucket, object, cfg.MaxNoncurrentVersions)
+}
+
+// pruneExcessNoncurrentVersions deletes all noncurrent versions of
+// object beyond the limit newest ones. Deletion goes through the
+// regular DeleteObjects path to preserve replication semantics, locked
+// versions are never pruned.
+func pruneExcessNoncurrentVersions(ctx context.Context, objectAPI ObjectLayer, bucket, object string, limit int) {
+	if objectAPI == nil {
+		return
+	}
+
+	var toDel []ObjectToDelete
+	var noncurrent int
+	var marker, versionIDMarker string
+	for {
+		// Versions are listed newest first, everything beyond the
+		// limit newest noncurrent versions is a pruning candidate.
+		res, err := objectAPI.ListObjectVersions(ctx, bucket, object, marker, versionIDMarker, "", maxObjectList)
+		if err != nil {
+			logger.LogIf(ctx, err)
+			return
+		}
+		for _, oi := range res.Objects {
+			if oi.Name != object || oi.IsLatest {
+				continue
+			}
+			noncurrent++
+			if noncurrent <= limit {
+				continue
+			}
+			if enforceRetentionForDeletion(ctx, oi) {
+				// Locked versions are retained beyond the limit.
+				continue
+			}
+			toDel = append(toDel, ObjectToDelete{
+				ObjectV: ObjectV{
+					ObjectName: oi.Name,
+					VersionID:  oi.VersionID,
+				},
+			})
+		}
+		if !res.IsTruncated {
+			break
+		}
+		marker, versionIDMarker = res.NextMarker, res.NextVersionIDMarker
+	}
+
+	for remaining := toDel; len(remaining) > 0; toDel = remaining {
+		if len(toDel) > maxDeleteList {
+			remaining = toDel[maxDeleteList:]
+			toDel = toDel[:maxDeleteList]
+		} else {
+			remaining = nil
+		}
+		vc, _ := globalBucketVersioningSys.Get(bucket)
+		deletedObjs, errs := objectAPI.DeleteObjects(ctx, bucket, toDel, ObjectOptions{
+			PrefixEnabledFn:  vc.PrefixEnabled,
+			VersionSuspended: vc.Suspended(),
+		})
+		var logged bool
+		for i, err := range errs {
+			if err != nil {
+				if !logged {
+					// log the first error
+					logger.LogIf(ctx, err)
+					logged = true
+				}
+				continue
+			}
+			dobj := deletedObjs[i]
+
+			sendEvent(eventArgs{
+				EventName:  event.ObjectRemovedDelete,
+				BucketName: bucket,
+				Object: ObjectInfo{
+					Name:      dobj.ObjectName,
+					VersionID: dobj.VersionID,
+				},
+				UserAgent: noncurrentVersionsPruneUserAgent,
+				Host:      globalLocalNodeName,
+			})
+		}
+	}
+}
@@ -19,11 +19,15 @@ package cmd
 
 import (
 	"encoding/xml"
+	"fmt"
 	"io"
 	"net/http"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/minio/minio/internal/event"
+	xhttp "github.com/minio/minio/internal/http"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/mux"
 	"github.com/minio/pkg/bucket/policy"
@@ -144,6 +148,26 @@ func (api objectAPIHandlers) PutBucketNotificationHandler(w http.ResponseWriter,
 		return
 	}
 
+	// Unless the caller opts out, verify that every referenced target is
+	// reachable before persisting the configuration, events sent to a dead
+	// target are otherwise silently dropped later.
+	if !skipNotificationValidation(r) {
+		var unreachable []string
+		for _, queue := range config.QueueList {
+			active, terr := globalEventNotifier.targetList.CheckActive(queue.ARN.TargetID)
+			if terr != nil || !active {
+				unreachable = append(unreachable, queue.ARN.String())
+			}
+		}
+		if len(unreachable) > 0 {
+			apiErr := errorCodes.ToAPIErr(ErrARNNotification)
+			apiErr.Description = fmt.Sprintf("Unable to reach notification target(s) %s, pass 'skipValidation=true' to store the configuration anyway",
+				strings.Join(unreachable, ", "))
+			writeErrorResponse(ctx, w, apiErr, r.URL)
+			return
+		}
+	}
+
 	configData, err := xml.Marshal(config)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -160,3 +184,15 @@ func (api objectAPIHandlers) PutBucketNotificationHandler(w http.ResponseWriter,
 
 	writeSuccessResponseHeadersOnly(w)
 }
+
+// skipNotificationValidation returns true if the caller asked to skip the
+// connectivity check of the referenced notification targets, either via the
+// 'skipValidation' query parameter or its S3-style header alias
+// 'x-amz-skip-destination-validation'.
+func skipNotificationValidation(r *http.Request) bool {
+	if skip, _ := strconv.ParseBool(r.Form.Get("skipValidation")); skip {
+		return true
+	}
+	skip, _ := strconv.ParseBool(r.Header.Get(xhttp.AmzSkipDestinationValidation))
+	return skip
+}
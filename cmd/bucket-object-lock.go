@@ -20,9 +20,13 @@ package cmd
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/minio/minio/internal/amztime"
 	"github.com/minio/minio/internal/auth"
 	objectlock "github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/bucket/replication"
@@ -36,6 +40,13 @@ type BucketObjectLockSys struct{}
 
 // Get - Get retention configuration.
 func (sys *BucketObjectLockSys) Get(bucketName string) (r objectlock.Retention, err error) {
+	return sys.GetForObject(bucketName, "")
+}
+
+// GetForObject - Get the retention configuration applicable to the given
+// object name, selecting the longest matching prefix rule if the bucket
+// carries MinIO-extension prefix rules.
+func (sys *BucketObjectLockSys) GetForObject(bucketName, object string) (r objectlock.Retention, err error) {
 	config, _, err := globalBucketMetadataSys.GetObjectLockConfig(bucketName)
 	if err != nil {
 		if errors.Is(err, BucketObjectLockConfigNotFound{Bucket: bucketName}) {
@@ -47,7 +58,7 @@ func (sys *BucketObjectLockSys) Get(bucketName string) (r objectlock.Retention,
 		logger.CriticalIf(context.Background(), err)
 		return r, err
 	}
-	return config.ToRetention(), nil
+	return config.ToRetentionForObject(object), nil
 }
 
 // enforceRetentionForDeletion checks if it is appropriate to remove an
@@ -76,6 +87,34 @@ func enforceRetentionForDeletion(ctx context.Context, objInfo ObjectInfo) (locke
 	return false
 }
 
+// auditObjectLockBypass leaves a dedicated audit trail whenever governance
+// retention is bypassed on a delete, recording who bypassed the retention
+// and which object version is being removed. The request is also tagged so
+// the regular API audit log entry carries the bypass context.
+func auditObjectLockBypass(ctx context.Context, r *http.Request, bucket string, object ObjectToDelete, ret objectlock.ObjectRetention) {
+	if reqInfo := logger.GetReqInfo(ctx); reqInfo != nil {
+		reqInfo.SetTags("objectLockBypassed", "true")
+	}
+
+	if len(logger.AuditTargets()) == 0 {
+		return
+	}
+
+	cred := getReqAccessCred(r, globalSite.Region)
+	auditLogInternal(ctx, AuditLogOptions{
+		Event:     "ObjectLockBypassDelete",
+		Bucket:    bucket,
+		Object:    object.ObjectName,
+		VersionID: object.VersionID,
+		Tags: map[string]interface{}{
+			"accessKey":       cred.AccessKey,
+			"parentUser":      cred.ParentUser,
+			"retentionMode":   string(ret.Mode),
+			"retainUntilDate": ret.RetainUntilDate.UTC().Format(time.RFC3339),
+		},
+	})
+}
+
 // enforceRetentionBypassForDelete enforces whether an existing object under governance can be deleted
 // with governance bypass headers set in the request.
 // Objects under site wide WORM can never be overwritten.
@@ -160,6 +199,9 @@ func enforceRetentionBypassForDelete(ctx context.Context, r *http.Request, bucke
 			if checkRequestAuthType(ctx, r, policy.BypassGovernanceRetentionAction, bucket, object.ObjectName) != ErrNone {
 				return ErrAccessDenied
 			}
+			// Retained content is about to be removed, leave an audit
+			// trail of the bypass before allowing the delete through.
+			auditObjectLockBypass(ctx, r, bucket, object, ret)
 		}
 	}
 	return ErrNone
@@ -257,7 +299,7 @@ func checkPutObjectLockAllowed(ctx context.Context, rq *http.Request, bucket, ob
 	retentionRequested := objectlock.IsObjectLockRetentionRequested(rq.Header)
 	legalHoldRequested := objectlock.IsObjectLockLegalHoldRequested(rq.Header)
 
-	retentionCfg, err := globalBucketObjectLockSys.Get(bucket)
+	retentionCfg, err := globalBucketObjectLockSys.GetForObject(bucket, object)
 	if err != nil {
 		return mode, retainDate, legalHold, ErrInvalidBucketObjectLockConfiguration
 	}
@@ -343,6 +385,85 @@ func checkPutObjectLockAllowed(ctx context.Context, rq *http.Request, bucket, ob
 	return mode, retainDate, legalHold, ErrNone
 }
 
+// applyRetentionToExistingObjects walks the bucket and stamps the new
+// default retention on current object versions that carry none, so
+// enabling compliance on existing data does not leave old objects
+// unprotected. Objects already under any retention are left untouched,
+// which also preserves retentions longer than the new default.
+// Progress is reported through the batch job metrics under the
+// returned job ID.
+func applyRetentionToExistingObjects(ctx context.Context, api ObjectLayer, bucket string, config *objectlock.Config) string {
+	jobID := mustGetUUID()
+	ri := &batchJobInfo{
+		JobID:     jobID,
+		JobType:   "object-lock-apply",
+		StartTime: UTCNow(),
+	}
+	globalBatchJobsMetrics.save(jobID, ri)
+
+	go func() {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make(chan ObjectInfo, 100)
+		if err := api.Walk(ctx, bucket, "", results, ObjectOptions{}); err != nil {
+			logger.LogIf(ctx, err)
+			ri.Failed = true
+			globalBatchJobsMetrics.save(jobID, ri)
+			return
+		}
+
+		for result := range results {
+			if result.DeleteMarker || !result.IsLatest {
+				continue
+			}
+			if ret := objectlock.GetObjectRetentionMeta(result.UserDefined); ret.Mode.Valid() {
+				// Already retained, including retentions longer than
+				// the new default.
+				continue
+			}
+			retention := config.ToRetentionForObject(result.Name)
+			if !retention.Mode.Valid() || retention.Validity <= 0 {
+				continue
+			}
+			retainUntil := UTCNow().Add(retention.Validity)
+
+			success := true
+			popts := ObjectOptions{
+				VersionID: result.VersionID,
+				EvalMetadataFn: func(oi *ObjectInfo) error {
+					oi.UserDefined[strings.ToLower(xhttp.AmzObjectLockMode)] = string(retention.Mode)
+					oi.UserDefined[strings.ToLower(xhttp.AmzObjectLockRetainUntilDate)] = amztime.ISO8601Format(retainUntil)
+					oi.UserDefined[ReservedMetadataPrefixLower+ObjectLockRetentionTimestamp] = UTCNow().Format(time.RFC3339Nano)
+					dsc := mustReplicate(ctx, bucket, oi.Name, getMustReplicateOptions(*oi, replication.MetadataReplicationType, ObjectOptions{}))
+					if dsc.ReplicateAny() {
+						oi.UserDefined[ReservedMetadataPrefixLower+ReplicationTimestamp] = UTCNow().Format(time.RFC3339Nano)
+						oi.UserDefined[ReservedMetadataPrefixLower+ReplicationStatus] = dsc.PendingStatus()
+					}
+					return nil
+				},
+			}
+			objInfo, err := api.PutObjectMetadata(ctx, bucket, result.Name, popts)
+			if err != nil {
+				logger.LogIf(ctx, fmt.Errorf("applying default retention to %s/%s failed: %w", bucket, result.Name, err))
+				success = false
+			} else {
+				dsc := mustReplicate(ctx, bucket, result.Name, getMustReplicateOptions(objInfo, replication.MetadataReplicationType, ObjectOptions{}))
+				if dsc.ReplicateAny() {
+					scheduleReplication(ctx, objInfo.Clone(), api, dsc, replication.MetadataReplicationType)
+				}
+			}
+			ri.trackCurrentBucketObject(bucket, result, success)
+			globalBatchJobsMetrics.save(jobID, ri)
+		}
+
+		ri.finalize()
+		globalBatchJobsMetrics.save(jobID, ri)
+	}()
+
+	return jobID
+}
+
 // NewBucketObjectLockSys returns initialized BucketObjectLockSys
 func NewBucketObjectLockSys() *BucketObjectLockSys {
 	return &BucketObjectLockSys{}
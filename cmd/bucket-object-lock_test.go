@@ -0,0 +1,104 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/internal/amztime"
+	objectlock "github.com/minio/minio/internal/bucket/object/lock"
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func TestApplyRetentionToExistingObjects(t *testing.T) {
+	ExecObjectLayerTest(t, testApplyRetentionToExistingObjects)
+}
+
+// testApplyRetentionToExistingObjects - Tests that the background walk stamps
+// the default retention on objects without one and leaves objects already
+// under retention untouched.
+func testApplyRetentionToExistingObjects(obj ObjectLayer, instanceType string, t TestErrHandler) {
+	bucketName := "retro-lock-bucket"
+	if err := obj.MakeBucket(GlobalContext, bucketName, MakeBucketOptions{}); err != nil {
+		t.Fatalf("%s: Failed to create bucket: <ERROR> %v", instanceType, err)
+	}
+
+	contentBytes := []byte("hello")
+	if _, err := obj.PutObject(GlobalContext, bucketName, "plain-object",
+		mustGetPutObjReader(t, bytes.NewReader(contentBytes), int64(len(contentBytes)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatalf("%s: Error uploading object: <ERROR> %v", instanceType, err)
+	}
+
+	// An object already retained well beyond the new default must not be
+	// touched by the walk.
+	existingRetainUntil := UTCNow().AddDate(0, 0, 365)
+	if _, err := obj.PutObject(GlobalContext, bucketName, "retained-object",
+		mustGetPutObjReader(t, bytes.NewReader(contentBytes), int64(len(contentBytes)), "", ""), ObjectOptions{
+			UserDefined: map[string]string{
+				strings.ToLower(xhttp.AmzObjectLockMode):            string(objectlock.RetCompliance),
+				strings.ToLower(xhttp.AmzObjectLockRetainUntilDate): amztime.ISO8601Format(existingRetainUntil),
+			},
+		}); err != nil {
+		t.Fatalf("%s: Error uploading object: <ERROR> %v", instanceType, err)
+	}
+
+	cfg, err := objectlock.ParseObjectLockConfig(strings.NewReader(
+		`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></Rule></ObjectLockConfiguration>`))
+	if err != nil {
+		t.Fatalf("%s: Failed to parse object lock config: <ERROR> %v", instanceType, err)
+	}
+
+	jobID := applyRetentionToExistingObjects(GlobalContext, obj, bucketName, cfg)
+	if jobID == "" {
+		t.Fatalf("%s: Expected a job ID for the background walk", instanceType)
+	}
+
+	// Wait for the background walk to stamp the unprotected object.
+	var ret objectlock.ObjectRetention
+	for start := time.Now(); time.Since(start) < 10*time.Second; time.Sleep(50 * time.Millisecond) {
+		oi, err := obj.GetObjectInfo(GlobalContext, bucketName, "plain-object", ObjectOptions{})
+		if err != nil {
+			t.Fatalf("%s: Failed to stat object: <ERROR> %v", instanceType, err)
+		}
+		if ret = objectlock.GetObjectRetentionMeta(oi.UserDefined); ret.Mode.Valid() {
+			break
+		}
+	}
+	if ret.Mode != objectlock.RetGovernance {
+		t.Fatalf("%s: Expected %s retention on the unprotected object, got %q", instanceType, objectlock.RetGovernance, ret.Mode)
+	}
+	if ret.RetainUntilDate.IsZero() || UTCNow().AddDate(0, 0, 31).Before(ret.RetainUntilDate.Time) {
+		t.Errorf("%s: Unexpected retain until date %s", instanceType, ret.RetainUntilDate)
+	}
+
+	// The already retained object keeps its longer retention.
+	oi, err := obj.GetObjectInfo(GlobalContext, bucketName, "retained-object", ObjectOptions{})
+	if err != nil {
+		t.Fatalf("%s: Failed to stat object: <ERROR> %v", instanceType, err)
+	}
+	existing := objectlock.GetObjectRetentionMeta(oi.UserDefined)
+	if existing.Mode != objectlock.RetCompliance {
+		t.Errorf("%s: Expected the existing %s retention to be preserved, got %q", instanceType, objectlock.RetCompliance, existing.Mode)
+	}
+	if d := existing.RetainUntilDate.Sub(existingRetainUntil); d < -time.Second || d > time.Second {
+		t.Errorf("%s: Expected the existing retain until date to be preserved, got %s", instanceType, existing.RetainUntilDate)
+	}
+}
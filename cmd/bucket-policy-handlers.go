@@ -20,24 +20,81 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	humanize "github.com/dustin/go-humanize"
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/mux"
 	"github.com/minio/pkg/bucket/policy"
+	"github.com/minio/pkg/env"
 )
 
 const (
 	// As per AWS S3 specification, 20KiB policy JSON data is allowed.
 	maxBucketPolicySize = 20 * humanize.KiByte
 
+	// Default number of statements allowed per policy document.
+	maxBucketPolicyStatements = 100
+
 	// Policy configuration file.
 	bucketPolicyConfig = "policy.json"
 )
 
+// Enforced limits on uploaded bucket policies, deployments that need
+// larger documents can relax them via environment.
+var (
+	bucketPolicyMaxSize       = int64(maxBucketPolicySize)
+	bucketPolicyMaxStatements = maxBucketPolicyStatements
+)
+
+func init() {
+	if v := env.Get("_MINIO_BUCKET_POLICY_MAX_SIZE", ""); v != "" {
+		sz, err := humanize.ParseBytes(v)
+		if err != nil || sz == 0 {
+			logger.Info("invalid _MINIO_BUCKET_POLICY_MAX_SIZE value %s, keeping the default %s", v, humanize.IBytes(uint64(bucketPolicyMaxSize)))
+		} else {
+			bucketPolicyMaxSize = int64(sz)
+		}
+	}
+	if v := env.Get("_MINIO_BUCKET_POLICY_MAX_STATEMENTS", ""); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			logger.Info("invalid _MINIO_BUCKET_POLICY_MAX_STATEMENTS value %s, keeping the default %d", v, bucketPolicyMaxStatements)
+		} else {
+			bucketPolicyMaxStatements = n
+		}
+	}
+}
+
+// pinpointPolicyError re-parses a rejected policy document statement by
+// statement so the returned error names the offending statement index
+// alongside the field level failure, err is returned unchanged when the
+// failure is not statement specific.
+func pinpointPolicyError(bucketPolicyBytes []byte, bucket string, err error) error {
+	var doc struct {
+		ID         string            `json:"ID,omitempty"`
+		Version    string            `json:"Version"`
+		Statements []json.RawMessage `json:"Statement"`
+	}
+	if json.Unmarshal(bucketPolicyBytes, &doc) != nil {
+		return err
+	}
+	for i, raw := range doc.Statements {
+		var st policy.Statement
+		if serr := json.Unmarshal(raw, &st); serr != nil {
+			return fmt.Errorf("statement %d of %d: %v", i+1, len(doc.Statements), serr)
+		}
+		if serr := st.Validate(bucket); serr != nil {
+			return fmt.Errorf("statement %d of %d: %v", i+1, len(doc.Statements), serr)
+		}
+	}
+	return err
+}
+
 // PutBucketPolicyHandler - This HTTP handler stores given bucket policy configuration as per
 // https://docs.aws.amazon.com/AmazonS3/latest/dev/access-policy-language-overview.html
 func (api objectAPIHandlers) PutBucketPolicyHandler(w http.ResponseWriter, r *http.Request) {
@@ -73,7 +130,7 @@ func (api objectAPIHandlers) PutBucketPolicyHandler(w http.ResponseWriter, r *ht
 	}
 
 	// Error out if Content-Length is beyond allowed size.
-	if r.ContentLength > maxBucketPolicySize {
+	if r.ContentLength > bucketPolicyMaxSize {
 		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(ErrPolicyTooLarge), r.URL)
 		return
 	}
@@ -89,7 +146,7 @@ func (api objectAPIHandlers) PutBucketPolicyHandler(w http.ResponseWriter, r *ht
 		writeErrorResponse(ctx, w, APIError{
 			Code:           "MalformedPolicy",
 			HTTPStatusCode: http.StatusBadRequest,
-			Description:    err.Error(),
+			Description:    pinpointPolicyError(bucketPolicyBytes, bucket, err).Error(),
 		}, r.URL)
 		return
 	}
@@ -100,18 +157,39 @@ func (api objectAPIHandlers) PutBucketPolicyHandler(w http.ResponseWriter, r *ht
 		return
 	}
 
+	if len(bucketPolicy.Statements) > bucketPolicyMaxStatements {
+		writeErrorResponse(ctx, w, APIError{
+			Code:           "MalformedPolicy",
+			HTTPStatusCode: http.StatusBadRequest,
+			Description:    fmt.Sprintf("policy document has %d statements, exceeding the limit of %d", len(bucketPolicy.Statements), bucketPolicyMaxStatements),
+		}, r.URL)
+		return
+	}
+
 	configData, err := json.Marshal(bucketPolicy)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
 
+	// With validate-only the caller only wants the policy checked and
+	// canonicalized, skip persisting it.
+	if validateOnly, _ := strconv.ParseBool(r.Form.Get("validate-only")); validateOnly {
+		writeSuccessResponseJSON(w, configData)
+		return
+	}
+
+	// Previous policy, if any, feeds the audit diff below.
+	prevPolicy, _, _ := globalBucketMetadataSys.GetPolicyConfig(bucket)
+
 	updatedAt, err := globalBucketMetadataSys.Update(ctx, bucket, bucketPolicyConfig, configData)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
 
+	auditBucketConfigChange(ctx, "bucketPolicy", bucketPolicySummary(prevPolicy), bucketPolicySummary(bucketPolicy))
+
 	// Call site replication hook.
 	logger.LogIf(ctx, globalSiteReplicationSys.BucketMetaHook(ctx, madmin.SRBucketMeta{
 		Type:      madmin.SRBucketMetaTypePolicy,
@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -775,3 +776,42 @@ func testDeleteBucketPolicyHandler(obj ObjectLayer, instanceType, bucketName str
 	// `ExecObjectLayerAPINilTest` manages the operation.
 	ExecObjectLayerAPINilTest(t, nilBucket, "", instanceType, apiRouter, nilReq)
 }
+
+// TestPinpointPolicyError - tests that rejected policies are annotated
+// with the offending statement index.
+func TestPinpointPolicyError(t *testing.T) {
+	generic := errors.New("generic parse failure")
+
+	// Second statement references a foreign bucket.
+	doc := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{"Effect": "Allow", "Principal": {"AWS": ["*"]}, "Action": ["s3:GetBucketLocation"], "Resource": ["arn:aws:s3:::mybucket"]},
+			{"Effect": "Allow", "Principal": {"AWS": ["*"]}, "Action": ["s3:GetBucketLocation"], "Resource": ["arn:aws:s3:::otherbucket"]}
+		]
+	}`
+	err := pinpointPolicyError([]byte(doc), "mybucket", generic)
+	if err == generic {
+		t.Fatal("expected the error to be annotated")
+	}
+	if !strings.Contains(err.Error(), "statement 2 of 2") {
+		t.Fatalf("expected statement index in error, got %v", err)
+	}
+
+	// Statement with an invalid Effect field.
+	doc = `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{"Effect": "Maybe", "Principal": {"AWS": ["*"]}, "Action": ["s3:GetBucketLocation"], "Resource": ["arn:aws:s3:::mybucket"]}
+		]
+	}`
+	err = pinpointPolicyError([]byte(doc), "mybucket", generic)
+	if !strings.Contains(err.Error(), "statement 1 of 1") || !strings.Contains(err.Error(), "Effect") {
+		t.Fatalf("expected statement index and field in error, got %v", err)
+	}
+
+	// Documents that do not decode at all keep the original error.
+	if err = pinpointPolicyError([]byte("not json"), "mybucket", generic); err != generic {
+		t.Fatalf("expected the original error, got %v", err)
+	}
+}
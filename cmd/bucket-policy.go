@@ -23,6 +23,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
@@ -38,6 +39,72 @@ import (
 // PolicySys - policy subsystem.
 type PolicySys struct{}
 
+// bucketPolicyStatus is the computed anonymous access status of a
+// bucket, cached keyed by the policy's last update time so a policy
+// change naturally invalidates the entry.
+type bucketPolicyStatus struct {
+	updatedAt time.Time
+	readable  bool
+	writable  bool
+}
+
+// bucketPolicyStatusCache caches the per-bucket policy status computed
+// by GetBucketPolicyStatusHandler, status dashboards poll it frequently
+// while policies change rarely.
+type bucketPolicyStatusCache struct {
+	mu sync.Mutex
+	m  map[string]bucketPolicyStatus
+}
+
+var globalPolicyStatusCache = bucketPolicyStatusCache{m: make(map[string]bucketPolicyStatus)}
+
+func (c *bucketPolicyStatusCache) get(bucket string, updatedAt time.Time) (readable, writable, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	st, ok := c.m[bucket]
+	if !ok || !st.updatedAt.Equal(updatedAt) {
+		return false, false, false
+	}
+	return st.readable, st.writable, true
+}
+
+func (c *bucketPolicyStatusCache) set(bucket string, updatedAt time.Time, readable, writable bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.m[bucket] = bucketPolicyStatus{
+		updatedAt: updatedAt,
+		readable:  readable,
+		writable:  writable,
+	}
+}
+
+// delete evicts the bucket's entry, called when the bucket is removed
+// so a re-created bucket never inherits a stale verdict.
+func (c *bucketPolicyStatusCache) delete(bucket string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.m, bucket)
+}
+
+// policyUsesConditions returns true if any statement of the policy
+// carries condition functions. Conditions such as aws:SourceIp or
+// aws:Referer evaluate differently per request, so their verdict must
+// not be shared across requesters.
+func policyUsesConditions(p *policy.Policy) bool {
+	if p == nil {
+		return false
+	}
+	for _, st := range p.Statements {
+		if len(st.Conditions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Get returns stored bucket policy
 func (sys *PolicySys) Get(bucket string) (*policy.Policy, error) {
 	policy, _, err := globalBucketMetadataSys.GetPolicyConfig(bucket)
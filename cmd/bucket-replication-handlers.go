@@ -85,11 +85,17 @@ func (api objectAPIHandlers) PutBucketReplicationConfigHandler(w http.ResponseWr
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
+
+	// Previous configuration, if any, feeds the audit diff below.
+	prevCfg, _, _ := globalBucketMetadataSys.GetReplicationConfig(ctx, bucket)
+
 	if _, err = globalBucketMetadataSys.Update(ctx, bucket, bucketReplicationConfig, configData); err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
 		return
 	}
 
+	auditBucketConfigChange(ctx, "replication", replicationConfigSummary(prevCfg), replicationConfigSummary(replicationConfig))
+
 	// Write success response.
 	writeSuccessResponseHeadersOnly(w)
 }
@@ -0,0 +1,284 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	"github.com/minio/minio/internal/auth"
+	"github.com/minio/minio/internal/event"
+	"github.com/minio/minio/internal/handlers"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+)
+
+// name of the config file carrying the bucket scanning webhook settings.
+const bucketScanConfig = "scan-config.xml"
+
+const (
+	// scanFailOpen admits the upload when the scanning webhook cannot
+	// be reached or times out.
+	scanFailOpen = "fail-open"
+	// scanFailClosed rejects and removes the upload when the scanning
+	// webhook cannot be reached or times out.
+	scanFailClosed = "fail-closed"
+
+	// scanVerdictQuarantine is the webhook verdict that quarantines the
+	// object, any other verdict admits it.
+	scanVerdictQuarantine = "quarantine"
+
+	// scanQuarantineTag is set on quarantined objects.
+	scanQuarantineTag = "scan-status=quarantined"
+
+	scanDefaultTimeout = 30 * time.Second
+	scanMaxTimeout     = 5 * time.Minute
+
+	// Presigned GET URLs handed to the webhook outlive the scan timeout
+	// by this margin so the scanner can fetch the payload.
+	scanPresignExpiryMargin = 2 * time.Minute
+)
+
+// ScanConfig holds the bucket-level scanning webhook configuration. When
+// configured, freshly uploaded objects are reported to the webhook before
+// the success response and quarantined when it says so.
+type ScanConfig struct {
+	XMLName xml.Name `xml:"ScanConfiguration"`
+	// Webhook receives a JSON description of the uploaded object and a
+	// short-lived presigned GET URL, and answers with a verdict.
+	Webhook string `xml:"Webhook"`
+	// TimeoutSeconds bounds a single webhook call, zero applies the
+	// default of 30 seconds.
+	TimeoutSeconds int `xml:"TimeoutSeconds,omitempty"`
+	// FailurePolicy is either 'fail-open' (default) or 'fail-closed'
+	// and decides what happens to uploads when the webhook fails.
+	FailurePolicy string `xml:"FailurePolicy,omitempty"`
+	// IncludePuts extends scanning from POST policy uploads to regular
+	// PutObject uploads.
+	IncludePuts bool `xml:"IncludePuts,omitempty"`
+}
+
+// parseBucketScanConfig unmarshals and validates 'scan-config.xml'.
+func parseBucketScanConfig(reader io.Reader) (*ScanConfig, error) {
+	cfg := ScanConfig{}
+	if err := xml.NewDecoder(reader).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(cfg.Webhook)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scan webhook URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, errors.New("scan webhook must be an http(s) URL")
+	}
+	switch cfg.FailurePolicy {
+	case "", scanFailOpen, scanFailClosed:
+	default:
+		return nil, fmt.Errorf("scan failure policy must be '%s' or '%s'", scanFailOpen, scanFailClosed)
+	}
+	if cfg.TimeoutSeconds < 0 || time.Duration(cfg.TimeoutSeconds)*time.Second > scanMaxTimeout {
+		return nil, fmt.Errorf("scan timeout must be between 0 and %d seconds", int(scanMaxTimeout/time.Second))
+	}
+	return &cfg, nil
+}
+
+func (cfg *ScanConfig) timeout() time.Duration {
+	if cfg.TimeoutSeconds <= 0 {
+		return scanDefaultTimeout
+	}
+	return time.Duration(cfg.TimeoutSeconds) * time.Second
+}
+
+func (cfg *ScanConfig) failClosed() bool {
+	return cfg.FailurePolicy == scanFailClosed
+}
+
+// scanStats tracks webhook scan latency and verdicts for metrics.
+type scanStats struct {
+	cleanVerdicts      atomic.Uint64
+	quarantineVerdicts atomic.Uint64
+	failedScans        atomic.Uint64
+	totalScans         atomic.Uint64
+	latencyNanos       atomic.Uint64
+}
+
+var globalScanStats scanStats
+
+// scanWebhookRequest is the JSON payload sent to the scanning webhook.
+type scanWebhookRequest struct {
+	Bucket       string `json:"bucket"`
+	Object       string `json:"object"`
+	VersionID    string `json:"versionId,omitempty"`
+	ETag         string `json:"etag,omitempty"`
+	Size         int64  `json:"size"`
+	PresignedURL string `json:"presignedURL,omitempty"`
+}
+
+// scanWebhookResponse is the JSON verdict returned by the scanning
+// webhook.
+type scanWebhookResponse struct {
+	Verdict string `json:"verdict"`
+}
+
+// presignedGetObjectURL generates a presigned GET URL for the given
+// object, valid for the given duration.
+func presignedGetObjectURL(scheme, host, bucket, object string, expires time.Duration, cred auth.Credentials) string {
+	t := UTCNow()
+	region := globalSite.Region
+	scope := getScope(t, region)
+
+	query := make(url.Values)
+	query.Set(xhttp.AmzAlgorithm, signV4Algorithm)
+	query.Set(xhttp.AmzDate, t.Format(iso8601Format))
+	query.Set(xhttp.AmzExpires, strconv.Itoa(int(expires/time.Second)))
+	query.Set(xhttp.AmzSignedHeaders, "host")
+	query.Set(xhttp.AmzCredential, cred.AccessKey+SlashSeparator+scope)
+	if cred.SessionToken != "" {
+		query.Set(xhttp.AmzSecurityToken, cred.SessionToken)
+	}
+	encodedQuery := query.Encode()
+
+	urlPath := SlashSeparator + pathJoin(bucket, object)
+	signedHeaders := make(http.Header)
+	signedHeaders.Set("host", host)
+
+	canonicalRequest := getCanonicalRequest(signedHeaders, unsignedPayload, encodedQuery, urlPath, http.MethodGet)
+	signature := getSignature(getSigningKey(cred.SecretKey, t, region, serviceS3),
+		getStringToSign(canonicalRequest, t, scope))
+
+	return scheme + "://" + host + s3utils.EncodePath(urlPath) + "?" + encodedQuery + "&" + xhttp.AmzSignature + "=" + signature
+}
+
+// scanObject reports the uploaded object to the configured webhook and
+// returns its verdict. The call is bounded by the configured timeout.
+func (cfg *ScanConfig) scanObject(ctx context.Context, r *http.Request, bucket string, objInfo ObjectInfo) (string, error) {
+	expires := cfg.timeout() + scanPresignExpiryMargin
+	payload, err := json.Marshal(scanWebhookRequest{
+		Bucket:       bucket,
+		Object:       objInfo.Name,
+		VersionID:    objInfo.VersionID,
+		ETag:         objInfo.ETag,
+		Size:         objInfo.Size,
+		PresignedURL: presignedGetObjectURL(getURLScheme(globalIsTLS), r.Host, bucket, objInfo.Name, expires, globalActiveCred),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.timeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.Webhook, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set(xhttp.ContentType, "application/json")
+
+	clnt := &http.Client{Transport: globalRemoteTargetTransport}
+	resp, err := clnt.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer xhttp.DrainBody(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("scan webhook returned '%s'", resp.Status)
+	}
+
+	verdict := scanWebhookResponse{}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&verdict); err != nil {
+		return "", err
+	}
+	return verdict.Verdict, nil
+}
+
+// enforceBucketScan invokes the bucket's scanning webhook, if configured,
+// for a freshly written object. Quarantined objects are tagged and a
+// quarantine event is emitted, the caller must then suppress the regular
+// created event. A non-ErrNone code is returned when the webhook failed
+// and the failure policy is fail-closed, the object is removed again in
+// that case. fromPut restricts enforcement to buckets that opted their
+// PutObject uploads into scanning.
+func enforceBucketScan(ctx context.Context, w http.ResponseWriter, r *http.Request, objectAPI ObjectLayer, bucket string, objInfo ObjectInfo, fromPut bool) (quarantined bool, s3Err APIErrorCode) {
+	cfg, _, err := globalBucketMetadataSys.GetScanConfig(bucket)
+	if err != nil {
+		// Not configured for this bucket.
+		return false, ErrNone
+	}
+	if fromPut && !cfg.IncludePuts {
+		return false, ErrNone
+	}
+
+	started := time.Now()
+	verdict, err := cfg.scanObject(ctx, r, bucket, objInfo)
+	globalScanStats.totalScans.Add(1)
+	globalScanStats.latencyNanos.Add(uint64(time.Since(started)))
+
+	if err != nil {
+		globalScanStats.failedScans.Add(1)
+		logger.LogIf(ctx, fmt.Errorf("scan webhook for bucket '%s' failed: %w", bucket, err))
+		if !cfg.failClosed() {
+			return false, ErrNone
+		}
+		// Fail closed, the unscanned object may not stay around.
+		opts := ObjectOptions{
+			VersionID:        objInfo.VersionID,
+			Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, objInfo.Name),
+			VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, objInfo.Name),
+		}
+		if _, derr := objectAPI.DeleteObject(ctx, bucket, objInfo.Name, opts); derr != nil {
+			logger.LogIf(ctx, fmt.Errorf("unable to remove unscanned object '%s/%s': %w", bucket, objInfo.Name, derr))
+		}
+		return false, ErrObjectScanFailed
+	}
+
+	if verdict != scanVerdictQuarantine {
+		globalScanStats.cleanVerdicts.Add(1)
+		return false, ErrNone
+	}
+	globalScanStats.quarantineVerdicts.Add(1)
+
+	// Tag the object so lifecycle/policies can act on quarantined
+	// content, then emit the quarantine event in place of the regular
+	// created event.
+	if _, err := objectAPI.PutObjectTags(ctx, bucket, objInfo.Name, scanQuarantineTag, ObjectOptions{VersionID: objInfo.VersionID}); err != nil {
+		logger.LogIf(ctx, fmt.Errorf("unable to tag quarantined object '%s/%s': %w", bucket, objInfo.Name, err))
+	}
+
+	sendEvent(eventArgs{
+		EventName:    event.ObjectScanQuarantined,
+		BucketName:   bucket,
+		Object:       objInfo,
+		ReqParams:    extractReqParams(r),
+		RespElements: extractRespElements(w),
+		UserAgent:    r.UserAgent(),
+		Host:         handlers.GetSourceIP(r),
+	})
+	return true, ErrNone
+}
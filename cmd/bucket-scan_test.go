@@ -0,0 +1,143 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio/internal/auth"
+)
+
+func TestParseBucketScanConfig(t *testing.T) {
+	testCases := []struct {
+		config    string
+		expectErr bool
+	}{
+		{`<ScanConfiguration><Webhook>http://scanner.example.com/scan</Webhook></ScanConfiguration>`, false},
+		{`<ScanConfiguration><Webhook>https://scanner.example.com/scan</Webhook><TimeoutSeconds>10</TimeoutSeconds><FailurePolicy>fail-closed</FailurePolicy><IncludePuts>true</IncludePuts></ScanConfiguration>`, false},
+		// Missing webhook.
+		{`<ScanConfiguration></ScanConfiguration>`, true},
+		// Unsupported scheme.
+		{`<ScanConfiguration><Webhook>ftp://scanner.example.com</Webhook></ScanConfiguration>`, true},
+		// Unknown failure policy.
+		{`<ScanConfiguration><Webhook>http://scanner.example.com</Webhook><FailurePolicy>reject</FailurePolicy></ScanConfiguration>`, true},
+		// Timeout beyond the maximum.
+		{`<ScanConfiguration><Webhook>http://scanner.example.com</Webhook><TimeoutSeconds>3600</TimeoutSeconds></ScanConfiguration>`, true},
+	}
+	for i, testCase := range testCases {
+		_, err := parseBucketScanConfig(strings.NewReader(testCase.config))
+		if testCase.expectErr && err == nil {
+			t.Errorf("Test %d: expected an error, got none", i+1)
+		}
+		if !testCase.expectErr && err != nil {
+			t.Errorf("Test %d: unexpected error: %v", i+1, err)
+		}
+	}
+}
+
+// Wrapper for calling TestAPIBucketScanEnforcement tests for both Erasure
+// multiple disks and single node setup.
+func TestAPIBucketScanEnforcement(t *testing.T) {
+	ExecObjectLayerAPITest(t, testAPIBucketScanEnforcement, []string{"PutObject"})
+}
+
+// testAPIBucketScanEnforcement - Tests that PutObject uploads are reported
+// to the configured scanning webhook, quarantine verdicts tag the object
+// and fail-closed removes the upload when the webhook fails.
+func testAPIBucketScanEnforcement(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T,
+) {
+	var verdict string
+	var lastScan scanWebhookRequest
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&lastScan); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"verdict":"%s"}`, verdict)
+	}))
+	defer webhook.Close()
+
+	scanCfg := []byte(fmt.Sprintf(`<ScanConfiguration><Webhook>%s</Webhook><TimeoutSeconds>5</TimeoutSeconds><FailurePolicy>fail-closed</FailurePolicy><IncludePuts>true</IncludePuts></ScanConfiguration>`, webhook.URL))
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketScanConfig, scanCfg); err != nil {
+		t.Fatalf("Failed to set bucket scan configuration: <ERROR> %v", err)
+	}
+
+	putObject := func(objectName string) *httptest.ResponseRecorder {
+		data := []byte("scan me")
+		req, err := newTestSignedRequestV4(http.MethodPut, getPutObjectURL("", bucketName, objectName),
+			int64(len(data)), strings.NewReader(string(data)), credentials.AccessKey, credentials.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Failed to create HTTP request for PutObject: <ERROR> %v", err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// A clean verdict admits the object untouched.
+	verdict = "clean"
+	if rec := putObject("clean-object"); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	if lastScan.Bucket != bucketName || lastScan.Object != "clean-object" {
+		t.Errorf("MinIO %s: Webhook saw unexpected object coordinates %s/%s", instanceType, lastScan.Bucket, lastScan.Object)
+	}
+	if !strings.Contains(lastScan.PresignedURL, "X-Amz-Signature=") {
+		t.Errorf("MinIO %s: Expected a presigned GET URL in the webhook payload, got `%s`", instanceType, lastScan.PresignedURL)
+	}
+	if tags, err := obj.GetObjectTags(GlobalContext, bucketName, "clean-object", ObjectOptions{}); err != nil || len(tags.ToMap()) != 0 {
+		t.Errorf("MinIO %s: Expected no tags on a clean object, got %v (err %v)", instanceType, tags, err)
+	}
+
+	// A quarantine verdict tags the object but the upload still succeeds.
+	verdict = scanVerdictQuarantine
+	if rec := putObject("bad-object"); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+	tags, err := obj.GetObjectTags(GlobalContext, bucketName, "bad-object", ObjectOptions{})
+	if err != nil {
+		t.Fatalf("MinIO %s: Unable to read tags of quarantined object: <ERROR> %v", instanceType, err)
+	}
+	if tags.String() != scanQuarantineTag {
+		t.Errorf("MinIO %s: Expected quarantine tag `%s`, got `%s`", instanceType, scanQuarantineTag, tags.String())
+	}
+
+	// With fail-closed a webhook failure rejects and removes the upload.
+	webhook.Close()
+	if rec := putObject("unscanned-object"); rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusServiceUnavailable, rec.Code)
+	}
+	if _, err := obj.GetObjectInfo(GlobalContext, bucketName, "unscanned-object", ObjectOptions{}); err == nil {
+		t.Errorf("MinIO %s: Expected the unscanned object to be removed", instanceType)
+	}
+
+	// Fail-open admits the upload despite the webhook failure.
+	scanCfg = []byte(fmt.Sprintf(`<ScanConfiguration><Webhook>%s</Webhook><TimeoutSeconds>5</TimeoutSeconds><IncludePuts>true</IncludePuts></ScanConfiguration>`, webhook.URL))
+	if _, err := globalBucketMetadataSys.Update(GlobalContext, bucketName, bucketScanConfig, scanCfg); err != nil {
+		t.Fatalf("Failed to update bucket scan configuration: <ERROR> %v", err)
+	}
+	if rec := putObject("unscanned-object"); rec.Code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, rec.Code)
+	}
+}
@@ -55,6 +55,33 @@ func readConfig(ctx context.Context, store objectIO, configFile string) ([]byte,
 	return buf, err
 }
 
+// readConfigAt reads up to length bytes of configFile starting at
+// offset, returning the bytes read together with the total object
+// size. Offsets at or beyond the end of the object return io.EOF,
+// mirroring StorageAPI.ReadAllAt, so callers can inspect a header or
+// tail without transferring the rest of the object.
+func readConfigAt(ctx context.Context, store objectIO, configFile string, offset, length int64) ([]byte, int64, error) {
+	rs := &HTTPRangeSpec{Start: offset, End: offset + length - 1}
+	r, err := store.GetObjectNInfo(ctx, minioMetaBucket, configFile, rs, http.Header{}, ObjectOptions{})
+	if err != nil {
+		if isErrObjectNotFound(err) {
+			return nil, 0, errConfigNotFound
+		}
+		var rangeErr InvalidRange
+		if errors.As(err, &rangeErr) {
+			return nil, rangeErr.ResourceSize, io.EOF
+		}
+		return nil, 0, err
+	}
+	defer r.Close()
+
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	return buf, r.ObjInfo.Size, nil
+}
+
 type objectDeleter interface {
 	DeleteObject(ctx context.Context, bucket, object string, opts ObjectOptions) (ObjectInfo, error)
 }
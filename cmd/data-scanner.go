@@ -39,10 +39,12 @@ import (
 	"github.com/minio/minio/internal/bucket/object/lock"
 	"github.com/minio/minio/internal/bucket/replication"
 	"github.com/minio/minio/internal/color"
+	"github.com/minio/minio/internal/config"
 	"github.com/minio/minio/internal/config/heal"
 	"github.com/minio/minio/internal/event"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/pkg/console"
+	"github.com/minio/pkg/env"
 	uatomic "go.uber.org/atomic"
 )
 
@@ -88,6 +90,12 @@ func initDataScanner(ctx context.Context, objAPI ObjectLayer) {
 	}()
 }
 
+// scanModeFlagFineHistogram is OR-ed into the scan mode to request
+// fine-grained object size histograms from the scan. It rides on the
+// scan mode so the request reaches remote disks without a wire format
+// change, madmin does not declare flag bits for the scan mode yet.
+const scanModeFlagFineHistogram = madmin.HealScanMode(1 << 8)
+
 func getCycleScanMode(currentCycle, bitrotStartCycle uint64, bitrotStartTime time.Time) madmin.HealScanMode {
 	bitrotCycle := globalHealConfig.BitrotScanCycle()
 	switch bitrotCycle {
@@ -201,6 +209,12 @@ func runDataScanner(ctx context.Context, objAPI ObjectLayer) {
 				saveBackgroundHealInfo(ctx, objAPI, newHealInfo)
 			}
 
+			// Fine-grained size histograms are opt-in, untouched
+			// deployments scan exactly as before.
+			if env.Get("_MINIO_SCANNER_SIZE_HISTOGRAM", config.EnableOff) == config.EnableOn {
+				scanMode |= scanModeFlagFineHistogram
+			}
+
 			// Wait before starting next cycle and wait on startup.
 			results := make(chan DataUsageInfo, 1)
 			go storeDataUsageInBackend(ctx, objAPI, results)
@@ -248,6 +262,9 @@ type folderScanner struct {
 	healObjectSelect      uint32 // Do a heal check on an object once every n cycles. Must divide into healFolderInclude
 	scanMode              madmin.HealScanMode
 
+	// Collect fine-grained object size histograms while scanning.
+	collectSizeHistogram bool
+
 	disks       []StorageAPI
 	disksQuorum int
 
@@ -256,6 +273,10 @@ type folderScanner struct {
 	updates    chan<- dataUsageEntry
 	lastUpdate time.Time
 
+	// If set per-prefix scan errors will be sent to this channel.
+	// Will not be closed when returned.
+	scanErrs chan<- nsScannerError
+
 	// updateCurrentPath should be called whenever a new path is scanned.
 	updateCurrentPath func(string)
 }
@@ -310,6 +331,11 @@ func scanDataFolder(ctx context.Context, poolIdx, setIdx int, basePath string, c
 	updatePath, closeDisk := globalScannerMetrics.currentPathUpdater(basePath, cache.Info.Name)
 	defer closeDisk()
 
+	// Peel the histogram request off the scan mode before the mode is
+	// compared against the madmin defined values.
+	collectSizeHistogram := scanMode&scanModeFlagFineHistogram != 0
+	scanMode &^= scanModeFlagFineHistogram
+
 	s := folderScanner{
 		root:                  basePath,
 		getSize:               getSize,
@@ -319,7 +345,9 @@ func scanDataFolder(ctx context.Context, poolIdx, setIdx int, basePath string, c
 		dataUsageScannerDebug: false,
 		healObjectSelect:      0,
 		scanMode:              scanMode,
+		collectSizeHistogram:  collectSizeHistogram,
 		updates:               cache.Info.updates,
+		scanErrs:              cache.Info.scanErrs,
 		updateCurrentPath:     updatePath,
 	}
 
@@ -380,6 +408,18 @@ func (f *folderScanner) sendUpdate() {
 	}
 }
 
+// reportErr forwards a scan error on the given prefix upstream, scanning
+// never blocks on error reporting.
+func (f *folderScanner) reportErr(prefix string, err error) {
+	if f.scanErrs == nil || err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	select {
+	case f.scanErrs <- nsScannerError{Bucket: f.newCache.Info.Name, Prefix: prefix, Err: err.Error(), Count: 1}:
+	default:
+	}
+}
+
 // scanFolder will scan the provided folder.
 // Files found in the folders will be added to f.newCache.
 // If final is provided folders will be put into f.newFolders or f.existingFolders.
@@ -495,8 +535,11 @@ func (f *folderScanner) scanFolder(ctx context.Context, folder cachedFolder, int
 			sz, err := f.getSize(item)
 			if err != nil {
 				wait() // wait to proceed to next entry.
-				if err != errSkipFile && f.dataUsageScannerDebug {
-					console.Debugf(scannerLogPrefix+" getSize \"%v/%v\" returned err: %v\n", bucket, item.objectPath(), err)
+				if err != errSkipFile {
+					f.reportErr(path.Dir(prefix), err)
+					if f.dataUsageScannerDebug {
+						console.Debugf(scannerLogPrefix+" getSize \"%v/%v\" returned err: %v\n", bucket, item.objectPath(), err)
+					}
 				}
 				return nil
 			}
@@ -511,6 +554,9 @@ func (f *folderScanner) scanFolder(ctx context.Context, folder cachedFolder, int
 			// object.
 			delete(abandonedChildren, path.Join(item.bucket, item.objectPath()))
 
+			if f.collectSizeHistogram && into.ObjSizesFine == nil {
+				into.ObjSizesFine = &fineSizeHistogram{}
+			}
 			into.addSizes(sz)
 			into.Objects++
 
@@ -519,6 +565,7 @@ func (f *folderScanner) scanFolder(ctx context.Context, folder cachedFolder, int
 			return nil
 		})
 		if err != nil {
+			f.reportErr(prefix, err)
 			return err
 		}
 
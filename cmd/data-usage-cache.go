@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/bits"
 	"math/rand"
 	"net/http"
 	"path"
@@ -32,6 +33,7 @@ import (
 	"time"
 
 	"github.com/cespare/xxhash/v2"
+	"github.com/dustin/go-humanize"
 	"github.com/klauspost/compress/zstd"
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio/internal/bucket/lifecycle"
@@ -51,6 +53,18 @@ type sizeHistogram [dataUsageBucketLen]uint64
 // versionsHistogram is a histogram of number of versions in an object.
 type versionsHistogram [dataUsageVersionLen]uint64
 
+// dataUsageFineBucketLen is the number of buckets in the fine-grained
+// size histogram, bucket i counts objects with 2^(i-1) <= size < 2^i,
+// bucket 0 counts empty objects and the last bucket absorbs everything
+// larger.
+const dataUsageFineBucketLen = 40
+
+// fineSizeHistogram is a power-of-two object size histogram, only
+// collected when the scan asks for it.
+type fineSizeHistogram struct {
+	Counts [dataUsageFineBucketLen]uint64 `msg:"c"`
+}
+
 type dataUsageEntry struct {
 	Children dataUsageHashMap `msg:"ch"`
 	// These fields do no include any children.
@@ -59,6 +73,7 @@ type dataUsageEntry struct {
 	Versions         uint64               `msg:"vs"` // Versions that are not delete markers.
 	ObjSizes         sizeHistogram        `msg:"szs"`
 	ObjVersions      versionsHistogram    `msg:"vh"`
+	ObjSizesFine     *fineSizeHistogram   `msg:"szf,omitempty"`
 	ReplicationStats *replicationAllStats `msg:"rs,omitempty"`
 	AllTierStats     *allTierStats        `msg:"ats,omitempty"`
 	Compacted        bool                 `msg:"c"`
@@ -288,15 +303,32 @@ type dataUsageCacheInfo struct {
 	// optional updates channel.
 	// If set updates will be sent regularly to this channel.
 	// Will not be closed when returned.
-	updates     chan<- dataUsageEntry `msg:"-"`
+	updates chan<- dataUsageEntry `msg:"-"`
+
+	// optional scanner errors channel.
+	// If set per-prefix scan errors will be sent to this channel.
+	// Will not be closed when returned.
+	scanErrs    chan<- nsScannerError `msg:"-"`
 	replication replicationConfig     `msg:"-"`
 }
 
+// nsScannerError records a scan error on a prefix, streamed alongside
+// dataUsageEntry updates so missing cache entries can be attributed.
+type nsScannerError struct {
+	Bucket string `msg:"b" json:"bucket"`
+	Prefix string `msg:"p" json:"prefix"`
+	Err    string `msg:"e" json:"error"`
+	Count  int    `msg:"n" json:"count"`
+}
+
 func (e *dataUsageEntry) addSizes(summary sizeSummary) {
 	e.Size += summary.totalSize
 	e.Versions += summary.versions
 	e.ObjSizes.add(summary.totalSize)
 	e.ObjVersions.add(summary.versions)
+	if e.ObjSizesFine != nil {
+		e.ObjSizesFine.add(summary.totalSize)
+	}
 
 	if e.ReplicationStats == nil {
 		e.ReplicationStats = &replicationAllStats{
@@ -361,6 +393,13 @@ func (e *dataUsageEntry) merge(other dataUsageEntry) {
 		e.ObjVersions[i] += v
 	}
 
+	if other.ObjSizesFine != nil {
+		if e.ObjSizesFine == nil {
+			e.ObjSizesFine = &fineSizeHistogram{}
+		}
+		e.ObjSizesFine.merge(other.ObjSizesFine)
+	}
+
 	if other.AllTierStats != nil {
 		if e.AllTierStats == nil {
 			e.AllTierStats = newAllTierStats()
@@ -721,6 +760,38 @@ func (h *sizeHistogram) toMap() map[string]uint64 {
 	return res
 }
 
+// add a size to the fine-grained histogram.
+func (h *fineSizeHistogram) add(size int64) {
+	if size < 0 {
+		return
+	}
+	idx := bits.Len64(uint64(size))
+	if idx >= dataUsageFineBucketLen {
+		idx = dataUsageFineBucketLen - 1
+	}
+	h.Counts[idx]++
+}
+
+// merge other into this histogram.
+func (h *fineSizeHistogram) merge(other *fineSizeHistogram) {
+	for i, v := range other.Counts[:] {
+		h.Counts[i] += v
+	}
+}
+
+// toMap returns the histogram as a map keyed by the upper bound of
+// each non-empty bucket.
+func (h *fineSizeHistogram) toMap() map[string]uint64 {
+	res := make(map[string]uint64, dataUsageFineBucketLen)
+	for i, count := range h.Counts {
+		if count == 0 {
+			continue
+		}
+		res[humanize.IBytes(1<<uint(i))] = count
+	}
+	return res
+}
+
 // add a version count to the histogram.
 func (h *versionsHistogram) add(versions uint64) {
 	// Fetch the histogram interval corresponding
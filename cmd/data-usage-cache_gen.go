@@ -1558,6 +1558,59 @@ func (z *dataUsageEntry) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "szf":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "ObjSizesFine")
+					return
+				}
+				z.ObjSizesFine = nil
+			} else {
+				if z.ObjSizesFine == nil {
+					z.ObjSizesFine = new(fineSizeHistogram)
+				}
+				var zb0004 uint32
+				zb0004, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "ObjSizesFine")
+					return
+				}
+				for zb0004 > 0 {
+					zb0004--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "ObjSizesFine")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "c":
+						var zb0005 uint32
+						zb0005, err = dc.ReadArrayHeader()
+						if err != nil {
+							err = msgp.WrapError(err, "ObjSizesFine", "Counts")
+							return
+						}
+						if zb0005 != uint32(dataUsageFineBucketLen) {
+							err = msgp.ArrayError{Wanted: uint32(dataUsageFineBucketLen), Got: zb0005}
+							return
+						}
+						for za0003 := range z.ObjSizesFine.Counts {
+							z.ObjSizesFine.Counts[za0003], err = dc.ReadUint64()
+							if err != nil {
+								err = msgp.WrapError(err, "ObjSizesFine", "Counts", za0003)
+								return
+							}
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "ObjSizesFine")
+							return
+						}
+					}
+				}
+			}
 		case "rs":
 			if dc.IsNil() {
 				err = dc.ReadNil()
@@ -1614,17 +1667,21 @@ func (z *dataUsageEntry) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 	// omitempty: check for empty values
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(10)
+	var zb0001Mask uint16 /* 10 bits */
 	_ = zb0001Mask
-	if z.ReplicationStats == nil {
+	if z.ObjSizesFine == nil {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if z.AllTierStats == nil {
+	if z.ReplicationStats == nil {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
+	if z.AllTierStats == nil {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -1708,6 +1765,38 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 		}
 	}
 	if (zb0001Mask & 0x40) == 0 { // if not empty
+		// write "szf"
+		err = en.Append(0xa3, 0x73, 0x7a, 0x66)
+		if err != nil {
+			return
+		}
+		if z.ObjSizesFine == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			// map header, size 1
+			// write "c"
+			err = en.Append(0x81, 0xa1, 0x63)
+			if err != nil {
+				return
+			}
+			err = en.WriteArrayHeader(uint32(dataUsageFineBucketLen))
+			if err != nil {
+				err = msgp.WrapError(err, "ObjSizesFine", "Counts")
+				return
+			}
+			for za0003 := range z.ObjSizesFine.Counts {
+				err = en.WriteUint64(z.ObjSizesFine.Counts[za0003])
+				if err != nil {
+					err = msgp.WrapError(err, "ObjSizesFine", "Counts", za0003)
+					return
+				}
+			}
+		}
+	}
+	if (zb0001Mask & 0x80) == 0 { // if not empty
 		// write "rs"
 		err = en.Append(0xa2, 0x72, 0x73)
 		if err != nil {
@@ -1726,7 +1815,7 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 			}
 		}
 	}
-	if (zb0001Mask & 0x80) == 0 { // if not empty
+	if (zb0001Mask & 0x100) == 0 { // if not empty
 		// write "ats"
 		err = en.Append(0xa3, 0x61, 0x74, 0x73)
 		if err != nil {
@@ -1762,17 +1851,21 @@ func (z *dataUsageEntry) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(10)
+	var zb0001Mask uint16 /* 10 bits */
 	_ = zb0001Mask
-	if z.ReplicationStats == nil {
+	if z.ObjSizesFine == nil {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if z.AllTierStats == nil {
+	if z.ReplicationStats == nil {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
+	if z.AllTierStats == nil {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 	if zb0001Len == 0 {
@@ -1807,6 +1900,21 @@ func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 		o = msgp.AppendUint64(o, z.ObjVersions[za0002])
 	}
 	if (zb0001Mask & 0x40) == 0 { // if not empty
+		// string "szf"
+		o = append(o, 0xa3, 0x73, 0x7a, 0x66)
+		if z.ObjSizesFine == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			// map header, size 1
+			// string "c"
+			o = append(o, 0x81, 0xa1, 0x63)
+			o = msgp.AppendArrayHeader(o, uint32(dataUsageFineBucketLen))
+			for za0003 := range z.ObjSizesFine.Counts {
+				o = msgp.AppendUint64(o, z.ObjSizesFine.Counts[za0003])
+			}
+		}
+	}
+	if (zb0001Mask & 0x80) == 0 { // if not empty
 		// string "rs"
 		o = append(o, 0xa2, 0x72, 0x73)
 		if z.ReplicationStats == nil {
@@ -1819,7 +1927,7 @@ func (z *dataUsageEntry) MarshalMsg(b []byte) (o []byte, err error) {
 			}
 		}
 	}
-	if (zb0001Mask & 0x80) == 0 { // if not empty
+	if (zb0001Mask & 0x100) == 0 { // if not empty
 		// string "ats"
 		o = append(o, 0xa3, 0x61, 0x74, 0x73)
 		if z.AllTierStats == nil {
@@ -1916,6 +2024,58 @@ func (z *dataUsageEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "szf":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.ObjSizesFine = nil
+			} else {
+				if z.ObjSizesFine == nil {
+					z.ObjSizesFine = new(fineSizeHistogram)
+				}
+				var zb0004 uint32
+				zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ObjSizesFine")
+					return
+				}
+				for zb0004 > 0 {
+					zb0004--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "ObjSizesFine")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "c":
+						var zb0005 uint32
+						zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "ObjSizesFine", "Counts")
+							return
+						}
+						if zb0005 != uint32(dataUsageFineBucketLen) {
+							err = msgp.ArrayError{Wanted: uint32(dataUsageFineBucketLen), Got: zb0005}
+							return
+						}
+						for za0003 := range z.ObjSizesFine.Counts {
+							z.ObjSizesFine.Counts[za0003], bts, err = msgp.ReadUint64Bytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "ObjSizesFine", "Counts", za0003)
+								return
+							}
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "ObjSizesFine")
+							return
+						}
+					}
+				}
+			}
 		case "rs":
 			if msgp.IsNil(bts) {
 				bts, err = msgp.ReadNilBytes(bts)
@@ -1970,7 +2130,13 @@ func (z *dataUsageEntry) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *dataUsageEntry) Msgsize() (s int) {
-	s = 1 + 3 + z.Children.Msgsize() + 3 + msgp.Int64Size + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.ArrayHeaderSize + (dataUsageBucketLen * (msgp.Uint64Size)) + 3 + msgp.ArrayHeaderSize + (dataUsageVersionLen * (msgp.Uint64Size)) + 3
+	s = 1 + 3 + z.Children.Msgsize() + 3 + msgp.Int64Size + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size + 4 + msgp.ArrayHeaderSize + (dataUsageBucketLen * (msgp.Uint64Size)) + 3 + msgp.ArrayHeaderSize + (dataUsageVersionLen * (msgp.Uint64Size)) + 4
+	if z.ObjSizesFine == nil {
+		s += msgp.NilSize
+	} else {
+		s += 1 + 2 + msgp.ArrayHeaderSize + (dataUsageFineBucketLen * (msgp.Uint64Size))
+	}
+	s += 3
 	if z.ReplicationStats == nil {
 		s += msgp.NilSize
 	} else {
@@ -2711,6 +2877,321 @@ func (z dataUsageHash) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *fineSizeHistogram) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "c":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Counts")
+				return
+			}
+			if zb0002 != uint32(dataUsageFineBucketLen) {
+				err = msgp.ArrayError{Wanted: uint32(dataUsageFineBucketLen), Got: zb0002}
+				return
+			}
+			for za0001 := range z.Counts {
+				z.Counts[za0001], err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "Counts", za0001)
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *fineSizeHistogram) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 1
+	// write "c"
+	err = en.Append(0x81, 0xa1, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(dataUsageFineBucketLen))
+	if err != nil {
+		err = msgp.WrapError(err, "Counts")
+		return
+	}
+	for za0001 := range z.Counts {
+		err = en.WriteUint64(z.Counts[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, "Counts", za0001)
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *fineSizeHistogram) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "c"
+	o = append(o, 0x81, 0xa1, 0x63)
+	o = msgp.AppendArrayHeader(o, uint32(dataUsageFineBucketLen))
+	for za0001 := range z.Counts {
+		o = msgp.AppendUint64(o, z.Counts[za0001])
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *fineSizeHistogram) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "c":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Counts")
+				return
+			}
+			if zb0002 != uint32(dataUsageFineBucketLen) {
+				err = msgp.ArrayError{Wanted: uint32(dataUsageFineBucketLen), Got: zb0002}
+				return
+			}
+			for za0001 := range z.Counts {
+				z.Counts[za0001], bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Counts", za0001)
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *fineSizeHistogram) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ArrayHeaderSize + (dataUsageFineBucketLen * (msgp.Uint64Size))
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *nsScannerError) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "b":
+			z.Bucket, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Bucket")
+				return
+			}
+		case "p":
+			z.Prefix, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Prefix")
+				return
+			}
+		case "e":
+			z.Err, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Err")
+				return
+			}
+		case "n":
+			z.Count, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Count")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *nsScannerError) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "b"
+	err = en.Append(0x84, 0xa1, 0x62)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Bucket)
+	if err != nil {
+		err = msgp.WrapError(err, "Bucket")
+		return
+	}
+	// write "p"
+	err = en.Append(0xa1, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Prefix)
+	if err != nil {
+		err = msgp.WrapError(err, "Prefix")
+		return
+	}
+	// write "e"
+	err = en.Append(0xa1, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Err)
+	if err != nil {
+		err = msgp.WrapError(err, "Err")
+		return
+	}
+	// write "n"
+	err = en.Append(0xa1, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Count)
+	if err != nil {
+		err = msgp.WrapError(err, "Count")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *nsScannerError) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "b"
+	o = append(o, 0x84, 0xa1, 0x62)
+	o = msgp.AppendString(o, z.Bucket)
+	// string "p"
+	o = append(o, 0xa1, 0x70)
+	o = msgp.AppendString(o, z.Prefix)
+	// string "e"
+	o = append(o, 0xa1, 0x65)
+	o = msgp.AppendString(o, z.Err)
+	// string "n"
+	o = append(o, 0xa1, 0x6e)
+	o = msgp.AppendInt(o, z.Count)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *nsScannerError) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "b":
+			z.Bucket, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Bucket")
+				return
+			}
+		case "p":
+			z.Prefix, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Prefix")
+				return
+			}
+		case "e":
+			z.Err, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Err")
+				return
+			}
+		case "n":
+			z.Count, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Count")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *nsScannerError) Msgsize() (s int) {
+	s = 1 + 2 + msgp.StringPrefixSize + len(z.Bucket) + 2 + msgp.StringPrefixSize + len(z.Prefix) + 2 + msgp.StringPrefixSize + len(z.Err) + 2 + msgp.IntSize
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *replicationAllStats) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -94,6 +94,34 @@ type DataUsageInfo struct {
 
 	// TierStats contains per-tier stats of all configured remote tiers
 	TierStats *allTierStats `json:"tierStats,omitempty"`
+
+	// ScannerErrors contains per-bucket error summaries collected during
+	// the scan, entries missing from the usage numbers above are listed
+	// here with the prefix that failed to scan.
+	ScannerErrors []nsScannerError `json:"scannerErrors,omitempty"`
+}
+
+// nsScannerMaxErrors is the maximum number of distinct scanner error
+// summaries kept per scanner cycle.
+const nsScannerMaxErrors = 256
+
+// scannerErrorsSnapshot returns the aggregated scanner errors sorted by
+// bucket and prefix. Callers must hold the lock protecting the map.
+func scannerErrorsSnapshot(agg map[string]*nsScannerError) []nsScannerError {
+	if len(agg) == 0 {
+		return nil
+	}
+	errs := make([]nsScannerError, 0, len(agg))
+	for _, e := range agg {
+		errs = append(errs, *e)
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		if errs[i].Bucket != errs[j].Bucket {
+			return errs[i].Bucket < errs[j].Bucket
+		}
+		return errs[i].Prefix < errs[j].Prefix
+	})
+	return errs
 }
 
 func (dui DataUsageInfo) tierStats() []madmin.TierInfo {
@@ -620,3 +620,35 @@ func equalAsJSON(a, b interface{}) bool {
 	}
 	return bytes.Equal(aj, bj)
 }
+
+func TestFineSizeHistogram(t *testing.T) {
+	var h fineSizeHistogram
+	for _, sz := range []int64{0, 1, 1023, 1024, 1 << 20, -1} {
+		h.add(sz)
+	}
+	// Sizes land in power-of-two buckets, negatives are ignored.
+	for idx, want := range map[int]uint64{0: 1, 1: 1, 10: 1, 11: 1, 21: 1} {
+		if got := h.Counts[idx]; got != want {
+			t.Errorf("bucket %d: got %d, want %d", idx, got, want)
+		}
+	}
+	h.add(1 << 62) // Oversized entries land in the last bucket.
+	if got := h.Counts[dataUsageFineBucketLen-1]; got != 1 {
+		t.Errorf("last bucket: got %d, want 1", got)
+	}
+
+	var other fineSizeHistogram
+	other.add(1024)
+	h.merge(&other)
+	if got := h.Counts[11]; got != 2 {
+		t.Errorf("bucket 11 after merge: got %d, want 2", got)
+	}
+
+	m := h.toMap()
+	if len(m) != 6 {
+		t.Errorf("toMap returned %d buckets, want 6: %v", len(m), m)
+	}
+	if m["2.0 KiB"] != 2 {
+		t.Errorf("unexpected 2KiB bucket: %v", m)
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/mimedb"
+	"github.com/minio/pkg/sync/errgroup"
+)
+
+// putObjectFanOutShared writes an unencrypted fan-out payload by erasure
+// encoding the content once and handing the resulting shards to every
+// destination object layout, instead of re-encoding the same bytes for
+// each key. It is only attempted on a single pool deployment where all
+// erasure sets share the same parameters; destinations whose set cannot
+// take the pre-encoded shards fall back to a regular PutObject.
+func (z *erasureServerPools) putObjectFanOutShared(ctx context.Context, bucket string, entries []minio.PutObjectFanOutEntry, buf []byte, opts fanOutOptions) ([]ObjectInfo, []error, bool) {
+	if !z.SinglePool() {
+		return nil, nil, false
+	}
+	pool := z.serverPools[0]
+
+	parityDrives := globalStorageClass.GetParityForSC("")
+	if parityDrives < 0 {
+		parityDrives = pool.defaultParityCount
+	}
+	dataDrives := pool.setDriveCount - parityDrives
+	if dataDrives <= 0 || parityDrives <= 0 {
+		return nil, nil, false
+	}
+	writeQuorum := dataDrives
+	if dataDrives == parityDrives {
+		writeQuorum++
+	}
+
+	erasure, err := NewErasure(ctx, dataDrives, parityDrives, blockSizeV2)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	// Encode the payload once into the same streaming bitrot format the
+	// per-disk writers produce, so the buffers can be written verbatim
+	// to each destination.
+	shardFileSize := erasure.ShardFileSize(int64(len(buf)))
+	buffers := make([]*bytes.Buffer, pool.setDriveCount)
+	writers := make([]io.Writer, pool.setDriveCount)
+	for i := range writers {
+		buffers[i] = bytes.NewBuffer(make([]byte, 0, bitrotShardFileSize(shardFileSize, erasure.ShardSize(), DefaultBitrotAlgorithm)))
+		writers[i] = newStreamingBitrotWriterBuffer(buffers[i], DefaultBitrotAlgorithm, erasure.ShardSize())
+	}
+
+	encodeBuf := make([]byte, erasure.blockSize)
+	n, err := erasure.Encode(ctx, bytes.NewReader(buf), writers, encodeBuf, writeQuorum)
+	closeBitrotWriters(writers)
+	if err != nil || n != int64(len(buf)) {
+		return nil, nil, false
+	}
+
+	shards := make([][]byte, len(buffers))
+	for i := range buffers {
+		shards[i] = buffers[i].Bytes()
+	}
+
+	// The content is the same for every destination, so is its ETag.
+	etag := getMD5Hash(buf)
+
+	errs := make([]error, len(entries))
+	objInfos := make([]ObjectInfo, len(entries))
+
+	var wg sync.WaitGroup
+	for i, req := range entries {
+		wg.Add(1)
+		go func(idx int, req minio.PutObjectFanOutEntry) {
+			defer wg.Done()
+
+			objInfos[idx] = ObjectInfo{Name: req.Key}
+
+			userDefined, err := fanOutUserDefined(bucket, req)
+			if err != nil {
+				errs[idx] = err
+				return
+			}
+
+			set := pool.getHashedSet(req.Key)
+			objInfo, err := set.putObjectFanOutShards(ctx, bucket, req.Key, shards, int64(len(buf)), shardFileSize, dataDrives, parityDrives, etag, ObjectOptions{
+				Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, req.Key),
+				VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, req.Key),
+				UserDefined:      userDefined,
+			})
+			if errors.Is(err, errFanOutFallback) {
+				objInfo, err = fanOutPutObjectEntry(ctx, bucket, z, req, buf, opts)
+			}
+			if err != nil {
+				errs[idx] = err
+				return
+			}
+			objInfos[idx] = objInfo
+		}(i, req)
+	}
+	wg.Wait()
+
+	return objInfos, errs, true
+}
+
+// putObjectFanOutShards writes one fan-out destination from pre-encoded
+// erasure shards, following the same commit sequence as putObject() but
+// skipping the erasure encode. Returns errFanOutFallback when this set
+// cannot take the shards as encoded, e.g. a storage class requests a
+// different parity or offline disks would upgrade it.
+func (er erasureObjects) putObjectFanOutShards(ctx context.Context, bucket, object string, shards [][]byte, size, shardFileSize int64, dataBlocks, parityBlocks int, etag string, opts ObjectOptions) (objInfo ObjectInfo, err error) {
+	auditObjectErasureSet(ctx, object, &er)
+
+	storageDisks := er.getDisks()
+	if len(storageDisks) != len(shards) {
+		return ObjectInfo{}, errFanOutFallback
+	}
+
+	userDefined := cloneMSS(opts.UserDefined)
+
+	parityDrives := globalStorageClass.GetParityForSC(userDefined[xhttp.AmzStorageClass])
+	if parityDrives < 0 {
+		parityDrives = er.defaultParityCount
+	}
+	if parityDrives != parityBlocks {
+		return ObjectInfo{}, errFanOutFallback
+	}
+	for _, disk := range storageDisks {
+		if disk == nil || !disk.IsOnline() {
+			// putObject() would upgrade parity for the offline disks,
+			// changing the layout the shards were encoded for.
+			return ObjectInfo{}, errFanOutFallback
+		}
+	}
+
+	// we now know the number of blocks this object needs for data and parity.
+	// writeQuorum is dataBlocks + 1
+	writeQuorum := dataBlocks
+	if dataBlocks == parityBlocks {
+		writeQuorum++
+	}
+
+	fi := newFileInfo(pathJoin(bucket, object), dataBlocks, parityBlocks)
+	fi.VersionID = opts.VersionID
+	if opts.Versioned && fi.VersionID == "" {
+		fi.VersionID = mustGetUUID()
+	}
+	fi.DataDir = mustGetUUID()
+
+	uniqueID := mustGetUUID()
+	tempObj := uniqueID
+
+	// Initialize erasure metadata.
+	partsMetadata := make([]FileInfo, len(storageDisks))
+	for index := range partsMetadata {
+		partsMetadata[index] = fi
+	}
+
+	// Order disks according to erasure distribution
+	onlineDisks, partsMetadata := shuffleDisksAndPartsMetadata(storageDisks, partsMetadata, fi)
+
+	inlineData := shardFileSize >= 0 &&
+		((!opts.Versioned && shardFileSize < smallFileThreshold) || shardFileSize < smallFileThreshold/8)
+
+	partName := "part.1"
+	tempErasureObj := pathJoin(uniqueID, fi.DataDir, partName)
+
+	// Delete temporary object in the event of failure.
+	var online int
+	defer func() {
+		if online != len(onlineDisks) {
+			er.deleteAll(context.Background(), minioMetaTmpBucket, tempObj)
+		}
+	}()
+
+	if !inlineData {
+		g := errgroup.WithNErrs(len(onlineDisks))
+		for index := range onlineDisks {
+			index := index
+			g.Go(func() error {
+				if onlineDisks[index] == nil {
+					return errDiskNotFound
+				}
+				return onlineDisks[index].CreateFile(ctx, minioMetaTmpBucket, tempErasureObj, int64(len(shards[index])), bytes.NewReader(shards[index]))
+			}, index)
+		}
+		errs := g.Wait()
+		if err := reduceWriteQuorumErrs(ctx, errs, objectOpIgnoredErrs, writeQuorum); err != nil {
+			return ObjectInfo{}, toObjectErr(err, minioMetaTmpBucket, tempErasureObj)
+		}
+		for i, err := range errs {
+			if err != nil {
+				onlineDisks[i] = nil
+			}
+		}
+	}
+
+	if !opts.NoLock {
+		lk := er.NewNSLock(bucket, object)
+		lkctx, err := lk.GetLock(ctx, globalOperationTimeout)
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		ctx = lkctx.Context()
+		defer lk.Unlock(lkctx)
+	}
+
+	modTime := opts.MTime
+	if opts.MTime.IsZero() {
+		modTime = UTCNow()
+	}
+
+	for i := range partsMetadata {
+		if onlineDisks[i] == nil {
+			continue
+		}
+		if inlineData {
+			partsMetadata[i].Data = shards[i]
+		} else {
+			partsMetadata[i].Data = nil
+		}
+		// No need to add checksum to part. We already have it on the object.
+		partsMetadata[i].AddObjectPart(1, "", size, size, modTime, nil, nil)
+		partsMetadata[i].Erasure.AddChecksumInfo(ChecksumInfo{
+			PartNumber: 1,
+			Algorithm:  DefaultBitrotAlgorithm,
+		})
+	}
+
+	userDefined["etag"] = etag
+
+	// Guess content-type from the extension if possible.
+	if userDefined["content-type"] == "" {
+		userDefined["content-type"] = mimedb.TypeByExtension(path.Ext(object))
+	}
+
+	// Fill all the necessary metadata.
+	// Update `xl.meta` content on each disks.
+	for index := range partsMetadata {
+		partsMetadata[index].Metadata = userDefined
+		partsMetadata[index].Size = size
+		partsMetadata[index].ModTime = modTime
+		if inlineData {
+			// Set an additional header when data is inlined.
+			partsMetadata[index].SetInlineData()
+		}
+	}
+
+	// Rename the successfully written temporary object to final location.
+	onlineDisks, versionsDisparity, err := renameData(ctx, onlineDisks, minioMetaTmpBucket, tempObj, partsMetadata, bucket, object, writeQuorum)
+	if err != nil {
+		if errors.Is(err, errFileNotFound) {
+			return ObjectInfo{}, toObjectErr(errErasureWriteQuorum, bucket, object)
+		}
+		logger.LogIf(ctx, err)
+		return ObjectInfo{}, toObjectErr(err, bucket, object)
+	}
+
+	for i := 0; i < len(onlineDisks); i++ {
+		if onlineDisks[i] != nil && onlineDisks[i].IsOnline() {
+			// Object info is the same in all disks, so we can pick
+			// the first meta from online disk
+			fi = partsMetadata[i]
+			break
+		}
+	}
+
+	// Whether a disk was initially or becomes offline
+	// during this upload, send it to the MRF list.
+	for i := 0; i < len(onlineDisks); i++ {
+		if onlineDisks[i] != nil && onlineDisks[i].IsOnline() {
+			continue
+		}
+
+		er.addPartial(bucket, object, fi.VersionID)
+		break
+	}
+
+	if versionsDisparity {
+		listAndHeal(ctx, bucket, object, &er, healObjectVersionsDisparity)
+	}
+
+	fi.ReplicationState = opts.PutReplicationState()
+	online = countOnlineDisks(onlineDisks)
+
+	// we are adding a new version to this object under the namespace lock, so this is the latest version.
+	fi.IsLatest = true
+
+	return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), nil
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/minio/madmin-go/v2"
@@ -795,16 +796,24 @@ func (er *erasureObjects) checkAbandonedParts(ctx context.Context, bucket string
 		defer lk.Unlock(lkctx)
 	}
 	var wg sync.WaitGroup
+	var dataDirs, bytesReclaimed uint64
 	for _, disk := range er.getDisks() {
 		if disk != nil {
 			wg.Add(1)
 			go func(disk StorageAPI) {
 				defer wg.Done()
-				_ = disk.CleanAbandonedData(ctx, bucket, object)
+				res, err := disk.CleanAbandonedData(ctx, bucket, object)
+				if err == nil {
+					atomic.AddUint64(&dataDirs, uint64(res.DataDirs))
+					atomic.AddUint64(&bytesReclaimed, res.BytesReclaimed)
+				}
 			}(disk)
 		}
 	}
 	wg.Wait()
+	if dataDirs > 0 {
+		logger.Info("checkAbandonedParts: removed %d abandoned data dir(s) reclaiming %d byte(s) for %s", dataDirs, bytesReclaimed, pathJoin(bucket, decodeDirObject(object)))
+	}
 	return nil
 }
 
@@ -841,7 +850,7 @@ func (er *erasureObjects) healObjectDir(ctx context.Context, bucket, object stri
 				wg.Add(1)
 				go func(index int, disk StorageAPI) {
 					defer wg.Done()
-					_ = disk.Delete(ctx, bucket, object, DeleteOptions{
+					_, _ = disk.Delete(ctx, bucket, object, DeleteOptions{
 						Recursive: false,
 						Force:     false,
 					})
@@ -18,8 +18,10 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -116,7 +118,7 @@ func (er erasureObjects) removePartMeta(bucket, object, uploadID, dataDir string
 		}
 		index := index
 		g.Go(func() error {
-			_ = storageDisks[index].Delete(context.TODO(), minioMetaMultipartBucket, curpartPath+".meta", DeleteOptions{
+			_, _ = storageDisks[index].Delete(context.TODO(), minioMetaMultipartBucket, curpartPath+".meta", DeleteOptions{
 				Recursive: false,
 				Force:     false,
 			})
@@ -143,11 +145,11 @@ func (er erasureObjects) removeObjectPart(bucket, object, uploadID, dataDir stri
 			// Ignoring failure to remove parts that weren't present in CompleteMultipartUpload
 			// requests. xl.meta is the authoritative source of truth on which parts constitute
 			// the object. The presence of parts that don't belong in the object doesn't affect correctness.
-			_ = storageDisks[index].Delete(context.TODO(), minioMetaMultipartBucket, curpartPath, DeleteOptions{
+			_, _ = storageDisks[index].Delete(context.TODO(), minioMetaMultipartBucket, curpartPath, DeleteOptions{
 				Recursive: false,
 				Force:     false,
 			})
-			_ = storageDisks[index].Delete(context.TODO(), minioMetaMultipartBucket, curpartPath+".meta", DeleteOptions{
+			_, _ = storageDisks[index].Delete(context.TODO(), minioMetaMultipartBucket, curpartPath+".meta", DeleteOptions{
 				Recursive: false,
 				Force:     false,
 			})
@@ -298,8 +300,10 @@ func (er erasureObjects) ListMultipartUploads(ctx context.Context, bucket, objec
 		}
 		populatedUploadIds.Add(uploadID)
 		uploads = append(uploads, MultipartInfo{
-			Object:    object,
-			UploadID:  uploadID,
+			Object: object,
+			// Return the client facing upload ID, the on-disk UUID is
+			// an internal detail and never matches client sent markers.
+			UploadID:  base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s.%s", globalDeploymentID, uploadID))),
 			Initiated: fi.ModTime,
 		})
 	}
@@ -324,6 +328,9 @@ func (er erasureObjects) ListMultipartUploads(ctx context.Context, bucket, objec
 	}
 	for uploadIndex < len(uploads) {
 		result.Uploads = append(result.Uploads, uploads[uploadIndex])
+		// Carry a usable key marker alongside the upload ID marker,
+		// SDK pagination loops send both back on the next page.
+		result.NextKeyMarker = uploads[uploadIndex].Object
 		result.NextUploadIDMarker = uploads[uploadIndex].UploadID
 		uploadIndex++
 		if len(result.Uploads) == maxUploads {
@@ -558,7 +565,8 @@ func writeAllDisks(ctx context.Context, disks []StorageAPI, dstBucket, dstEntry
 			}
 			index := index
 			g.Go(func() error {
-				return disks[index].Delete(ctx, dstBucket, dstEntry, DeleteOptions{Force: true})
+				_, err := disks[index].Delete(ctx, dstBucket, dstEntry, DeleteOptions{Force: true})
+				return err
 			}, index)
 		}
 		// Ignore these errors.
@@ -576,6 +584,11 @@ func writeAllDisks(ctx context.Context, disks []StorageAPI, dstBucket, dstEntry
 func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, r *PutObjReader, opts ObjectOptions) (pi PartInfo, err error) {
 	auditObjectErasureSet(ctx, object, &er)
 
+	if opts.InlineChecksums {
+		// Verify the internode shard writes inline for this operation.
+		ctx = contextWithInlineHash(ctx)
+	}
+
 	// Read lock for upload id.
 	// Only held while reading the upload metadata.
 	uploadIDRLock := er.NewNSLock(bucket, pathJoin(object, uploadID))
@@ -752,15 +765,16 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 
 	// Return success.
 	return PartInfo{
-		PartNumber:     partInfo.Number,
-		ETag:           partInfo.ETag,
-		LastModified:   partInfo.ModTime,
-		Size:           partInfo.Size,
-		ActualSize:     partInfo.ActualSize,
-		ChecksumCRC32:  partInfo.Checksums["CRC32"],
-		ChecksumCRC32C: partInfo.Checksums["CRC32C"],
-		ChecksumSHA1:   partInfo.Checksums["SHA1"],
-		ChecksumSHA256: partInfo.Checksums["SHA256"],
+		PartNumber:        partInfo.Number,
+		ETag:              partInfo.ETag,
+		LastModified:      partInfo.ModTime,
+		Size:              partInfo.Size,
+		ActualSize:        partInfo.ActualSize,
+		ChecksumCRC32:     partInfo.Checksums["CRC32"],
+		ChecksumCRC32C:    partInfo.Checksums["CRC32C"],
+		ChecksumCRC64NVME: partInfo.Checksums["CRC64NVME"],
+		ChecksumSHA1:      partInfo.Checksums["SHA1"],
+		ChecksumSHA256:    partInfo.Checksums["SHA256"],
 	}, nil
 }
 
@@ -901,15 +915,16 @@ func (er erasureObjects) ListObjectParts(ctx context.Context, bucket, object, up
 	result.Parts = make([]PartInfo, 0, len(parts))
 	for _, part := range parts {
 		result.Parts = append(result.Parts, PartInfo{
-			PartNumber:     part.Number,
-			ETag:           part.ETag,
-			LastModified:   part.ModTime,
-			ActualSize:     part.ActualSize,
-			Size:           part.Size,
-			ChecksumCRC32:  part.Checksums["CRC32"],
-			ChecksumCRC32C: part.Checksums["CRC32C"],
-			ChecksumSHA1:   part.Checksums["SHA1"],
-			ChecksumSHA256: part.Checksums["SHA256"],
+			PartNumber:        part.Number,
+			ETag:              part.ETag,
+			LastModified:      part.ModTime,
+			ActualSize:        part.ActualSize,
+			Size:              part.Size,
+			ChecksumCRC32:     part.Checksums["CRC32"],
+			ChecksumCRC32C:    part.Checksums["CRC32C"],
+			ChecksumCRC64NVME: part.Checksums["CRC64NVME"],
+			ChecksumSHA1:      part.Checksums["SHA1"],
+			ChecksumSHA256:    part.Checksums["SHA256"],
 		})
 		if len(result.Parts) >= maxParts {
 			break
@@ -993,6 +1008,9 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 
 	var checksumCombined []byte
 
+	// Full object checksum accumulated across parts for CRC-64/NVME.
+	var crc64Combined uint64
+
 	// However, in case of encryption, the persisted part ETags don't match
 	// what we have sent to the client during PutObjectPart. The reason is
 	// that ETags are encrypted. Hence, the client will send a list of complete
@@ -1112,10 +1130,11 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 				}
 			}
 			wantCS := map[string]string{
-				hash.ChecksumCRC32.String():  part.ChecksumCRC32,
-				hash.ChecksumCRC32C.String(): part.ChecksumCRC32C,
-				hash.ChecksumSHA1.String():   part.ChecksumSHA1,
-				hash.ChecksumSHA256.String(): part.ChecksumSHA256,
+				hash.ChecksumCRC32.String():     part.ChecksumCRC32,
+				hash.ChecksumCRC32C.String():    part.ChecksumCRC32C,
+				hash.ChecksumCRC64NVME.String(): part.ChecksumCRC64NVME,
+				hash.ChecksumSHA1.String():      part.ChecksumSHA1,
+				hash.ChecksumSHA256.String():    part.ChecksumSHA256,
 			}
 			if wantCS[checksumType.String()] != crc {
 				return oi, InvalidPart{
@@ -1131,6 +1150,11 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 				}
 			}
 			checksumCombined = append(checksumCombined, cs.Raw...)
+			if checksumType.Is(hash.ChecksumCRC64NVME) {
+				// CRC-64/NVME is linear, combine the part checksums into the
+				// checksum of the full object content.
+				crc64Combined = hash.ChecksumCRC64NVMECombine(crc64Combined, binary.BigEndian.Uint64(cs.Raw), expPart.ActualSize)
+			}
 		}
 
 		// All parts except the last part has to be at least 5MB.
@@ -1159,15 +1183,33 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 		}
 	}
 
+	var crc64Raw []byte
+	if checksumType.Is(hash.ChecksumCRC64NVME) {
+		crc64Raw = binary.BigEndian.AppendUint64(nil, crc64Combined)
+	}
+
 	if opts.WantChecksum != nil {
-		err := opts.WantChecksum.Matches(checksumCombined)
-		if err != nil {
+		if crc64Raw != nil {
+			// The client sends the full object checksum, not a checksum of
+			// the part checksums.
+			if !bytes.Equal(opts.WantChecksum.Raw, crc64Raw) {
+				return oi, hash.ChecksumMismatch{
+					Want: opts.WantChecksum.Encoded,
+					Got:  base64.StdEncoding.EncodeToString(crc64Raw),
+				}
+			}
+		} else if err := opts.WantChecksum.Matches(checksumCombined); err != nil {
 			return oi, err
 		}
 	}
 	if checksumType.IsSet() {
 		checksumType |= hash.ChecksumMultipart | hash.ChecksumIncludesMultipart
-		cs := hash.NewChecksumFromData(checksumType, checksumCombined)
+		var cs *hash.Checksum
+		if crc64Raw != nil {
+			cs = hash.NewChecksumWithType(checksumType, base64.StdEncoding.EncodeToString(crc64Raw))
+		} else {
+			cs = hash.NewChecksumFromData(checksumType, checksumCombined)
+		}
 		fi.Checksum = cs.AppendTo(nil, checksumCombined)
 		if opts.EncryptFn != nil {
 			fi.Checksum = opts.EncryptFn("object-checksum", fi.Checksum)
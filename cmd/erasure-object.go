@@ -51,6 +51,10 @@ import (
 // list all errors which can be ignored in object operations.
 var objectOpIgnoredErrs = append(baseIgnoredErrs, errDiskAccessDenied, errUnformattedDisk)
 
+// maximum number of re-read attempts when a metadata update loses the
+// optimistic concurrency check against a concurrent writer.
+const maxMetadataStaleRetries = 3
+
 // Object Operations
 
 func countOnlineDisks(onlineDisks []StorageAPI) (online int) {
@@ -187,6 +191,11 @@ func (er erasureObjects) CopyObject(ctx context.Context, srcBucket, srcObject, d
 func (er erasureObjects) GetObjectNInfo(ctx context.Context, bucket, object string, rs *HTTPRangeSpec, h http.Header, opts ObjectOptions) (gr *GetObjectReader, err error) {
 	auditObjectErasureSet(ctx, object, &er)
 
+	if opts.InlineChecksums {
+		// Verify the internode shard reads inline for this operation.
+		ctx = contextWithInlineHash(ctx)
+	}
+
 	// This is a special call attempted first to check for SOS-API calls.
 	gr, err = veeamSOSAPIGetObject(ctx, bucket, object, rs, opts)
 	if err == nil {
@@ -1009,6 +1018,11 @@ func healObjectVersionsDisparity(bucket string, entry metaCacheEntry) error {
 func (er erasureObjects) putObject(ctx context.Context, bucket string, object string, r *PutObjReader, opts ObjectOptions) (objInfo ObjectInfo, err error) {
 	auditObjectErasureSet(ctx, object, &er)
 
+	if opts.InlineChecksums {
+		// Verify the internode shard writes inline for this operation.
+		ctx = contextWithInlineHash(ctx)
+	}
+
 	data := r.Reader
 
 	if opts.CheckPrecondFn != nil {
@@ -1545,10 +1559,11 @@ func (er erasureObjects) deletePrefix(ctx context.Context, bucket, prefix string
 				Recursive: true,
 				Force:     true,
 			})
-			return disks[index].Delete(ctx, bucket, prefix, DeleteOptions{
+			_, err := disks[index].Delete(ctx, bucket, prefix, DeleteOptions{
 				Recursive: true,
 				Force:     true,
 			})
+			return err
 		}, index)
 	}
 	for _, err := range g.Wait() {
@@ -1763,64 +1778,71 @@ func (er erasureObjects) PutObjectMetadata(ctx context.Context, bucket, object s
 
 	disks := er.getDisks()
 
-	var metaArr []FileInfo
-	var errs []error
+	// Re-read and retry when a concurrent writer bumped the metadata
+	// generation between our read and the update.
+	for attempt := 0; ; attempt++ {
+		var metaArr []FileInfo
+		var errs []error
 
-	// Read metadata associated with the object from all disks.
-	if opts.VersionID != "" {
-		metaArr, errs = readAllFileInfo(ctx, disks, bucket, object, opts.VersionID, false)
-	} else {
-		metaArr, errs = readAllXL(ctx, disks, bucket, object, false, false)
-	}
+		// Read metadata associated with the object from all disks.
+		if opts.VersionID != "" {
+			metaArr, errs = readAllFileInfo(ctx, disks, bucket, object, opts.VersionID, false)
+		} else {
+			metaArr, errs = readAllXL(ctx, disks, bucket, object, false, false)
+		}
 
-	readQuorum, _, err := objectQuorumFromMeta(ctx, metaArr, errs, er.defaultParityCount)
-	if err != nil {
-		if errors.Is(err, errErasureReadQuorum) && !strings.HasPrefix(bucket, minioMetaBucket) {
-			_, derr := er.deleteIfDangling(ctx, bucket, object, metaArr, errs, nil, opts)
-			if derr != nil {
-				err = derr
+		readQuorum, _, err := objectQuorumFromMeta(ctx, metaArr, errs, er.defaultParityCount)
+		if err != nil {
+			if errors.Is(err, errErasureReadQuorum) && !strings.HasPrefix(bucket, minioMetaBucket) {
+				_, derr := er.deleteIfDangling(ctx, bucket, object, metaArr, errs, nil, opts)
+				if derr != nil {
+					err = derr
+				}
 			}
+			return ObjectInfo{}, toObjectErr(err, bucket, object)
 		}
-		return ObjectInfo{}, toObjectErr(err, bucket, object)
-	}
 
-	// List all online disks.
-	onlineDisks, modTime := listOnlineDisks(disks, metaArr, errs, readQuorum)
+		// List all online disks.
+		onlineDisks, modTime := listOnlineDisks(disks, metaArr, errs, readQuorum)
 
-	// Pick latest valid metadata.
-	fi, err := pickValidFileInfo(ctx, metaArr, modTime, readQuorum)
-	if err != nil {
-		return ObjectInfo{}, toObjectErr(err, bucket, object)
-	}
+		// Pick latest valid metadata.
+		fi, err := pickValidFileInfo(ctx, metaArr, modTime, readQuorum)
+		if err != nil {
+			return ObjectInfo{}, toObjectErr(err, bucket, object)
+		}
 
-	if fi.Deleted {
-		return ObjectInfo{}, toObjectErr(errMethodNotAllowed, bucket, object)
-	}
+		if fi.Deleted {
+			return ObjectInfo{}, toObjectErr(errMethodNotAllowed, bucket, object)
+		}
 
-	filterOnlineDisksInplace(fi, metaArr, onlineDisks)
+		filterOnlineDisksInplace(fi, metaArr, onlineDisks)
 
-	// if version-id is not specified retention is supposed to be set on the latest object.
-	if opts.VersionID == "" {
-		opts.VersionID = fi.VersionID
-	}
+		// if version-id is not specified retention is supposed to be set on the latest object.
+		if opts.VersionID == "" {
+			opts.VersionID = fi.VersionID
+		}
 
-	objInfo := fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended)
-	if opts.EvalMetadataFn != nil {
-		if err := opts.EvalMetadataFn(&objInfo); err != nil {
-			return ObjectInfo{}, err
+		objInfo := fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended)
+		if opts.EvalMetadataFn != nil {
+			if err := opts.EvalMetadataFn(&objInfo); err != nil {
+				return ObjectInfo{}, err
+			}
 		}
-	}
-	for k, v := range objInfo.UserDefined {
-		fi.Metadata[k] = v
-	}
-	fi.ModTime = opts.MTime
-	fi.VersionID = opts.VersionID
+		for k, v := range objInfo.UserDefined {
+			fi.Metadata[k] = v
+		}
+		fi.ModTime = opts.MTime
+		fi.VersionID = opts.VersionID
 
-	if err = er.updateObjectMeta(ctx, bucket, object, fi, onlineDisks); err != nil {
-		return ObjectInfo{}, toObjectErr(err, bucket, object)
-	}
+		if err = er.updateObjectMeta(ctx, bucket, object, fi, onlineDisks); err != nil {
+			if errors.Is(err, errMetadataStale) && attempt < maxMetadataStaleRetries {
+				continue
+			}
+			return ObjectInfo{}, toObjectErr(err, bucket, object)
+		}
 
-	return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), nil
+		return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), nil
+	}
 }
 
 // PutObjectTags - replace or add tags to an existing object
@@ -1836,55 +1858,62 @@ func (er erasureObjects) PutObjectTags(ctx context.Context, bucket, object strin
 
 	disks := er.getDisks()
 
-	var metaArr []FileInfo
-	var errs []error
+	// Re-read and retry when a concurrent writer bumped the metadata
+	// generation between our read and the update.
+	for attempt := 0; ; attempt++ {
+		var metaArr []FileInfo
+		var errs []error
 
-	// Read metadata associated with the object from all disks.
-	if opts.VersionID != "" {
-		metaArr, errs = readAllFileInfo(ctx, disks, bucket, object, opts.VersionID, false)
-	} else {
-		metaArr, errs = readAllXL(ctx, disks, bucket, object, false, false)
-	}
+		// Read metadata associated with the object from all disks.
+		if opts.VersionID != "" {
+			metaArr, errs = readAllFileInfo(ctx, disks, bucket, object, opts.VersionID, false)
+		} else {
+			metaArr, errs = readAllXL(ctx, disks, bucket, object, false, false)
+		}
 
-	readQuorum, _, err := objectQuorumFromMeta(ctx, metaArr, errs, er.defaultParityCount)
-	if err != nil {
-		if errors.Is(err, errErasureReadQuorum) && !strings.HasPrefix(bucket, minioMetaBucket) {
-			_, derr := er.deleteIfDangling(ctx, bucket, object, metaArr, errs, nil, opts)
-			if derr != nil {
-				err = derr
+		readQuorum, _, err := objectQuorumFromMeta(ctx, metaArr, errs, er.defaultParityCount)
+		if err != nil {
+			if errors.Is(err, errErasureReadQuorum) && !strings.HasPrefix(bucket, minioMetaBucket) {
+				_, derr := er.deleteIfDangling(ctx, bucket, object, metaArr, errs, nil, opts)
+				if derr != nil {
+					err = derr
+				}
 			}
+			return ObjectInfo{}, toObjectErr(err, bucket, object)
 		}
-		return ObjectInfo{}, toObjectErr(err, bucket, object)
-	}
 
-	// List all online disks.
-	onlineDisks, modTime := listOnlineDisks(disks, metaArr, errs, readQuorum)
+		// List all online disks.
+		onlineDisks, modTime := listOnlineDisks(disks, metaArr, errs, readQuorum)
 
-	// Pick latest valid metadata.
-	fi, err := pickValidFileInfo(ctx, metaArr, modTime, readQuorum)
-	if err != nil {
-		return ObjectInfo{}, toObjectErr(err, bucket, object)
-	}
-	if fi.Deleted {
-		if opts.VersionID == "" {
-			return ObjectInfo{}, toObjectErr(errFileNotFound, bucket, object)
+		// Pick latest valid metadata.
+		fi, err := pickValidFileInfo(ctx, metaArr, modTime, readQuorum)
+		if err != nil {
+			return ObjectInfo{}, toObjectErr(err, bucket, object)
+		}
+		if fi.Deleted {
+			if opts.VersionID == "" {
+				return ObjectInfo{}, toObjectErr(errFileNotFound, bucket, object)
+			}
+			return ObjectInfo{}, toObjectErr(errMethodNotAllowed, bucket, object)
 		}
-		return ObjectInfo{}, toObjectErr(errMethodNotAllowed, bucket, object)
-	}
 
-	filterOnlineDisksInplace(fi, metaArr, onlineDisks)
+		filterOnlineDisksInplace(fi, metaArr, onlineDisks)
 
-	fi.Metadata[xhttp.AmzObjectTagging] = tags
-	fi.ReplicationState = opts.PutReplicationState()
-	for k, v := range opts.UserDefined {
-		fi.Metadata[k] = v
-	}
+		fi.Metadata[xhttp.AmzObjectTagging] = tags
+		fi.ReplicationState = opts.PutReplicationState()
+		for k, v := range opts.UserDefined {
+			fi.Metadata[k] = v
+		}
 
-	if err = er.updateObjectMeta(ctx, bucket, object, fi, onlineDisks); err != nil {
-		return ObjectInfo{}, toObjectErr(err, bucket, object)
-	}
+		if err = er.updateObjectMeta(ctx, bucket, object, fi, onlineDisks); err != nil {
+			if errors.Is(err, errMetadataStale) && attempt < maxMetadataStaleRetries {
+				continue
+			}
+			return ObjectInfo{}, toObjectErr(err, bucket, object)
+		}
 
-	return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), nil
+		return fi.ToObjectInfo(bucket, object, opts.Versioned || opts.VersionSuspended), nil
+	}
 }
 
 // updateObjectMeta will update the metadata of a file.
@@ -1893,6 +1922,14 @@ func (er erasureObjects) updateObjectMeta(ctx context.Context, bucket, object st
 		return nil
 	}
 
+	// Only metadata changes here, a patch avoids shipping the full
+	// FileInfo to every disk.
+	patch := MetadataPatch{
+		VersionID: fi.VersionID,
+		Metadata:  fi.Metadata,
+		ModTime:   fi.ModTime,
+	}
+
 	g := errgroup.WithNErrs(len(onlineDisks))
 
 	// Start writing `xl.meta` to all disks in parallel.
@@ -1902,7 +1939,7 @@ func (er erasureObjects) updateObjectMeta(ctx context.Context, bucket, object st
 			if onlineDisks[index] == nil {
 				return errDiskNotFound
 			}
-			return onlineDisks[index].UpdateMetadata(ctx, bucket, object, fi)
+			return onlineDisks[index].PatchMetadata(ctx, bucket, object, patch)
 		}, index)
 	}
 
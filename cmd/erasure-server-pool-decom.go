@@ -571,12 +571,13 @@ func (z *erasureServerPools) decommissionObject(ctx context.Context, bucket stri
 				return fmt.Errorf("decommissionObject: PutObjectPart() %w", err)
 			}
 			parts[i] = CompletePart{
-				ETag:           pi.ETag,
-				PartNumber:     pi.PartNumber,
-				ChecksumCRC32:  pi.ChecksumCRC32,
-				ChecksumCRC32C: pi.ChecksumCRC32C,
-				ChecksumSHA256: pi.ChecksumSHA256,
-				ChecksumSHA1:   pi.ChecksumSHA1,
+				ETag:              pi.ETag,
+				PartNumber:        pi.PartNumber,
+				ChecksumCRC32:     pi.ChecksumCRC32,
+				ChecksumCRC32C:    pi.ChecksumCRC32C,
+				ChecksumCRC64NVME: pi.ChecksumCRC64NVME,
+				ChecksumSHA256:    pi.ChecksumSHA256,
+				ChecksumSHA1:      pi.ChecksumSHA1,
 			}
 		}
 		_, err = z.CompleteMultipartUpload(ctx, bucket, objInfo.Name, res.UploadID, parts, ObjectOptions{
@@ -302,6 +302,20 @@ func (z *erasureServerPools) getAvailablePoolIdx(ctx context.Context, bucket, ob
 	if total == 0 {
 		return -1
 	}
+	// Honor the bucket's pool pinning as long as the pinned pool can hold
+	// the object, otherwise fall back to regular pool selection.
+	if pinned := globalBucketMetadataSys.PinnedPool(bucket); pinned > 0 && pinned <= len(z.serverPools) {
+		for _, pool := range serverPools {
+			if pool.Index != pinned-1 {
+				continue
+			}
+			if pool.Available > 0 {
+				return pool.Index
+			}
+			break
+		}
+		logger.LogOnceIf(ctx, fmt.Errorf("bucket %s is pinned to pool %d which has no usable space, falling back to regular pool selection", bucket, pinned), "pinned-pool-full-"+bucket)
+	}
 	// choose when we reach this many
 	choose := rand.Uint64() % total
 	atTotal := uint64(0)
@@ -510,6 +524,23 @@ func (z *erasureServerPools) getPoolIdxNoLock(ctx context.Context, bucket, objec
 	return idx, nil
 }
 
+// getRoutedPoolIdxNoLock returns the pool a routed object lands in: the pool
+// already holding the object when it exists, the requested pool (1-based)
+// otherwise. Out of range requests fall back to regular pool selection.
+func (z *erasureServerPools) getRoutedPoolIdxNoLock(ctx context.Context, bucket, object string, size int64, pool int) (idx int, err error) {
+	if pool < 1 || pool > len(z.serverPools) {
+		return z.getPoolIdxNoLock(ctx, bucket, object, size)
+	}
+	idx, err = z.getPoolIdxExistingNoLock(ctx, bucket, object)
+	if err != nil && !isErrObjectNotFound(err) {
+		return idx, err
+	}
+	if isErrObjectNotFound(err) {
+		idx = pool - 1
+	}
+	return idx, nil
+}
+
 // getPoolIdx returns the found previous object and its corresponding pool idx,
 // if none are found falls back to most available space pool, this function is
 // designed to be only used by PutObject, CopyObject (newObject creation) and NewMultipartUpload.
@@ -613,6 +644,27 @@ func (z *erasureServerPools) NSScanner(ctx context.Context, updates chan<- DataU
 	var results []dataUsageCache
 	var firstErr error
 
+	// Aggregate streamed scanner errors per bucket/prefix/error, bounded
+	// to keep the report small on badly broken namespaces.
+	scanErrs := make(chan nsScannerError, 16)
+	scanErrAgg := make(map[string]*nsScannerError)
+	var scanErrsWg sync.WaitGroup
+	scanErrsWg.Add(1)
+	go func() {
+		defer scanErrsWg.Done()
+		for e := range scanErrs {
+			mu.Lock()
+			k := e.Bucket + "/" + e.Prefix + ": " + e.Err
+			if agg, ok := scanErrAgg[k]; ok {
+				agg.Count += e.Count
+			} else if len(scanErrAgg) < nsScannerMaxErrors {
+				e := e
+				scanErrAgg[k] = &e
+			}
+			mu.Unlock()
+		}
+	}()
+
 	allBuckets, err := z.ListBuckets(ctx, BucketOptions{})
 	if err != nil {
 		return err
@@ -646,7 +698,7 @@ func (z *erasureServerPools) NSScanner(ctx context.Context, updates chan<- DataU
 					}
 				}()
 				// Start scanner. Blocks until done.
-				err := erObj.nsScanner(ctx, allBuckets, wantCycle, updates, healScanMode)
+				err := erObj.nsScanner(ctx, allBuckets, wantCycle, updates, healScanMode, scanErrs)
 				if err != nil {
 					logger.LogIf(ctx, err)
 					mu.Lock()
@@ -684,7 +736,9 @@ func (z *erasureServerPools) NSScanner(ctx context.Context, updates chan<- DataU
 				allMerged.merge(info)
 			}
 			if allMerged.root() != nil && allMerged.Info.LastUpdate.After(lastUpdate) {
-				updates <- allMerged.dui(allMerged.Info.Name, allBuckets)
+				dui := allMerged.dui(allMerged.Info.Name, allBuckets)
+				dui.ScannerErrors = scannerErrorsSnapshot(scanErrAgg)
+				updates <- dui
 				lastUpdate = allMerged.Info.LastUpdate
 			}
 		}
@@ -703,6 +757,9 @@ func (z *erasureServerPools) NSScanner(ctx context.Context, updates chan<- DataU
 	}()
 
 	wg.Wait()
+	// All scanners returned, collect remaining errors before the final update.
+	close(scanErrs)
+	scanErrsWg.Wait()
 	ch := make(chan struct{})
 	select {
 	case updateCloser <- ch:
@@ -740,6 +797,13 @@ func (z *erasureServerPools) MakeBucket(ctx context.Context, bucket string, opts
 		}
 	}
 
+	if opts.PinnedPool > len(z.serverPools) {
+		return InvalidArgument{
+			Bucket: bucket,
+			Err:    fmt.Errorf("location constraint pins the bucket to pool %d, but only %d pool(s) are configured", opts.PinnedPool, len(z.serverPools)),
+		}
+	}
+
 	if err := z.s3Peer.MakeBucket(ctx, bucket, opts); err != nil {
 		if _, ok := err.(BucketExists); !ok {
 			// Delete created buckets, ignoring errors.
@@ -763,6 +827,8 @@ func (z *erasureServerPools) MakeBucket(ctx context.Context, bucket string, opts
 		meta.VersioningConfigXML = enabledBucketVersioningConfig
 	}
 
+	meta.PinnedPool = opts.PinnedPool
+
 	if err := meta.Save(context.Background(), z); err != nil {
 		return toObjectErr(err, bucket)
 	}
@@ -963,7 +1029,13 @@ func (z *erasureServerPools) PutObject(ctx context.Context, bucket string, objec
 		opts.NoLock = true
 	}
 
-	idx, err := z.getPoolIdxNoLock(ctx, bucket, object, data.Size())
+	var idx int
+	var err error
+	if opts.RoutePool > 0 {
+		idx, err = z.getRoutedPoolIdxNoLock(ctx, bucket, object, data.Size(), opts.RoutePool)
+	} else {
+		idx, err = z.getPoolIdxNoLock(ctx, bucket, object, data.Size())
+	}
 	if err != nil {
 		return ObjectInfo{}, err
 	}
@@ -1816,6 +1888,23 @@ func (z *erasureServerPools) Walk(ctx context.Context, bucket, prefix string, re
 							return
 						}
 
+						// Prune excluded subtrees before decoding any
+						// versions off the entry.
+						for _, exclude := range opts.WalkExcludePrefixes {
+							if strings.HasPrefix(entry.name, exclude) {
+								return
+							}
+						}
+
+						// Evaluate pushed down tag/metadata checks against
+						// the raw metadata, entries that cannot match are
+						// skipped without decoding their versions.
+						if opts.WalkPushdown != nil {
+							if matched, ok := opts.WalkPushdown.matchRawMeta(entry.metadata); ok && !matched {
+								return
+							}
+						}
+
 						fivs, err := entry.fileInfoVersions(bucket)
 						if err != nil {
 							cancel()
@@ -344,7 +344,7 @@ func (er erasureObjects) cleanupDeletedObjects(ctx context.Context) {
 
 // nsScanner will start scanning buckets and send updated totals as they are traversed.
 // Updates are sent on a regular basis and the caller *must* consume them.
-func (er erasureObjects) nsScanner(ctx context.Context, buckets []BucketInfo, wantCycle uint32, updates chan<- dataUsageCache, healScanMode madmin.HealScanMode) error {
+func (er erasureObjects) nsScanner(ctx context.Context, buckets []BucketInfo, wantCycle uint32, updates chan<- dataUsageCache, healScanMode madmin.HealScanMode, scanErrs chan<- nsScannerError) error {
 	if len(buckets) == 0 {
 		return nil
 	}
@@ -490,10 +490,25 @@ func (er erasureObjects) nsScanner(ctx context.Context, buckets []BucketInfo, wa
 						}
 					}
 				}(cache.Info.Name)
+				// Forward scan errors, the disk closes its channel when done.
+				diskScanErrs := make(chan nsScannerError, 1)
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for scanErr := range diskScanErrs {
+						if scanErrs == nil {
+							continue
+						}
+						select {
+						case <-ctx.Done():
+						case scanErrs <- scanErr:
+						}
+					}
+				}()
 				// Calc usage
 				before := cache.Info.LastUpdate
 				var err error
-				cache, err = disk.NSScanner(ctx, cache, updates, healScanMode)
+				cache, err = disk.NSScanner(ctx, cache, updates, healScanMode, diskScanErrs)
 				if err != nil {
 					if !cache.Info.LastUpdate.IsZero() && cache.Info.LastUpdate.After(before) {
 						logger.LogIf(ctx, cache.save(ctx, er, cacheName))
@@ -23,6 +23,7 @@ import (
 	"runtime"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/mem"
@@ -52,8 +53,21 @@ type apiConfig struct {
 	disableODirect              bool
 	gzipObjects                 bool
 	rootAccess                  bool
+	fanOutConcurrency           int
+
+	// Per method-class deadlines for storage REST calls, zero
+	// leaves the corresponding class unbounded.
+	storageMetadataDeadline   time.Duration
+	storageSmallReadDeadline  time.Duration
+	storageStreamReadDeadline time.Duration
+	storageWriteDeadline      time.Duration
 }
 
+// Number of fan-out uploads currently in progress across the server, each
+// one can hold an up to 16MiB payload buffer in memory. Used to back off
+// the per-request fan-out concurrency when many arrive at once.
+var globalFanOutInFlight atomic.Int64
+
 const cgroupLimitFile = "/sys/fs/cgroup/memory/memory.limit_in_bytes"
 
 func cgroupLimit(limitFile string) (limit uint64) {
@@ -154,6 +168,53 @@ func (t *apiConfig) init(cfg api.Config, setDriveCounts []int) {
 	t.disableODirect = cfg.DisableODirect
 	t.gzipObjects = cfg.GzipObjects
 	t.rootAccess = cfg.RootAccess
+	t.fanOutConcurrency = cfg.FanOutConcurrency
+	t.storageMetadataDeadline = cfg.StorageMetadataDeadline
+	t.storageSmallReadDeadline = cfg.StorageSmallReadDeadline
+	t.storageStreamReadDeadline = cfg.StorageStreamReadDeadline
+	t.storageWriteDeadline = cfg.StorageWriteDeadline
+}
+
+// getStorageDeadline returns the configured deadline for the given storage
+// REST method class, zero when the class is unbounded.
+func (t *apiConfig) getStorageDeadline(class storageCallClass) time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	switch class {
+	case storageCallMetadata:
+		return t.storageMetadataDeadline
+	case storageCallSmallRead:
+		return t.storageSmallReadDeadline
+	case storageCallStreamRead:
+		return t.storageStreamReadDeadline
+	case storageCallWrite:
+		return t.storageWriteDeadline
+	}
+	return 0
+}
+
+// getFanOutConcurrency returns the number of objects written concurrently
+// per fan-out upload, scaled down by the number of fan-out uploads already
+// in flight so simultaneous uploads do not collectively exhaust memory.
+func (t *apiConfig) getFanOutConcurrency(inFlight int) int {
+	t.mu.RLock()
+	fanOut := t.fanOutConcurrency
+	t.mu.RUnlock()
+
+	if fanOut <= 0 {
+		fanOut = 100
+		if runtime.GOMAXPROCS(0) < fanOut {
+			fanOut = runtime.GOMAXPROCS(0)
+		}
+	}
+	if inFlight > 1 {
+		fanOut /= inFlight
+	}
+	if fanOut < 1 {
+		fanOut = 1
+	}
+	return fanOut
 }
 
 func (t *apiConfig) isDisableODirect() bool {
@@ -18,12 +18,15 @@
 package cmd
 
 import (
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/textproto"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/minio/madmin-go/v2"
@@ -40,6 +43,37 @@ const (
 	replaceDirective = "REPLACE"
 )
 
+// maxBucketConfigSize - maximum size allowed for a bucket configuration
+// body after decompression, guards gzip-encoded bodies against
+// decompression bombs.
+const maxBucketConfigSize = 2 << 20 // 2 MiB
+
+// readBucketConfigBody reads a bucket configuration request body,
+// transparently decompressing it when sent with 'Content-Encoding: gzip'.
+// The decompressed content is capped at maxBucketConfigSize, oversized
+// payloads return ErrEntityTooLarge.
+func readBucketConfigBody(r *http.Request) ([]byte, APIErrorCode) {
+	body := io.Reader(r.Body)
+	if strings.EqualFold(strings.TrimSpace(r.Header.Get(xhttp.ContentEncoding)), "gzip") {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, ErrMalformedXML
+		}
+		defer gz.Close()
+		body = gz
+	} else if r.ContentLength >= 0 {
+		body = io.LimitReader(body, r.ContentLength)
+	}
+	data, err := io.ReadAll(io.LimitReader(body, maxBucketConfigSize+1))
+	if err != nil {
+		return nil, ErrMalformedXML
+	}
+	if len(data) > maxBucketConfigSize {
+		return nil, ErrEntityTooLarge
+	}
+	return data, ErrNone
+}
+
 // Parses location constraint from the incoming reader.
 func parseLocationConstraint(r *http.Request) (location string, s3Error APIErrorCode) {
 	// If the request has no body with content-length set to 0,
@@ -56,6 +90,11 @@ func parseLocationConstraint(r *http.Request) (location string, s3Error APIError
 	if location == "" {
 		location = globalSite.Region
 	}
+	if strings.HasPrefix(location, locationPoolHintPrefix) {
+		// "pool:N" pins the bucket to a server pool instead of naming a
+		// region, the pool reference is validated at bucket creation.
+		return location, ErrNone
+	}
 	if !isValidLocation(location) {
 		return location, ErrInvalidRegion
 	}
@@ -63,6 +102,25 @@ func parseLocationConstraint(r *http.Request) (location string, s3Error APIError
 	return location, ErrNone
 }
 
+// locationPoolHintPrefix identifies location constraint values of the form
+// "pool:N" that pin a bucket to the N'th server pool instead of naming a
+// region, pools are numbered starting at 1 in the order given on the
+// command line.
+const locationPoolHintPrefix = "pool:"
+
+// parseLocationPoolHint returns the 1-based pool ordinal carried in a
+// "pool:N" location constraint, zero when location is a plain region.
+func parseLocationPoolHint(location string) (int, error) {
+	if !strings.HasPrefix(location, locationPoolHintPrefix) {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(location, locationPoolHintPrefix))
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid pool in location constraint %s - expecting pool:N with N starting at 1", location)
+	}
+	return n, nil
+}
+
 // Validates input location is same as configured region
 // of MinIO server.
 func isValidLocation(location string) bool {
@@ -95,6 +95,34 @@ func TestIsValidLocationContraint(t *testing.T) {
 	}
 }
 
+// Tests validate the "pool:N" location constraint pool hint parsing.
+func TestParseLocationPoolHint(t *testing.T) {
+	testCases := []struct {
+		location  string
+		expPool   int
+		expectErr bool
+	}{
+		{"us-east-1", 0, false},
+		{"", 0, false},
+		{"pool:1", 1, false},
+		{"pool:2", 2, false},
+		{"pool:0", 0, true},
+		{"pool:-1", 0, true},
+		{"pool:second", 0, true},
+		{"pool:", 0, true},
+	}
+
+	for i, testCase := range testCases {
+		pool, err := parseLocationPoolHint(testCase.location)
+		if testCase.expectErr != (err != nil) {
+			t.Errorf("Test %d: Expected error %t, got %v", i+1, testCase.expectErr, err)
+		}
+		if pool != testCase.expPool {
+			t.Errorf("Test %d: Expected pool %d, got %d", i+1, testCase.expPool, pool)
+		}
+	}
+}
+
 // Tests validate metadata extraction from http headers.
 func TestExtractMetadataHeaders(t *testing.T) {
 	testCases := []struct {
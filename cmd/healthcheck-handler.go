@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strconv"
 	"time"
@@ -55,6 +56,12 @@ func ClusterCheckHandler(w http.ResponseWriter, r *http.Request) {
 	if result.WriteQuorum > 0 {
 		w.Header().Set(xhttp.MinIOWriteQuorum, strconv.Itoa(result.WriteQuorum))
 	}
+	if !globalBucketMetadataSys.Initialized() {
+		// Report warm-up progress so orchestrators can gate traffic
+		// until bucket configs are fully loaded.
+		loaded, total := globalBucketMetadataSys.WarmupProgress()
+		w.Header().Set(xhttp.MinIOBucketsWarming, fmt.Sprintf("%d/%d", loaded, total))
+	}
 	w.Header().Set(xhttp.MinIOStorageClassDefaults, strconv.FormatBool(result.UsingDefaults))
 
 	if !result.Healthy {
@@ -87,7 +87,9 @@ func init() {
 		getMinioVersionMetrics(),
 		getS3TTFBMetric(),
 		getNotificationMetrics(),
+		getScanWebhookMetrics(),
 		getDistLockMetrics(),
+		getFederationMetrics(),
 	}
 
 	nodeCollector = newMinioCollectorNode(nodeGroups)
@@ -127,6 +129,7 @@ const (
 	requestsRejectedSubsystem MetricSubsystem = "requests_rejected"
 	timeSubsystem             MetricSubsystem = "time"
 	trafficSubsystem          MetricSubsystem = "traffic"
+	tlsSubsystem              MetricSubsystem = "tls"
 	softwareSubsystem         MetricSubsystem = "software"
 	sysCallSubsystem          MetricSubsystem = "syscall"
 	usageSubsystem            MetricSubsystem = "usage"
@@ -136,9 +139,11 @@ const (
 	iamSubsystem              MetricSubsystem = "iam"
 	kmsSubsystem              MetricSubsystem = "kms"
 	notifySubsystem           MetricSubsystem = "notify"
+	scanWebhookSubsystem      MetricSubsystem = "scan_webhook"
 	lambdaSubsystem           MetricSubsystem = "lambda"
 	auditSubsystem            MetricSubsystem = "audit"
 	webhookSubsystem          MetricSubsystem = "webhook"
+	federationSubsystem       MetricSubsystem = "federation"
 )
 
 // MetricName are the individual names for the metric.
@@ -628,6 +633,46 @@ func getInternodeFailedRequests() MetricDescription {
 	}
 }
 
+func getInternodeShedRequests() MetricDescription {
+	return MetricDescription{
+		Namespace: interNodeMetricNamespace,
+		Subsystem: trafficSubsystem,
+		Name:      "shed_requests_total",
+		Help:      "Total number of internode calls shed due to high remote queue depth",
+		Type:      counterMetric,
+	}
+}
+
+func getInternodeTLSCertExpiryMD() MetricDescription {
+	return MetricDescription{
+		Namespace: interNodeMetricNamespace,
+		Subsystem: tlsSubsystem,
+		Name:      "cert_expiry_days",
+		Help:      "Days until the TLS certificate served by the remote endpoint expires",
+		Type:      gaugeMetric,
+	}
+}
+
+func getInternodeConnsDialedMD() MetricDescription {
+	return MetricDescription{
+		Namespace: interNodeMetricNamespace,
+		Subsystem: trafficSubsystem,
+		Name:      "conns_dialed_total",
+		Help:      "Total number of internode calls to the remote endpoint that needed a freshly dialed connection",
+		Type:      counterMetric,
+	}
+}
+
+func getInternodeConnsReusedMD() MetricDescription {
+	return MetricDescription{
+		Namespace: interNodeMetricNamespace,
+		Subsystem: trafficSubsystem,
+		Name:      "conns_reused_total",
+		Help:      "Total number of internode calls to the remote endpoint served over a reused keep-alive connection",
+		Type:      counterMetric,
+	}
+}
+
 func getInternodeTCPDialTimeout() MetricDescription {
 	return MetricDescription{
 		Namespace: interNodeMetricNamespace,
@@ -1861,6 +1906,59 @@ func getNotificationMetrics() *MetricsGroup {
 	return mg
 }
 
+func getScanWebhookMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(ctx context.Context) []Metric {
+		metrics := make([]Metric, 0, 4)
+		metrics = append(metrics, Metric{
+			Description: MetricDescription{
+				Namespace: minioNamespace,
+				Subsystem: scanWebhookSubsystem,
+				Name:      "verdicts_total",
+				Help:      "Total number of scan webhook verdicts by result",
+				Type:      counterMetric,
+			},
+			VariableLabels: map[string]string{"verdict": "clean"},
+			Value:          float64(globalScanStats.cleanVerdicts.Load()),
+		})
+		metrics = append(metrics, Metric{
+			Description: MetricDescription{
+				Namespace: minioNamespace,
+				Subsystem: scanWebhookSubsystem,
+				Name:      "verdicts_total",
+				Help:      "Total number of scan webhook verdicts by result",
+				Type:      counterMetric,
+			},
+			VariableLabels: map[string]string{"verdict": "quarantine"},
+			Value:          float64(globalScanStats.quarantineVerdicts.Load()),
+		})
+		metrics = append(metrics, Metric{
+			Description: MetricDescription{
+				Namespace: minioNamespace,
+				Subsystem: scanWebhookSubsystem,
+				Name:      "failed_total",
+				Help:      "Total number of scan webhook calls that failed or timed out",
+				Type:      counterMetric,
+			},
+			Value: float64(globalScanStats.failedScans.Load()),
+		})
+		metrics = append(metrics, Metric{
+			Description: MetricDescription{
+				Namespace: minioNamespace,
+				Subsystem: scanWebhookSubsystem,
+				Name:      "latency_ns_total",
+				Help:      "Total time spent waiting on scan webhook calls in nanoseconds, divide by the total number of verdicts and failures for the average latency",
+				Type:      counterMetric,
+			},
+			Value: float64(globalScanStats.latencyNanos.Load()),
+		})
+		return metrics
+	})
+	return mg
+}
+
 func getHTTPMetrics() *MetricsGroup {
 	mg := &MetricsGroup{
 		cacheInterval: 10 * time.Second,
@@ -1974,6 +2072,36 @@ func getNetworkMetrics() *MetricsGroup {
 				Description: getInterNodeReceivedBytesMD(),
 				Value:       float64(connStats.TotalInputBytes),
 			})
+			globalStorageShedRequests.Range(func(k, v interface{}) bool {
+				metrics = append(metrics, Metric{
+					Description:    getInternodeShedRequests(),
+					Value:          float64(atomic.LoadUint64(v.(*uint64))),
+					VariableLabels: map[string]string{"endpoint": k.(string)},
+				})
+				return true
+			})
+			globalStorageRESTClients.Range(func(k, v interface{}) bool {
+				client := v.(*rest.Client)
+				if expiry := client.PeerCertExpiry(); !expiry.IsZero() {
+					metrics = append(metrics, Metric{
+						Description:    getInternodeTLSCertExpiryMD(),
+						Value:          time.Until(expiry).Hours() / 24,
+						VariableLabels: map[string]string{"endpoint": k.(string)},
+					})
+				}
+				if dialed, reused := client.ConnStats(); dialed > 0 || reused > 0 {
+					metrics = append(metrics, Metric{
+						Description:    getInternodeConnsDialedMD(),
+						Value:          float64(dialed),
+						VariableLabels: map[string]string{"endpoint": k.(string)},
+					}, Metric{
+						Description:    getInternodeConnsReusedMD(),
+						Value:          float64(reused),
+						VariableLabels: map[string]string{"endpoint": k.(string)},
+					})
+				}
+				return true
+			})
 		}
 		metrics = append(metrics, Metric{
 			Description: getS3SentBytesMD(),
@@ -2441,6 +2569,28 @@ func getWebhookMetrics() *MetricsGroup {
 	return mg
 }
 
+func getFederationMetrics() *MetricsGroup {
+	mg := &MetricsGroup{
+		cacheInterval: 10 * time.Second,
+	}
+	mg.RegisterRead(func(ctx context.Context) []Metric {
+		if globalDNSConfig == nil {
+			return nil
+		}
+		return []Metric{{
+			Description: MetricDescription{
+				Namespace: nodeMetricNamespace,
+				Subsystem: federationSubsystem,
+				Name:      "dns_pending_registrations",
+				Help:      "Number of local buckets whose federated DNS registration is still pending",
+				Type:      gaugeMetric,
+			},
+			Value: float64(globalFederationPending.count()),
+		}}
+	})
+	return mg
+}
+
 func getKMSMetrics() *MetricsGroup {
 	mg := &MetricsGroup{
 		cacheInterval: 10 * time.Second,
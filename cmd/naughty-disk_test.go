@@ -112,8 +112,8 @@ func (d *naughtyDisk) SetDiskID(id string) {
 	d.disk.SetDiskID(id)
 }
 
-func (d *naughtyDisk) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode) (info dataUsageCache, err error) {
-	return d.disk.NSScanner(ctx, cache, updates, scanMode)
+func (d *naughtyDisk) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode, scanErrs chan<- nsScannerError) (info dataUsageCache, err error) {
+	return d.disk.NSScanner(ctx, cache, updates, scanMode, scanErrs)
 }
 
 func (d *naughtyDisk) DiskInfo(ctx context.Context) (info DiskInfo, err error) {
@@ -214,16 +214,27 @@ func (d *naughtyDisk) RenameFile(ctx context.Context, srcVolume, srcPath, dstVol
 	return d.disk.RenameFile(ctx, srcVolume, srcPath, dstVolume, dstPath)
 }
 
-func (d *naughtyDisk) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (err error) {
+func (d *naughtyDisk) RenameFiles(ctx context.Context, pairs []RenameFilePair) []error {
 	if err := d.calcError(); err != nil {
-		return err
+		errs := make([]error, len(pairs))
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	return d.disk.RenameFiles(ctx, pairs)
+}
+
+func (d *naughtyDisk) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (*CheckPartsResp, error) {
+	if err := d.calcError(); err != nil {
+		return nil, err
 	}
 	return d.disk.CheckParts(ctx, volume, path, fi)
 }
 
-func (d *naughtyDisk) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (err error) {
+func (d *naughtyDisk) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (stats DeleteStats, err error) {
 	if err := d.calcError(); err != nil {
-		return err
+		return DeleteStats{}, err
 	}
 	return d.disk.Delete(ctx, volume, path, deleteOpts)
 }
@@ -246,6 +257,13 @@ func (d *naughtyDisk) WriteMetadata(ctx context.Context, volume, path string, fi
 	return d.disk.WriteMetadata(ctx, volume, path, fi)
 }
 
+func (d *naughtyDisk) WriteMetadataIf(ctx context.Context, volume, path string, fi FileInfo, expectedSig uint64) (err error) {
+	if err := d.calcError(); err != nil {
+		return err
+	}
+	return d.disk.WriteMetadataIf(ctx, volume, path, fi, expectedSig)
+}
+
 func (d *naughtyDisk) UpdateMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
 	if err := d.calcError(); err != nil {
 		return err
@@ -253,6 +271,13 @@ func (d *naughtyDisk) UpdateMetadata(ctx context.Context, volume, path string, f
 	return d.disk.UpdateMetadata(ctx, volume, path, fi)
 }
 
+func (d *naughtyDisk) PatchMetadata(ctx context.Context, volume, path string, patch MetadataPatch) (err error) {
+	if err := d.calcError(); err != nil {
+		return err
+	}
+	return d.disk.PatchMetadata(ctx, volume, path, patch)
+}
+
 func (d *naughtyDisk) DeleteVersion(ctx context.Context, volume, path string, fi FileInfo, forceDelMarker bool) (err error) {
 	if err := d.calcError(); err != nil {
 		return err
@@ -281,6 +306,13 @@ func (d *naughtyDisk) ReadAll(ctx context.Context, volume string, path string) (
 	return d.disk.ReadAll(ctx, volume, path)
 }
 
+func (d *naughtyDisk) ReadAllAt(ctx context.Context, volume string, path string, offset, length int64) (buf []byte, size int64, err error) {
+	if err := d.calcError(); err != nil {
+		return nil, 0, err
+	}
+	return d.disk.ReadAllAt(ctx, volume, path, offset, length)
+}
+
 func (d *naughtyDisk) ReadXL(ctx context.Context, volume string, path string, readData bool) (rf RawFileInfo, err error) {
 	if err := d.calcError(); err != nil {
 		return rf, err
@@ -310,9 +342,9 @@ func (d *naughtyDisk) ReadMultiple(ctx context.Context, req ReadMultipleReq, res
 	return d.disk.ReadMultiple(ctx, req, resp)
 }
 
-func (d *naughtyDisk) CleanAbandonedData(ctx context.Context, volume string, path string) error {
+func (d *naughtyDisk) CleanAbandonedData(ctx context.Context, volume string, path string) (CleanAbandonedDataResp, error) {
 	if err := d.calcError(); err != nil {
-		return err
+		return CleanAbandonedDataResp{}, err
 	}
 	return d.disk.CleanAbandonedData(ctx, volume, path)
 }
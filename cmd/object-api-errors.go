@@ -385,6 +385,55 @@ func (e BucketTaggingNotFound) Error() string {
 	return "No bucket tags found for bucket: " + e.Bucket
 }
 
+// BucketDefaultTagsNotFound - no bucket default object tags found
+type BucketDefaultTagsNotFound GenericError
+
+func (e BucketDefaultTagsNotFound) Error() string {
+	return "No default object tags configuration found for bucket: " + e.Bucket
+}
+
+// BucketNoncurrentVersionsConfigNotFound - no bucket noncurrent versions limit found
+type BucketNoncurrentVersionsConfigNotFound GenericError
+
+func (e BucketNoncurrentVersionsConfigNotFound) Error() string {
+	return "No noncurrent versions configuration found for bucket: " + e.Bucket
+}
+
+// BucketScanConfigNotFound - no bucket scan configuration found
+type BucketScanConfigNotFound GenericError
+
+func (e BucketScanConfigNotFound) Error() string {
+	return "No scan configuration found for bucket: " + e.Bucket
+}
+
+// BucketMaxObjectSizeConfigNotFound - no bucket maximum object size configuration found
+type BucketMaxObjectSizeConfigNotFound GenericError
+
+func (e BucketMaxObjectSizeConfigNotFound) Error() string {
+	return "No maximum object size configuration found for bucket: " + e.Bucket
+}
+
+// BucketMaxVersionsConfigNotFound - no bucket maximum versions configuration found
+type BucketMaxVersionsConfigNotFound GenericError
+
+func (e BucketMaxVersionsConfigNotFound) Error() string {
+	return "No maximum versions configuration found for bucket: " + e.Bucket
+}
+
+// BucketBulkDeleteConfigNotFound - no bucket bulk delete configuration found
+type BucketBulkDeleteConfigNotFound GenericError
+
+func (e BucketBulkDeleteConfigNotFound) Error() string {
+	return "No bulk delete configuration found for bucket: " + e.Bucket
+}
+
+// BucketFanOutRoutingConfigNotFound - no bucket fan-out routing configuration found
+type BucketFanOutRoutingConfigNotFound GenericError
+
+func (e BucketFanOutRoutingConfigNotFound) Error() string {
+	return "No fan-out routing configuration found for bucket: " + e.Bucket
+}
+
 // BucketObjectLockConfigNotFound - no bucket object lock config found
 type BucketObjectLockConfigNotFound GenericError
 
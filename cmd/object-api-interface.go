@@ -90,15 +90,28 @@ type ObjectOptions struct {
 	// participating in a rebalance operation. Typically set for 'write' operations.
 	SkipRebalancing bool
 
-	WalkFilter      func(info FileInfo) bool // return WalkFilter returns 'true/false'
-	WalkMarker      string                   // set to skip until this object
-	PrefixEnabledFn func(prefix string) bool // function which returns true if versioning is enabled on prefix
+	// RoutePool routes a new object to the given pool (1-based, in command
+	// line order), zero when unset. Objects that already exist stay on
+	// their current pool regardless. Set by fan-out routing rules.
+	RoutePool int
+
+	WalkFilter          func(info FileInfo) bool // return WalkFilter returns 'true/false'
+	WalkMarker          string                   // set to skip until this object
+	WalkExcludePrefixes []string                 // entries under these prefixes are pruned from the walk
+	WalkPushdown        *WalkPushdownFilter      // simple tag/metadata checks evaluated against raw xl.meta before versions are decoded
+	PrefixEnabledFn     func(prefix string) bool // function which returns true if versioning is enabled on prefix
 
 	// IndexCB will return any index created but the compression.
 	// Object must have been read at this point.
 	IndexCB func() []byte
 
 	InclFreeVersions bool
+
+	// InlineChecksums requests inline content hash trailers on the
+	// internode shard reads and writes performed for this operation,
+	// rejecting corrupted transfers immediately instead of at heal time.
+	// Set for high-value writes such as replication and batch jobs.
+	InlineChecksums bool
 }
 
 // ExpirationOptions represents object options for object expiration at objectLayer.
@@ -123,14 +136,16 @@ type MakeBucketOptions struct {
 	ForceCreate       bool      // Create buckets even if they are already created.
 	CreatedAt         time.Time // only for site replication
 	NoLock            bool      // does not lock the make bucket call if set to 'true'
+	PinnedPool        int       // pin the bucket's new objects to this pool (1-based), zero when unset
 }
 
 // DeleteBucketOptions provides options for DeleteBucket calls.
 type DeleteBucketOptions struct {
-	NoLock     bool             // does not lock the delete bucket call if set to 'true'
-	NoRecreate bool             // do not recreate bucket on delete failures
-	Force      bool             // Force deletion
-	SRDeleteOp SRBucketDeleteOp // only when site replication is enabled
+	NoLock        bool             // does not lock the delete bucket call if set to 'true'
+	NoRecreate    bool             // do not recreate bucket on delete failures
+	Force         bool             // Force deletion
+	CascadeConfig bool             // remove bucket's remote targets, notification, quota and lifecycle config as well
+	SRDeleteOp    SRBucketDeleteOp // only when site replication is enabled
 }
 
 // BucketOptions provides options for ListBuckets and GetBucketInfo call.
@@ -73,6 +73,9 @@ func getDefaultOpts(header http.Header, copySource bool, metadata map[string]str
 	}
 	if _, ok := header[xhttp.MinIOSourceReplicationRequest]; ok {
 		opts.ReplicationRequest = true
+		// Replicated data is not re-verified against its source, verify
+		// the internode shard transfers inline instead.
+		opts.InlineChecksums = true
 	}
 	opts.Speedtest = header.Get(globalObjectPerfUserMetadata) != ""
 	return
@@ -1065,6 +1065,10 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 	}
 
 	// Check if bucket encryption is enabled
+	if s3Err := enforceBucketSSEConfig(dstBucket, r.Header); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
 	sseConfig, _ := globalBucketSSEConfigSys.Get(dstBucket)
 	sseConfig.Apply(r.Header, sse.ApplyOptions{
 		AutoEncrypt: globalAutoEncryption,
@@ -1145,6 +1149,12 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Enforce any maximum object size configured on the destination bucket.
+	if limit, exceeded := exceedsBucketMaxObjectSize(dstBucket, srcInfo.Size); exceeded {
+		writeErrorResponse(ctx, w, bucketMaxObjectSizeError(dstBucket, limit), r.URL)
+		return
+	}
+
 	// We have to copy metadata only if source and destination are same.
 	// this changes for encryption which can be observed below.
 	if cpSrcDstSame {
@@ -1379,6 +1389,15 @@ func (api objectAPIHandlers) CopyObjectHandler(w http.ResponseWriter, r *http.Re
 
 	}
 
+	// Merge in destination bucket default object tags, replicas carry
+	// the tags already merged at the source.
+	if !dstOpts.ReplicationRequest {
+		if err := applyBucketDefaultTags(dstBucket, srcInfo.UserDefined); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
 	srcInfo.UserDefined = filterReplicationStatusMetadata(srcInfo.UserDefined)
 	srcInfo.UserDefined = objectlock.FilterObjectLockMetadata(srcInfo.UserDefined, true, true)
 	retPerms := isPutActionAllowed(ctx, getRequestAuthType(r), dstBucket, dstObject, r, iampolicy.PutObjectRetentionAction)
@@ -1651,6 +1670,12 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Enforce any maximum object size configured on the bucket.
+	if limit, exceeded := exceedsBucketMaxObjectSize(bucket, size); exceeded {
+		writeErrorResponse(ctx, w, bucketMaxObjectSizeError(bucket, limit), r.URL)
+		return
+	}
+
 	metadata, err := extractMetadata(ctx, r)
 	if err != nil {
 		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
@@ -1666,6 +1691,15 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		metadata[xhttp.AmzObjectTagging] = objTags
 	}
 
+	// Merge in bucket default object tags, replicas carry the tags
+	// already merged at the source.
+	if _, ok := r.Header[xhttp.MinIOSourceReplicationRequest]; !ok {
+		if err := applyBucketDefaultTags(bucket, metadata); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
 	var (
 		md5hex              = clientETag.String()
 		sha256hex           = ""
@@ -1727,6 +1761,10 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	// Check if bucket encryption is enabled
+	if s3Err = enforceBucketSSEConfig(bucket, r.Header); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
 	sseConfig, _ := globalBucketSSEConfigSys.Get(bucket)
 	sseConfig.Apply(r.Header, sse.ApplyOptions{
 		AutoEncrypt: globalAutoEncryption,
@@ -1921,6 +1959,15 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		scheduleReplication(ctx, objInfo.Clone(), objectAPI, dsc, replication.ObjectReplicationType)
 	}
 
+	// Hand the object to the bucket's scanning webhook when the bucket
+	// opted its PutObject uploads into scanning. Quarantined objects get
+	// the quarantine event instead of the created event.
+	quarantined, s3Err := enforceBucketScan(ctx, w, r, objectAPI, bucket, objInfo, true)
+	if s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
+
 	setPutObjHeaders(w, objInfo, false)
 
 	// Notify object created event.
@@ -1933,12 +1980,18 @@ func (api objectAPIHandlers) PutObjectHandler(w http.ResponseWriter, r *http.Req
 		UserAgent:    r.UserAgent(),
 		Host:         handlers.GetSourceIP(r),
 	}
-	sendEvent(evt)
+	if !quarantined {
+		sendEvent(evt)
+	}
 	if objInfo.NumVersions > dataScannerExcessiveVersionsThreshold {
 		evt.EventName = event.ObjectManyVersions
 		sendEvent(evt)
 	}
 
+	// Prune the oldest noncurrent versions beyond the bucket's
+	// configured limit, if any.
+	enforceNoncurrentVersionsLimit(bucket, object, objInfo.NumVersions)
+
 	// Do not send checksums in events to avoid leaks.
 	hash.TransferChecksumHeader(w, r)
 	writeSuccessResponseHeadersOnly(w)
@@ -86,6 +86,10 @@ func (api objectAPIHandlers) NewMultipartUploadHandler(w http.ResponseWriter, r
 	}
 
 	// Check if bucket encryption is enabled
+	if s3Err := enforceBucketSSEConfig(bucket, r.Header); s3Err != ErrNone {
+		writeErrorResponse(ctx, w, errorCodes.ToAPIErr(s3Err), r.URL)
+		return
+	}
 	sseConfig, _ := globalBucketSSEConfigSys.Get(bucket)
 	sseConfig.Apply(r.Header, sse.ApplyOptions{
 		AutoEncrypt: globalAutoEncryption,
@@ -99,6 +103,18 @@ func (api objectAPIHandlers) NewMultipartUploadHandler(w http.ResponseWriter, r
 		}
 	}
 
+	// Reject the upload early when the client declares enough parts that
+	// even minimum-sized parts exceed the bucket's maximum object size,
+	// only the last part may be smaller than the minimum part size.
+	if partsCount := r.Header.Get(xhttp.AmzMpPartsCount); partsCount != "" {
+		if count, cerr := strconv.Atoi(partsCount); cerr == nil && count > 1 {
+			if limit, exceeded := exceedsBucketMaxObjectSize(bucket, int64(count-1)*globalMinPartSize); exceeded {
+				writeErrorResponse(ctx, w, bucketMaxObjectSizeError(bucket, limit), r.URL)
+				return
+			}
+		}
+	}
+
 	encMetadata := map[string]string{}
 
 	if crypto.Requested(r.Header) {
@@ -132,6 +148,15 @@ func (api objectAPIHandlers) NewMultipartUploadHandler(w http.ResponseWriter, r
 		metadata[xhttp.AmzObjectTagging] = objTags
 	}
 
+	// Merge in bucket default object tags, replicas carry the tags
+	// already merged at the source.
+	if _, ok := r.Header[xhttp.MinIOSourceReplicationRequest]; !ok {
+		if err := applyBucketDefaultTags(bucket, metadata); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL)
+			return
+		}
+	}
+
 	retPerms := isPutActionAllowed(ctx, getRequestAuthType(r), bucket, object, r, iampolicy.PutObjectRetentionAction)
 	holdPerms := isPutActionAllowed(ctx, getRequestAuthType(r), bucket, object, r, iampolicy.PutObjectLegalHoldAction)
 
@@ -907,6 +932,35 @@ func (api objectAPIHandlers) CompleteMultipartUploadHandler(w http.ResponseWrite
 		return
 	}
 
+	// Enforce any maximum object size configured on the bucket, the size
+	// of the completed object is the sum of its parts.
+	if cfg, _, cerr := globalBucketMetadataSys.GetMaxObjectSizeConfig(bucket); cerr == nil {
+		partSizes := make(map[int]int64, len(complMultipartUpload.Parts))
+		var partNumberMarker int
+		for {
+			listPartsInfo, lerr := objectAPI.ListObjectParts(ctx, bucket, object, uploadID, partNumberMarker, globalMaxPartID, ObjectOptions{})
+			if lerr != nil {
+				writeErrorResponse(ctx, w, toAPIError(ctx, lerr), r.URL)
+				return
+			}
+			for _, part := range listPartsInfo.Parts {
+				partSizes[part.PartNumber] = part.ActualSize
+			}
+			if !listPartsInfo.IsTruncated {
+				break
+			}
+			partNumberMarker = listPartsInfo.NextPartNumberMarker
+		}
+		var objectSize int64
+		for _, part := range complMultipartUpload.Parts {
+			objectSize += partSizes[part.PartNumber]
+		}
+		if objectSize > cfg.MaxObjectSize {
+			writeErrorResponse(ctx, w, bucketMaxObjectSizeError(bucket, cfg.MaxObjectSize), r.URL)
+			return
+		}
+	}
+
 	// Reject retention or governance headers if set, CompleteMultipartUpload spec
 	// does not use these headers, and should not be passed down to checkPutObjectLockAllowed
 	if objectlock.IsObjectLockRequested(r.Header) || objectlock.IsObjectLockGovernanceBypassSet(r.Header) {
@@ -20,6 +20,8 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"errors"
+	"strings"
 	"sync"
 
 	"github.com/minio/minio-go/v7"
@@ -36,12 +38,120 @@ type fanOutOptions struct {
 	Key      []byte
 	KmsCtx   kms.Context
 	Checksum *hash.Checksum
+	// Routing holds the bucket's fan-out routing rules, nil when the
+	// bucket has none. Entries carrying a matching tag are written to
+	// the pool the first matching rule selects.
+	Routing *FanOutRoutingConfig
+}
+
+// errFanOutFallback is returned by the shared encode fast path when a
+// destination cannot take the pre-encoded shards and must be written
+// through a regular PutObject instead.
+var errFanOutFallback = errors.New("fan-out shared encode not applicable")
+
+// fanOutSharedPutter is implemented by object layers that can write an
+// unencrypted fan-out payload from a single erasure encode shared by all
+// destination keys.
+type fanOutSharedPutter interface {
+	putObjectFanOutShared(ctx context.Context, bucket string, entries []minio.PutObjectFanOutEntry, buf []byte, opts fanOutOptions) ([]ObjectInfo, []error, bool)
+}
+
+// fanOutEntryStorageClass returns the storage class requested by a fan-out
+// entry. The entry has no dedicated field for it, so the class travels in
+// the entry's user metadata under the storage class header key.
+func fanOutEntryStorageClass(req minio.PutObjectFanOutEntry) string {
+	for k, v := range req.UserMetadata {
+		if strings.EqualFold(k, xhttp.AmzStorageClass) {
+			return v
+		}
+	}
+	return ""
+}
+
+// fanOutUserDefined builds the user-defined metadata for a single fan-out
+// entry, merging in the bucket default object tags.
+func fanOutUserDefined(bucket string, req minio.PutObjectFanOutEntry) (map[string]string, error) {
+	userDefined := make(map[string]string, len(req.UserMetadata))
+	for k, v := range req.UserMetadata {
+		if strings.EqualFold(k, xhttp.AmzStorageClass) {
+			// Store the storage class under the canonical key, the
+			// erasure layer derives this entry's parity from it.
+			k = xhttp.AmzStorageClass
+		}
+		userDefined[k] = v
+	}
+	userDefined[xhttp.AmzObjectTagging] = s3utils.TagEncode(req.UserTags)
+
+	// Merge in bucket default object tags.
+	if err := applyBucketDefaultTags(bucket, userDefined); err != nil {
+		return nil, err
+	}
+	return userDefined, nil
+}
+
+// fanOutPutObjectEntry writes a single fan-out entry through the regular
+// PutObject path, erasure encoding the payload for this entry alone.
+func fanOutPutObjectEntry(ctx context.Context, bucket string, objectAPI ObjectLayer, req minio.PutObjectFanOutEntry, fanOutBuf []byte, opts fanOutOptions) (oi ObjectInfo, err error) {
+	hr, err := hash.NewReader(bytes.NewReader(fanOutBuf), int64(len(fanOutBuf)), "", "", -1)
+	if err != nil {
+		return oi, err
+	}
+
+	reader := NewPutObjReader(hr)
+	defer func() {
+		if cerr := reader.Close(); cerr != nil {
+			err = cerr
+		}
+		if cerr := hr.Close(); cerr != nil {
+			err = cerr
+		}
+	}()
+
+	userDefined, err := fanOutUserDefined(bucket, req)
+	if err != nil {
+		return oi, err
+	}
+
+	if opts.Kind != nil {
+		encrd, objectEncryptionKey, err := newEncryptReader(ctx, hr, opts.Kind, opts.KeyID, opts.Key, bucket, req.Key, userDefined, opts.KmsCtx)
+		if err != nil {
+			return oi, err
+		}
+
+		// do not try to verify encrypted content/
+		hr, err = hash.NewReader(encrd, -1, "", "", -1)
+		if err != nil {
+			return oi, err
+		}
+
+		reader, err = reader.WithEncryption(hr, &objectEncryptionKey)
+		if err != nil {
+			return oi, err
+		}
+	}
+
+	return objectAPI.PutObject(ctx, bucket, req.Key, reader, ObjectOptions{
+		Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, req.Key),
+		VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, req.Key),
+		UserDefined:      userDefined,
+		RoutePool:        opts.Routing.poolFor(req.UserTags),
+	})
 }
 
 // fanOutPutObject takes an input source reader and fans out multiple PUT operations
 // based on the incoming fan-out request, a context cancelation by the caller
 // would ensure all fan-out operations are canceled.
 func fanOutPutObject(ctx context.Context, bucket string, objectAPI ObjectLayer, fanOutEntries []minio.PutObjectFanOutEntry, fanOutBuf []byte, opts fanOutOptions) ([]ObjectInfo, []error) {
+	if opts.Kind == nil {
+		// Unencrypted content is identical for every destination, let
+		// capable object layers erasure encode the payload only once.
+		if shared, ok := objectAPI.(fanOutSharedPutter); ok {
+			if objInfos, errs, ok := shared.putObjectFanOutShared(ctx, bucket, fanOutEntries, fanOutBuf, opts); ok {
+				return objInfos, errs
+			}
+		}
+	}
+
 	errs := make([]error, len(fanOutEntries))
 	objInfos := make([]ObjectInfo, len(fanOutEntries))
 
@@ -53,54 +163,7 @@ func fanOutPutObject(ctx context.Context, bucket string, objectAPI ObjectLayer,
 
 			objInfos[idx] = ObjectInfo{Name: req.Key}
 
-			hr, err := hash.NewReader(bytes.NewReader(fanOutBuf), int64(len(fanOutBuf)), "", "", -1)
-			if err != nil {
-				errs[idx] = err
-				return
-			}
-
-			reader := NewPutObjReader(hr)
-			defer func() {
-				if err := reader.Close(); err != nil {
-					errs[idx] = err
-				}
-				if err := hr.Close(); err != nil {
-					errs[idx] = err
-				}
-			}()
-
-			userDefined := make(map[string]string, len(req.UserMetadata))
-			for k, v := range req.UserMetadata {
-				userDefined[k] = v
-			}
-			userDefined[xhttp.AmzObjectTagging] = s3utils.TagEncode(req.UserTags)
-
-			if opts.Kind != nil {
-				encrd, objectEncryptionKey, err := newEncryptReader(ctx, hr, opts.Kind, opts.KeyID, opts.Key, bucket, req.Key, userDefined, opts.KmsCtx)
-				if err != nil {
-					errs[idx] = err
-					return
-				}
-
-				// do not try to verify encrypted content/
-				hr, err = hash.NewReader(encrd, -1, "", "", -1)
-				if err != nil {
-					errs[idx] = err
-					return
-				}
-
-				reader, err = reader.WithEncryption(hr, &objectEncryptionKey)
-				if err != nil {
-					errs[idx] = err
-					return
-				}
-			}
-
-			objInfo, err := objectAPI.PutObject(ctx, bucket, req.Key, reader, ObjectOptions{
-				Versioned:        globalBucketVersioningSys.PrefixEnabled(bucket, req.Key),
-				VersionSuspended: globalBucketVersioningSys.PrefixSuspended(bucket, req.Key),
-				UserDefined:      userDefined,
-			})
+			objInfo, err := fanOutPutObjectEntry(ctx, bucket, objectAPI, req, fanOutBuf, opts)
 			if err != nil {
 				errs[idx] = err
 				return
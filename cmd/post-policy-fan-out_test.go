@@ -0,0 +1,235 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio/internal/config/storageclass"
+)
+
+// TestFanOutPutObjectSharedEncode verifies that the shared erasure encode
+// fast path produces the same objects as the regular PutObject path, for
+// both inlined and on-disk shard sizes.
+func TestFanOutPutObjectSharedEncode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatalf("Failed obtaining Temp Backend: <ERROR> %s", err)
+	}
+	defer removeRoots(fsDirs)
+	initAllSubsystems(ctx)
+	setObjectLayer(obj)
+
+	shared, ok := obj.(fanOutSharedPutter)
+	if !ok {
+		t.Fatal("expected the erasure backend to implement the fan-out shared putter")
+	}
+
+	for _, size := range []int{8 * humanize.KiByte, humanize.MiByte} {
+		t.Run(humanize.IBytes(uint64(size)), func(t *testing.T) {
+			bucket := getRandomBucketName()
+			if err := obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+				t.Fatal(err)
+			}
+
+			payload := generateBytesData(size)
+			entries := make([]minio.PutObjectFanOutEntry, 10)
+			for i := range entries {
+				entries[i] = minio.PutObjectFanOutEntry{Key: fmt.Sprintf("fan-out/object-%d", i)}
+			}
+
+			objInfos, errs, ok := shared.putObjectFanOutShared(ctx, bucket, entries, payload, fanOutOptions{})
+			if !ok {
+				t.Fatal("shared encode fast path was not taken")
+			}
+			for i, err := range errs {
+				if err != nil {
+					t.Fatalf("entry %d: <ERROR> %v", i, err)
+				}
+			}
+
+			wantETag := getMD5Hash(payload)
+			for i, entry := range entries {
+				if objInfos[i].Name != entry.Key {
+					t.Errorf("entry %d: expected key %s, got %s", i, entry.Key, objInfos[i].Name)
+				}
+				if objInfos[i].ETag != wantETag {
+					t.Errorf("entry %d: expected etag %s, got %s", i, wantETag, objInfos[i].ETag)
+				}
+
+				gr, err := obj.GetObjectNInfo(ctx, bucket, entry.Key, nil, nil, ObjectOptions{})
+				if err != nil {
+					t.Fatalf("entry %d: <ERROR> %v", i, err)
+				}
+				content, err := io.ReadAll(gr)
+				gr.Close()
+				if err != nil {
+					t.Fatalf("entry %d: <ERROR> %v", i, err)
+				}
+				if !bytes.Equal(content, payload) {
+					t.Errorf("entry %d: object content differs from the fan-out payload", i)
+				}
+			}
+		})
+	}
+}
+
+// TestFanOutPutObjectStorageClass verifies that a fan-out entry can request
+// its own storage class through its user metadata, writing that entry with
+// the reduced redundancy parity while the others keep the standard one.
+func TestFanOutPutObjectStorageClass(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatalf("Failed obtaining Temp Backend: <ERROR> %s", err)
+	}
+	defer removeRoots(fsDirs)
+	initAllSubsystems(ctx)
+	setObjectLayer(obj)
+
+	saveSC := globalStorageClass
+	defer func() {
+		globalStorageClass.Update(saveSC)
+	}()
+	globalStorageClass.Update(storageclass.Config{
+		RRS: storageclass.StorageClass{
+			Parity: 2,
+		},
+		Standard: storageclass.StorageClass{
+			Parity: 4,
+		},
+	})
+
+	bucket := getRandomBucketName()
+	if err := obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	payload := generateBytesData(8 * humanize.KiByte)
+	entries := []minio.PutObjectFanOutEntry{
+		{Key: "fan-out/standard"},
+		{Key: "fan-out/reduced", UserMetadata: map[string]string{"x-amz-storage-class": storageclass.RRS}},
+	}
+
+	if sc := fanOutEntryStorageClass(entries[0]); sc != "" {
+		t.Fatalf("expected no storage class for the first entry, got %s", sc)
+	}
+	if sc := fanOutEntryStorageClass(entries[1]); sc != storageclass.RRS {
+		t.Fatalf("expected storage class %s for the second entry, got %s", storageclass.RRS, sc)
+	}
+
+	_, errs := fanOutPutObject(ctx, bucket, obj, entries, payload, fanOutOptions{})
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("entry %d: <ERROR> %v", i, err)
+		}
+	}
+
+	for i, want := range []string{storageclass.STANDARD, storageclass.RRS} {
+		objInfo, err := obj.GetObjectInfo(ctx, bucket, entries[i].Key, ObjectOptions{})
+		if err != nil {
+			t.Fatalf("entry %d: <ERROR> %v", i, err)
+		}
+		if objInfo.StorageClass != want {
+			t.Errorf("entry %d: expected storage class %s, got %s", i, want, objInfo.StorageClass)
+		}
+	}
+}
+
+// benchmarkFanOutPutObject measures fanning out a payload to fanOut keys,
+// either through the shared erasure encode fast path or re-encoding the
+// payload per object.
+func benchmarkFanOutPutObject(b *testing.B, fanOut, size int, sharedEncode bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		b.Fatalf("Failed obtaining Temp Backend: <ERROR> %s", err)
+	}
+	defer removeRoots(fsDirs)
+	initAllSubsystems(ctx)
+	setObjectLayer(obj)
+
+	bucket := getRandomBucketName()
+	if err := obj.MakeBucket(ctx, bucket, MakeBucketOptions{}); err != nil {
+		b.Fatal(err)
+	}
+
+	payload := generateBytesData(size)
+	entries := make([]minio.PutObjectFanOutEntry, fanOut)
+	for i := range entries {
+		entries[i] = minio.PutObjectFanOutEntry{Key: fmt.Sprintf("fan-out/object-%d", i)}
+	}
+
+	b.SetBytes(int64(size) * int64(fanOut))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if sharedEncode {
+			_, errs := fanOutPutObject(ctx, bucket, obj, entries, payload, fanOutOptions{})
+			for _, err := range errs {
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+			continue
+		}
+
+		// Per-object path, each entry erasure encodes the payload again.
+		errs := make([]error, len(entries))
+		var wg sync.WaitGroup
+		for idx, req := range entries {
+			wg.Add(1)
+			go func(idx int, req minio.PutObjectFanOutEntry) {
+				defer wg.Done()
+				_, errs[idx] = fanOutPutObjectEntry(ctx, bucket, obj, req, payload, fanOutOptions{})
+			}(idx, req)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkFanOutPutObject50SharedEncode benchmarks a 50-way fan-out of a
+// 16 MiB payload erasure encoded once for all keys.
+func BenchmarkFanOutPutObject50SharedEncode(b *testing.B) {
+	benchmarkFanOutPutObject(b, 50, 16*humanize.MiByte, true)
+}
+
+// BenchmarkFanOutPutObject50PerObject benchmarks the same fan-out with the
+// payload re-encoded for every key, for comparison.
+func BenchmarkFanOutPutObject50PerObject(b *testing.B) {
+	benchmarkFanOutPutObject(b, 50, 16*humanize.MiByte, false)
+}
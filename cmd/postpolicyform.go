@@ -56,6 +56,12 @@ const (
 	policyCondEqual         = "eq"
 	policyCondStartsWith    = "starts-with"
 	policyCondContentLength = "content-length-range"
+
+	// MinIO extension: the named form field must be present with a
+	// non-empty value, the condition value is ignored. Allows bucket
+	// owners to require metadata fields such as x-amz-meta-* on all
+	// POST policy uploads.
+	policyCondRequired = "required"
 )
 
 // toString - Safely convert interface to string without causing panic.
@@ -199,7 +205,7 @@ func parsePostPolicyForm(r io.Reader) (PostPolicyForm, error) {
 				return parsedPolicy, fmt.Errorf("Malformed conditional fields %s of type %s found in POST policy form", condt, reflect.TypeOf(condt).String())
 			}
 			switch toLowerString(condt[0]) {
-			case policyCondEqual, policyCondStartsWith:
+			case policyCondEqual, policyCondStartsWith, policyCondRequired:
 				for _, v := range condt { // Pre-check all values for type.
 					if !isString(v) {
 						// All values must be of type string.
@@ -254,6 +260,8 @@ func checkPolicyCond(op string, input1, input2 string) bool {
 		return input1 == input2
 	case policyCondStartsWith:
 		return strings.HasPrefix(input1, input2)
+	case policyCondRequired:
+		return input1 != ""
 	}
 	return false
 }
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	minio "github.com/minio/minio-go/v7"
 )
@@ -62,6 +63,16 @@ func TestParsePostPolicyForm(t *testing.T) {
 			policy:  `{"expiration":"2021-03-27T20:35:28.458Z","conditions":[["eq","$bucket","testbucket"],["eq","$key","wtf.txt"],["eq","$x-amz-date","20210320T203528Z"],["eq","$x-amz-algorithm","AWS4-HMAC-SHA256"],["eq","$x-amz-credential","Q3AM3UQ867SPQQA43P2F/20210320/us-east-1/s3/aws4_request"]]}`,
 			success: true,
 		},
+		// required metadata condition, shall be parsed properly.
+		{
+			policy:  `{"expiration":"2021-03-27T20:35:28.458Z","conditions":[["eq","$bucket","testbucket"],["required","$x-amz-meta-project",""]]}`,
+			success: true,
+		},
+		// required condition on a non-field target, reject.
+		{
+			policy:  `{"expiration":"2021-03-27T20:35:28.458Z","conditions":[["eq","$bucket","testbucket"],["required","x-amz-meta-project",""]]}`,
+			success: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -158,3 +169,43 @@ func TestPostPolicyForm(t *testing.T) {
 		}
 	}
 }
+
+// Test required metadata conditions in Post Policy
+func TestPostPolicyRequiredCondition(t *testing.T) {
+	policy := fmt.Sprintf(`{"expiration":"%s","conditions":[["eq","$bucket","testbucket"],["required","$x-amz-meta-project",""]]}`,
+		UTCNow().AddDate(0, 0, 10).Format(time.RFC3339Nano))
+
+	postPolicyForm, err := parsePostPolicyForm(strings.NewReader(policy))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		project     string
+		setProject  bool
+		expectedErr error
+	}{
+		// Required metadata is present.
+		{project: "backup", setProject: true, expectedErr: nil},
+		// Required metadata is missing.
+		{setProject: false, expectedErr: fmt.Errorf("Invalid according to Policy: Policy Condition failed: [required, $x-amz-meta-project, ]")},
+		// Required metadata is present but empty.
+		{project: "", setProject: true, expectedErr: fmt.Errorf("Invalid according to Policy: Policy Condition failed: [required, $x-amz-meta-project, ]")},
+	}
+	for i, tt := range testCases {
+		formValues := make(http.Header)
+		formValues.Set("Bucket", "testbucket")
+		formValues.Set("Key", "myfile.txt")
+		if tt.setProject {
+			formValues.Set("X-Amz-Meta-Project", tt.project)
+		}
+
+		err := checkPostPolicy(formValues, postPolicyForm)
+		if tt.expectedErr == nil && err != nil {
+			t.Fatalf("Test %d: Expected success but failed with %s", i+1, err)
+		}
+		if tt.expectedErr != nil && (err == nil || err.Error() != tt.expectedErr.Error()) {
+			t.Fatalf("Test %d: Expected %s, got %v", i+1, tt.expectedErr, err)
+		}
+	}
+}
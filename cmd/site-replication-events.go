@@ -0,0 +1,180 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio/internal/config"
+	"github.com/minio/minio/internal/event"
+	"github.com/minio/minio/internal/logger"
+	"github.com/minio/pkg/env"
+)
+
+// srForwardBucketEventsEnv enables forwarding bucket-level
+// notifications (s3:BucketCreated, s3:BucketRemoved) to peer clusters
+// so they reach the peers' notification targets as well. "on" forwards
+// to every peer, any other value designates a single event hub peer by
+// site name or deployment ID. Off by default.
+const srForwardBucketEventsEnv = "_MINIO_SITE_REPLICATION_FORWARD_EVENTS"
+
+// srBucketMetaTypeBucketEvent - carries a bucket-level notification to
+// a peer cluster, the JSON encoded event travels in the SRBucketMeta
+// Tags field.
+const srBucketMetaTypeBucketEvent = "bucket-event"
+
+// srOriginSiteKey is the response element key annotating re-emitted
+// events with the site the event originated from.
+const srOriginSiteKey = "x-minio-origin-site"
+
+// srBucketEvent is the wire form of a forwarded bucket-level
+// notification. Origin deployment ID and request ID form the
+// deduplication key that keeps forwarding loops impossible.
+type srBucketEvent struct {
+	EventName          string            `json:"eventName"`
+	Bucket             string            `json:"bucket"`
+	ReqParams          map[string]string `json:"reqParams,omitempty"`
+	RespElements       map[string]string `json:"respElements,omitempty"`
+	Host               string            `json:"host,omitempty"`
+	UserAgent          string            `json:"userAgent,omitempty"`
+	OriginSite         string            `json:"originSite"`
+	OriginDeploymentID string            `json:"originDeploymentID"`
+	RequestID          string            `json:"requestID"`
+}
+
+// srSeenBucketEvents remembers recently re-emitted events by their
+// deduplication key, reset wholesale once full to keep memory use flat.
+var srSeenBucketEvents = struct {
+	sync.Mutex
+	keys map[string]struct{}
+}{keys: make(map[string]struct{})}
+
+const srSeenBucketEventsMax = 10000
+
+// srBucketEventSeen records key, reporting whether it was already
+// present.
+func srBucketEventSeen(key string) bool {
+	srSeenBucketEvents.Lock()
+	defer srSeenBucketEvents.Unlock()
+	if _, ok := srSeenBucketEvents.keys[key]; ok {
+		return true
+	}
+	if len(srSeenBucketEvents.keys) >= srSeenBucketEventsMax {
+		srSeenBucketEvents.keys = make(map[string]struct{}, srSeenBucketEventsMax)
+	}
+	srSeenBucketEvents.keys[key] = struct{}{}
+	return false
+}
+
+// forwardBucketEvent sends a bucket-level notification to peer
+// clusters so it reaches their notification targets. A no-op unless
+// enabled via _MINIO_SITE_REPLICATION_FORWARD_EVENTS and site
+// replication is configured.
+func (c *SiteReplicationSys) forwardBucketEvent(ctx context.Context, args eventArgs) {
+	hub := env.Get(srForwardBucketEventsEnv, config.EnableOff)
+	if hub == config.EnableOff || hub == "" {
+		return
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+	if !c.enabled {
+		return
+	}
+
+	ev := srBucketEvent{
+		EventName:          args.EventName.String(),
+		Bucket:             args.BucketName,
+		ReqParams:          args.ReqParams,
+		RespElements:       args.RespElements,
+		Host:               args.Host,
+		UserAgent:          args.UserAgent,
+		OriginSite:         c.state.Peers[globalDeploymentID].Name,
+		OriginDeploymentID: globalDeploymentID,
+		RequestID:          args.RespElements["requestId"],
+	}
+	buf, err := json.Marshal(ev)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	payload := string(buf)
+
+	cerr := c.concDo(nil, func(d string, p madmin.PeerInfo) error {
+		if hub != config.EnableOn && hub != p.Name && hub != d {
+			// A designated event hub is configured, skip other peers.
+			return nil
+		}
+		admClient, err := c.getAdminClient(ctx, d)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		return c.annotatePeerErr(p.Name, replicateBucketEvent, admClient.SRPeerReplicateBucketMeta(ctx, madmin.SRBucketMeta{
+			Type:      srBucketMetaTypeBucketEvent,
+			Bucket:    args.BucketName,
+			Tags:      &payload,
+			UpdatedAt: UTCNow(),
+		}))
+	}, replicateBucketEvent)
+	if cerr != nil {
+		logger.LogOnceIf(ctx, cerr, "site-replication-forward-bucket-event")
+	}
+}
+
+// PeerBucketEventHandler - re-emits a bucket-level notification
+// forwarded by a peer cluster to the local notification targets. The
+// event is annotated with its origin site and dropped when it
+// originated locally or was already seen, so forwarding cannot loop.
+func (c *SiteReplicationSys) PeerBucketEventHandler(ctx context.Context, payload *string) error {
+	if payload == nil {
+		return errSRInvalidRequest(errInvalidArgument)
+	}
+	var ev srBucketEvent
+	if err := json.Unmarshal([]byte(*payload), &ev); err != nil {
+		return wrapSRErr(err)
+	}
+	if ev.OriginDeploymentID == globalDeploymentID {
+		return nil
+	}
+	if ev.RequestID != "" && srBucketEventSeen(ev.OriginDeploymentID+":"+ev.RequestID) {
+		return nil
+	}
+	eventName, err := event.ParseName(ev.EventName)
+	if err != nil {
+		return wrapSRErr(err)
+	}
+
+	respElements := ev.RespElements
+	if respElements == nil {
+		respElements = make(map[string]string)
+	}
+	respElements[srOriginSiteKey] = ev.OriginSite
+
+	sendEvent(eventArgs{
+		EventName:    eventName,
+		BucketName:   ev.Bucket,
+		ReqParams:    ev.ReqParams,
+		RespElements: respElements,
+		Host:         ev.Host,
+		UserAgent:    ev.UserAgent,
+	})
+	return nil
+}
@@ -30,6 +30,7 @@ import (
 	"reflect"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -194,6 +195,10 @@ type SiteReplicationSys struct {
 	state srState
 
 	iamMetaCache srIAMCache
+
+	// healKick requests an immediate healing pass from the background
+	// healing routine, used to reconcile rejected peer updates.
+	healKick chan struct{}
 }
 
 type srState srStateV1
@@ -217,6 +222,7 @@ type srStateData struct {
 
 // Init - initialize the site replication manager.
 func (c *SiteReplicationSys) Init(ctx context.Context, objAPI ObjectLayer) error {
+	c.healKick = make(chan struct{}, 1)
 	go c.startHealRoutine(ctx, objAPI)
 
 	err := c.loadFromDisk(ctx, objAPI)
@@ -675,6 +681,7 @@ const (
 	deleteBucket            = "DeleteBucket"
 	replicateIAMItem        = "SRPeerReplicateIAMItem"
 	replicateBucketMetadata = "SRPeerReplicateBucketMeta"
+	replicateBucketEvent    = "SRPeerForwardBucketEvent"
 )
 
 // MakeBucketHook - called during a regular make bucket call when cluster
@@ -701,6 +708,9 @@ func (c *SiteReplicationSys) MakeBucketHook(ctx context.Context, bucket string,
 	if opts.ForceCreate {
 		optsMap["forceCreate"] = "true"
 	}
+	if opts.PinnedPool > 0 {
+		optsMap["pinnedPool"] = strconv.Itoa(opts.PinnedPool)
+	}
 	createdAt, _ := globalBucketMetadataSys.CreatedAt(bucket)
 	optsMap["createdAt"] = createdAt.UTC().Format(time.RFC3339Nano)
 	opts.CreatedAt = createdAt
@@ -751,7 +761,7 @@ func (c *SiteReplicationSys) MakeBucketHook(ctx context.Context, bucket string,
 // DeleteBucketHook - called during a regular delete bucket call when cluster
 // replication is enabled. It is responsible for the deletion of the same bucket
 // on remote clusters.
-func (c *SiteReplicationSys) DeleteBucketHook(ctx context.Context, bucket string, forceDelete bool) error {
+func (c *SiteReplicationSys) DeleteBucketHook(ctx context.Context, bucket string, forceDelete, cascadeConfig bool) error {
 	// At this point, the local bucket is deleted.
 
 	c.RLock()
@@ -765,6 +775,13 @@ func (c *SiteReplicationSys) DeleteBucketHook(ctx context.Context, bucket string
 		op = madmin.ForceDeleteBucketBktOp
 	}
 
+	var optsMap map[string]string
+	if cascadeConfig {
+		optsMap = map[string]string{
+			"cascadeConfig": "true",
+		}
+	}
+
 	// Send bucket delete to other clusters.
 	cerr := c.concDo(nil, func(deploymentID string, p madmin.PeerInfo) error {
 		admClient, err := c.getAdminClient(ctx, deploymentID)
@@ -772,7 +789,7 @@ func (c *SiteReplicationSys) DeleteBucketHook(ctx context.Context, bucket string
 			return wrapSRErr(err)
 		}
 
-		return c.annotatePeerErr(p.Name, deleteBucket, admClient.SRPeerBucketOps(ctx, bucket, op, nil))
+		return c.annotatePeerErr(p.Name, deleteBucket, admClient.SRPeerBucketOps(ctx, bucket, op, optsMap))
 	},
 		deleteBucket,
 	)
@@ -1070,6 +1087,12 @@ func (c *SiteReplicationSys) PeerBucketDeleteHandler(ctx context.Context, bucket
 		return err
 	}
 
+	if opts.CascadeConfig {
+		for _, warning := range cascadeDeleteBucketConfig(ctx, bucket) {
+			logger.LogIf(ctx, fmt.Errorf("cascade delete for bucket %s: %s", bucket, warning))
+		}
+	}
+
 	globalNotificationSys.DeleteBucketMetadata(ctx, bucket)
 
 	return nil
@@ -1357,6 +1380,42 @@ func (c *SiteReplicationSys) PeerSTSAccHandler(ctx context.Context, stsCred *mad
 	return nil
 }
 
+// srBucketMetaEpochSep separates the bucket meta type from the sender's
+// config epoch in SRBucketMeta.Type, e.g. "tags@4". The epoch rides on
+// the type string since madmin.SRBucketMeta has no field for it, peers
+// without epoch support keep last-write-wins semantics on UpdatedAt.
+const srBucketMetaEpochSep = "@"
+
+// srBucketMetaTypeWithEpoch returns the type with the epoch suffixed,
+// a zero epoch returns the plain type.
+func srBucketMetaTypeWithEpoch(typ string, epoch uint64) string {
+	if epoch == 0 {
+		return typ
+	}
+	return typ + srBucketMetaEpochSep + strconv.FormatUint(epoch, 10)
+}
+
+// srBucketMetaTypeSplitEpoch returns the plain type and the epoch carried
+// in it, the epoch is zero when absent.
+func srBucketMetaTypeSplitEpoch(typ string) (string, uint64) {
+	i := strings.LastIndex(typ, srBucketMetaEpochSep)
+	if i < 0 {
+		return typ, 0
+	}
+	epoch, err := strconv.ParseUint(typ[i+1:], 10, 64)
+	if err != nil {
+		return typ, 0
+	}
+	return typ[:i], epoch
+}
+
+// srBucketMetaEpochOK returns true when an incoming hook epoch may be
+// applied on top of the current local epoch. Hooks without an epoch keep
+// the legacy last-write-wins semantics.
+func srBucketMetaEpochOK(incoming, current uint64) bool {
+	return incoming == 0 || incoming == current+1
+}
+
 // BucketMetaHook - called when bucket meta changes happen and need to be
 // replicated to peer clusters.
 func (c *SiteReplicationSys) BucketMetaHook(ctx context.Context, item madmin.SRBucketMeta) error {
@@ -1369,6 +1428,10 @@ func (c *SiteReplicationSys) BucketMetaHook(ctx context.Context, item madmin.SRB
 		return nil
 	}
 
+	// Carry the local config epoch, bumped by the update that triggered
+	// this hook, so peers can reject concurrent conflicting updates.
+	item.Type = srBucketMetaTypeWithEpoch(item.Type, globalBucketMetadataSys.ConfigEpoch(item.Bucket))
+
 	cerr := c.concDo(nil, func(d string, p madmin.PeerInfo) error {
 		admClient, err := c.getAdminClient(ctx, d)
 		if err != nil {
@@ -1466,6 +1529,186 @@ func (c *SiteReplicationSys) PeerBucketTaggingHandler(ctx context.Context, bucke
 	return nil
 }
 
+// PeerBucketDefaultTagsHandler - copies/deletes default object tags config to local cluster.
+func (c *SiteReplicationSys) PeerBucketDefaultTagsHandler(ctx context.Context, bucket string, defaultTags *string, updatedAt time.Time) error {
+	// skip overwrite if local update is newer than peer update.
+	if !updatedAt.IsZero() {
+		if _, updateTm, err := globalBucketMetadataSys.GetDefaultTagsConfig(bucket); err == nil && updateTm.After(updatedAt) {
+			return nil
+		}
+	}
+
+	if defaultTags != nil {
+		configData, err := base64.StdEncoding.DecodeString(*defaultTags)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		_, err = globalBucketMetadataSys.Update(ctx, bucket, bucketDefaultTagsConfig, configData)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		return nil
+	}
+
+	// Delete the default object tags config
+	_, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketDefaultTagsConfig)
+	if err != nil {
+		return wrapSRErr(err)
+	}
+
+	return nil
+}
+
+// PeerBucketNoncurrentVersionsHandler - copies/deletes noncurrent versions limit config to local cluster.
+func (c *SiteReplicationSys) PeerBucketNoncurrentVersionsHandler(ctx context.Context, bucket string, noncurrentVersions *string, updatedAt time.Time) error {
+	// skip overwrite if local update is newer than peer update.
+	if !updatedAt.IsZero() {
+		if _, updateTm, err := globalBucketMetadataSys.GetNoncurrentVersionsConfig(bucket); err == nil && updateTm.After(updatedAt) {
+			return nil
+		}
+	}
+
+	if noncurrentVersions != nil {
+		configData, err := base64.StdEncoding.DecodeString(*noncurrentVersions)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		_, err = globalBucketMetadataSys.Update(ctx, bucket, bucketNoncurrentVersionsConfig, configData)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		return nil
+	}
+
+	// Delete the noncurrent versions limit config
+	_, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketNoncurrentVersionsConfig)
+	if err != nil {
+		return wrapSRErr(err)
+	}
+
+	return nil
+}
+
+// PeerBucketMaxObjectSizeHandler - copies/deletes maximum object size config to local cluster.
+func (c *SiteReplicationSys) PeerBucketMaxObjectSizeHandler(ctx context.Context, bucket string, maxObjectSize *string, updatedAt time.Time) error {
+	// skip overwrite if local update is newer than peer update.
+	if !updatedAt.IsZero() {
+		if _, updateTm, err := globalBucketMetadataSys.GetMaxObjectSizeConfig(bucket); err == nil && updateTm.After(updatedAt) {
+			return nil
+		}
+	}
+
+	if maxObjectSize != nil {
+		configData, err := base64.StdEncoding.DecodeString(*maxObjectSize)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		_, err = globalBucketMetadataSys.Update(ctx, bucket, bucketMaxObjectSizeConfig, configData)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		return nil
+	}
+
+	// Delete the maximum object size config
+	_, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketMaxObjectSizeConfig)
+	if err != nil {
+		return wrapSRErr(err)
+	}
+
+	return nil
+}
+
+// PeerBucketMaxVersionsHandler - copies/deletes maximum versions config to local cluster.
+func (c *SiteReplicationSys) PeerBucketMaxVersionsHandler(ctx context.Context, bucket string, maxVersions *string, updatedAt time.Time) error {
+	// skip overwrite if local update is newer than peer update.
+	if !updatedAt.IsZero() {
+		if _, updateTm, err := globalBucketMetadataSys.GetMaxVersionsConfig(bucket); err == nil && updateTm.After(updatedAt) {
+			return nil
+		}
+	}
+
+	if maxVersions != nil {
+		configData, err := base64.StdEncoding.DecodeString(*maxVersions)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		_, err = globalBucketMetadataSys.Update(ctx, bucket, bucketMaxVersionsConfig, configData)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		return nil
+	}
+
+	// Delete the maximum versions config
+	_, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketMaxVersionsConfig)
+	if err != nil {
+		return wrapSRErr(err)
+	}
+
+	return nil
+}
+
+// PeerBucketBulkDeleteHandler - copies/deletes bulk delete config to local cluster.
+func (c *SiteReplicationSys) PeerBucketBulkDeleteHandler(ctx context.Context, bucket string, bulkDelete *string, updatedAt time.Time) error {
+	// skip overwrite if local update is newer than peer update.
+	if !updatedAt.IsZero() {
+		if _, updateTm, err := globalBucketMetadataSys.GetBulkDeleteConfig(bucket); err == nil && updateTm.After(updatedAt) {
+			return nil
+		}
+	}
+
+	if bulkDelete != nil {
+		configData, err := base64.StdEncoding.DecodeString(*bulkDelete)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		_, err = globalBucketMetadataSys.Update(ctx, bucket, bucketBulkDeleteConfig, configData)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		return nil
+	}
+
+	// Delete the bulk delete config
+	_, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketBulkDeleteConfig)
+	if err != nil {
+		return wrapSRErr(err)
+	}
+
+	return nil
+}
+
+// PeerBucketFanOutRoutingHandler - copies/deletes fan-out routing config to local cluster.
+func (c *SiteReplicationSys) PeerBucketFanOutRoutingHandler(ctx context.Context, bucket string, fanOutRouting *string, updatedAt time.Time) error {
+	// skip overwrite if local update is newer than peer update.
+	if !updatedAt.IsZero() {
+		if _, updateTm, err := globalBucketMetadataSys.GetFanOutRoutingConfig(bucket); err == nil && updateTm.After(updatedAt) {
+			return nil
+		}
+	}
+
+	if fanOutRouting != nil {
+		configData, err := base64.StdEncoding.DecodeString(*fanOutRouting)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		_, err = globalBucketMetadataSys.Update(ctx, bucket, bucketFanOutRoutingConfig, configData)
+		if err != nil {
+			return wrapSRErr(err)
+		}
+		return nil
+	}
+
+	// Delete the fan-out routing config
+	_, err := globalBucketMetadataSys.Delete(ctx, bucket, bucketFanOutRoutingConfig)
+	if err != nil {
+		return wrapSRErr(err)
+	}
+
+	return nil
+}
+
 // PeerBucketObjectLockConfigHandler - sets object lock on local bucket.
 func (c *SiteReplicationSys) PeerBucketObjectLockConfigHandler(ctx context.Context, bucket string, objectLockData *string, updatedAt time.Time) error {
 	if objectLockData != nil {
@@ -3650,26 +3893,34 @@ func (c *SiteReplicationSys) startHealRoutine(ctx context.Context, objAPI Object
 
 	var maxRefreshDurationSecondsForLog float64 = 10 // 10 seconds..
 
+	heal := func() {
+		c.RLock()
+		enabled := c.enabled
+		c.RUnlock()
+		if enabled {
+			refreshStart := time.Now()
+			c.healIAMSystem(ctx, objAPI) // heal IAM system first
+			c.healBuckets(ctx, objAPI)   // heal buckets subsequently
+
+			took := time.Since(refreshStart).Seconds()
+			if took > maxRefreshDurationSecondsForLog {
+				// Log if we took a lot of time.
+				logger.Info("Site replication healing refresh took %.2fs", took)
+			}
+
+			// wait for 200 millisecond, if we are experience lot of I/O
+			waitForLowIO(runtime.GOMAXPROCS(0), 200*time.Millisecond, currentHTTPIO)
+		}
+	}
+
 	for {
 		select {
 		case <-healTimer.C:
-			c.RLock()
-			enabled := c.enabled
-			c.RUnlock()
-			if enabled {
-				refreshStart := time.Now()
-				c.healIAMSystem(ctx, objAPI) // heal IAM system first
-				c.healBuckets(ctx, objAPI)   // heal buckets subsequently
-
-				took := time.Since(refreshStart).Seconds()
-				if took > maxRefreshDurationSecondsForLog {
-					// Log if we took a lot of time.
-					logger.Info("Site replication healing refresh took %.2fs", took)
-				}
+			heal()
+			healTimer.Reset(siteHealTimeInterval)
 
-				// wait for 200 millisecond, if we are experience lot of I/O
-				waitForLowIO(runtime.GOMAXPROCS(0), 200*time.Millisecond, currentHTTPIO)
-			}
+		case <-c.healKick:
+			heal()
 			healTimer.Reset(siteHealTimeInterval)
 
 		case <-ctx.Done():
@@ -3678,6 +3929,18 @@ func (c *SiteReplicationSys) startHealRoutine(ctx context.Context, objAPI Object
 	}
 }
 
+// kickHealRoutine requests an immediate healing pass, called when a peer
+// bucket metadata update is rejected and the sites need to reconcile.
+func (c *SiteReplicationSys) kickHealRoutine() {
+	if c.healKick == nil {
+		return
+	}
+	select {
+	case c.healKick <- struct{}{}:
+	default:
+	}
+}
+
 type srBucketStatsSummary struct {
 	madmin.SRBucketStatsSummary
 	meta srBucketMetaInfo
@@ -66,3 +66,91 @@ func TestGetMissingSiteNames(t *testing.T) {
 		}
 	}
 }
+
+// TestSRBucketMetaTypeEpoch tests the epoch suffix carried on bucket meta
+// types.
+func TestSRBucketMetaTypeEpoch(t *testing.T) {
+	testCases := []struct {
+		typ      string
+		expType  string
+		expEpoch uint64
+	}{
+		{"tags@4", "tags", 4},
+		{"tags", "tags", 0},
+		// Malformed suffixes keep the type untouched.
+		{"tags@x", "tags@x", 0},
+		{"policy@12", "policy", 12},
+	}
+	for i, tc := range testCases {
+		typ, epoch := srBucketMetaTypeSplitEpoch(tc.typ)
+		if typ != tc.expType || epoch != tc.expEpoch {
+			t.Errorf("Test %d: Expected (%s, %d), got (%s, %d)", i+1, tc.expType, tc.expEpoch, typ, epoch)
+		}
+	}
+	if got := srBucketMetaTypeWithEpoch("tags", 4); got != "tags@4" {
+		t.Errorf("Expected `tags@4`, got `%s`", got)
+	}
+	if got := srBucketMetaTypeWithEpoch("tags", 0); got != "tags" {
+		t.Errorf("Expected `tags`, got `%s`", got)
+	}
+}
+
+// TestSRBucketMetaEpochConcurrency simulates two sites updating the same
+// bucket, an update racing with another site's update must be rejected
+// while in-order successors are accepted.
+func TestSRBucketMetaEpochConcurrency(t *testing.T) {
+	// Both sites start in sync at epoch 3.
+	siteA, siteB := uint64(3), uint64(3)
+
+	// Site A updates locally and sends its bumped epoch.
+	siteA++
+	if !srBucketMetaEpochOK(siteA, siteB) {
+		t.Fatal("in-order update from site A must be accepted")
+	}
+	siteB = siteA // B applies the hook.
+
+	// Both sites update concurrently before either hook arrives.
+	siteA++
+	siteB++
+	if srBucketMetaEpochOK(siteA, siteB) {
+		t.Fatal("concurrent update from site A must be rejected on site B")
+	}
+	if srBucketMetaEpochOK(siteB, siteA) {
+		t.Fatal("concurrent update from site B must be rejected on site A")
+	}
+
+	// Hooks without an epoch keep last-write-wins semantics.
+	if !srBucketMetaEpochOK(0, siteB) {
+		t.Fatal("legacy hooks without epoch must be accepted")
+	}
+}
+
+func TestSRBucketEventSeen(t *testing.T) {
+	if srBucketEventSeen("dep-1:req-1") {
+		t.Fatal("expected first sighting to be new")
+	}
+	if !srBucketEventSeen("dep-1:req-1") {
+		t.Fatal("expected repeat sighting to be deduplicated")
+	}
+	if srBucketEventSeen("dep-1:req-2") {
+		t.Fatal("expected a distinct request to be new")
+	}
+}
+
+func TestPeerBucketEventHandlerDrops(t *testing.T) {
+	c := &SiteReplicationSys{}
+
+	if err := c.PeerBucketEventHandler(GlobalContext, nil); err == nil {
+		t.Fatal("expected error for missing payload")
+	}
+	garbage := "not-json"
+	if err := c.PeerBucketEventHandler(GlobalContext, &garbage); err == nil {
+		t.Fatal("expected error for malformed payload")
+	}
+	// An event that originated on this deployment is dropped without
+	// being re-emitted, forwarding cannot loop.
+	local := `{"eventName":"s3:BucketCreated:*","bucket":"foo","originDeploymentID":"` + globalDeploymentID + `","requestID":"req-1"}`
+	if err := c.PeerBucketEventHandler(GlobalContext, &local); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
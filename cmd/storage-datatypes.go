@@ -28,10 +28,24 @@ import (
 type DeleteOptions struct {
 	Recursive bool
 	Force     bool
+	// ReportStats requests a DeleteStats summary of the delete, the
+	// extra stat cost is only paid when it is set.
+	ReportStats bool
 }
 
 //go:generate msgp -file=$GOFILE
 
+// DeleteStats summarizes a Delete call, it is only populated when
+// DeleteOptions.ReportStats is set.
+type DeleteStats struct {
+	// BytesFreed is the size of the deleted file, zero for directories.
+	BytesFreed int64
+	// ParentEmpty is set when the parent directory of the deleted path
+	// holds no further entries, it may already have been pruned by the
+	// delete itself.
+	ParentEmpty bool
+}
+
 // DiskInfo is an extended type which returns current
 // disk usage per path.
 // The above means that any added/deleted fields are incompatible.
@@ -55,7 +69,14 @@ type DiskInfo struct {
 	MountPath  string
 	ID         string
 	Metrics    DiskMetrics
-	Error      string // carries the error over the network
+	// Capabilities are the storage REST protocol extensions advertised
+	// by the serving node, carried here for debugging mixed-version
+	// clusters.
+	Capabilities []string
+	// TLSIssue classifies the last TLS handshake failure against the
+	// endpoint, set on the client side for unreachable remote disks.
+	TLSIssue string
+	Error    string // carries the error over the network
 }
 
 // DiskMetrics has the information about XL Storage APIs
@@ -64,6 +85,10 @@ type DiskInfo struct {
 type DiskMetrics struct {
 	LastMinute map[string]AccElem `json:"apiLatencies,omitempty"`
 	APICalls   map[string]uint64  `json:"apiCalls,omitempty"`
+
+	// BackoffLevel is the number of consecutive DiskInfo probe
+	// failures for an online disk, zero when the last probe succeeded.
+	BackoffLevel int `json:"backoffLevel,omitempty"`
 }
 
 // VolsInfo is a collection of volume(bucket) information
@@ -135,6 +160,20 @@ func (f *FileInfoVersions) findVersionIndex(v string) int {
 	return -1
 }
 
+// MetadataPatch carries only the metadata fields that change during
+// frequent metadata-only updates such as replication status, avoiding
+// a full FileInfo encode/decode and rewrite on the wire.
+type MetadataPatch struct {
+	// Version of the file the patch applies to.
+	VersionID string `msg:"vid,omitempty"`
+
+	// Metadata keys to set on the version.
+	Metadata map[string]string `msg:"meta"`
+
+	// ModTime overrides the version mod time when non-zero.
+	ModTime time.Time `msg:"mt"`
+}
+
 // RawFileInfo - represents raw file stat information as byte array.
 // The above means that any added/deleted fields are incompatible.
 // Make sure to bump the internode version at storage-rest-common.go
@@ -334,6 +373,12 @@ type ReadMultipleReq struct {
 	MaxResults   int      // Stop after this many successful results. <= 0 means all.
 }
 
+// CleanAbandonedDataResp summarizes what CleanAbandonedData reclaimed.
+type CleanAbandonedDataResp struct {
+	DataDirs       int    // Number of abandoned data directories and inline entries removed.
+	BytesReclaimed uint64 // Bytes freed by removing them.
+}
+
 // ReadMultipleResp contains a single response from a ReadMultipleReq.
 type ReadMultipleResp struct {
 	Bucket  string    // Bucket as given by request.
@@ -344,3 +389,43 @@ type ReadMultipleResp struct {
 	Data    []byte    // Contains all data of file.
 	Modtime time.Time // Modtime of file on disk.
 }
+
+// RenameFilePair is a single source/destination entry of a bulk
+// RenameFiles call.
+type RenameFilePair struct {
+	SrcVolume string // Volume the source file lives in.
+	SrcPath   string // Source file path inside SrcVolume.
+	DstVolume string // Volume the file is renamed into.
+	DstPath   string // Destination file path inside DstVolume.
+}
+
+// Status of a single part in CheckPartsResp.Results.
+const (
+	checkPartUnknown = iota // part was not checked
+	checkPartSuccess
+	checkPartFileNotFound
+	checkPartFileCorrupt // present but shorter than the expected shard size
+)
+
+// CheckPartsResp is CheckParts()'s response, one result per entry of
+// the requested FileInfo.Parts in the same order.
+type CheckPartsResp struct {
+	Results []int
+}
+
+// Err collapses the per-part results into the single pass/fail error
+// older callers expect, the first bad part wins.
+func (c *CheckPartsResp) Err() error {
+	if c == nil {
+		return nil
+	}
+	for _, result := range c.Results {
+		switch result {
+		case checkPartFileNotFound:
+			return errFileNotFound
+		case checkPartFileCorrupt:
+			return errFileCorrupt
+		}
+	}
+	return nil
+}
@@ -6,6 +6,401 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// DecodeMsg implements msgp.Decodable
+func (z *CheckPartsResp) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Results":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Results")
+				return
+			}
+			if cap(z.Results) >= int(zb0002) {
+				z.Results = (z.Results)[:zb0002]
+			} else {
+				z.Results = make([]int, zb0002)
+			}
+			for za0001 := range z.Results {
+				z.Results[za0001], err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Results", za0001)
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CheckPartsResp) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 1
+	// write "Results"
+	err = en.Append(0x81, 0xa7, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Results)))
+	if err != nil {
+		err = msgp.WrapError(err, "Results")
+		return
+	}
+	for za0001 := range z.Results {
+		err = en.WriteInt(z.Results[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, "Results", za0001)
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CheckPartsResp) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "Results"
+	o = append(o, 0x81, 0xa7, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Results)))
+	for za0001 := range z.Results {
+		o = msgp.AppendInt(o, z.Results[za0001])
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CheckPartsResp) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Results":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Results")
+				return
+			}
+			if cap(z.Results) >= int(zb0002) {
+				z.Results = (z.Results)[:zb0002]
+			} else {
+				z.Results = make([]int, zb0002)
+			}
+			for za0001 := range z.Results {
+				z.Results[za0001], bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Results", za0001)
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CheckPartsResp) Msgsize() (s int) {
+	s = 1 + 8 + msgp.ArrayHeaderSize + (len(z.Results) * (msgp.IntSize))
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *CleanAbandonedDataResp) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "DataDirs":
+			z.DataDirs, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "DataDirs")
+				return
+			}
+		case "BytesReclaimed":
+			z.BytesReclaimed, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "BytesReclaimed")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z CleanAbandonedDataResp) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "DataDirs"
+	err = en.Append(0x82, 0xa8, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.DataDirs)
+	if err != nil {
+		err = msgp.WrapError(err, "DataDirs")
+		return
+	}
+	// write "BytesReclaimed"
+	err = en.Append(0xae, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.BytesReclaimed)
+	if err != nil {
+		err = msgp.WrapError(err, "BytesReclaimed")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z CleanAbandonedDataResp) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "DataDirs"
+	o = append(o, 0x82, 0xa8, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x73)
+	o = msgp.AppendInt(o, z.DataDirs)
+	// string "BytesReclaimed"
+	o = append(o, 0xae, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64)
+	o = msgp.AppendUint64(o, z.BytesReclaimed)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CleanAbandonedDataResp) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "DataDirs":
+			z.DataDirs, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DataDirs")
+				return
+			}
+		case "BytesReclaimed":
+			z.BytesReclaimed, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BytesReclaimed")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z CleanAbandonedDataResp) Msgsize() (s int) {
+	s = 1 + 9 + msgp.IntSize + 15 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DeleteStats) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "BytesFreed":
+			z.BytesFreed, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "BytesFreed")
+				return
+			}
+		case "ParentEmpty":
+			z.ParentEmpty, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "ParentEmpty")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DeleteStats) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "BytesFreed"
+	err = en.Append(0x82, 0xaa, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.BytesFreed)
+	if err != nil {
+		err = msgp.WrapError(err, "BytesFreed")
+		return
+	}
+	// write "ParentEmpty"
+	err = en.Append(0xab, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.ParentEmpty)
+	if err != nil {
+		err = msgp.WrapError(err, "ParentEmpty")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DeleteStats) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "BytesFreed"
+	o = append(o, 0x82, 0xaa, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x64)
+	o = msgp.AppendInt64(o, z.BytesFreed)
+	// string "ParentEmpty"
+	o = append(o, 0xab, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79)
+	o = msgp.AppendBool(o, z.ParentEmpty)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DeleteStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "BytesFreed":
+			z.BytesFreed, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BytesFreed")
+				return
+			}
+		case "ParentEmpty":
+			z.ParentEmpty, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ParentEmpty")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DeleteStats) Msgsize() (s int) {
+	s = 1 + 11 + msgp.Int64Size + 12 + msgp.BoolSize
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *DiskInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 	var zb0001 uint32
@@ -14,8 +409,8 @@ func (z *DiskInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	if zb0001 != 16 {
-		err = msgp.ArrayError{Wanted: 16, Got: zb0001}
+	if zb0001 != 18 {
+		err = msgp.ArrayError{Wanted: 18, Got: zb0001}
 		return
 	}
 	z.Total, err = dc.ReadUint64()
@@ -93,6 +488,29 @@ func (z *DiskInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 		err = msgp.WrapError(err, "Metrics")
 		return
 	}
+	var zb0002 uint32
+	zb0002, err = dc.ReadArrayHeader()
+	if err != nil {
+		err = msgp.WrapError(err, "Capabilities")
+		return
+	}
+	if cap(z.Capabilities) >= int(zb0002) {
+		z.Capabilities = (z.Capabilities)[:zb0002]
+	} else {
+		z.Capabilities = make([]string, zb0002)
+	}
+	for za0001 := range z.Capabilities {
+		z.Capabilities[za0001], err = dc.ReadString()
+		if err != nil {
+			err = msgp.WrapError(err, "Capabilities", za0001)
+			return
+		}
+	}
+	z.TLSIssue, err = dc.ReadString()
+	if err != nil {
+		err = msgp.WrapError(err, "TLSIssue")
+		return
+	}
 	z.Error, err = dc.ReadString()
 	if err != nil {
 		err = msgp.WrapError(err, "Error")
@@ -103,8 +521,8 @@ func (z *DiskInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *DiskInfo) EncodeMsg(en *msgp.Writer) (err error) {
-	// array header, size 16
-	err = en.Append(0xdc, 0x0, 0x10)
+	// array header, size 18
+	err = en.Append(0xdc, 0x0, 0x12)
 	if err != nil {
 		return
 	}
@@ -183,6 +601,23 @@ func (z *DiskInfo) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Metrics")
 		return
 	}
+	err = en.WriteArrayHeader(uint32(len(z.Capabilities)))
+	if err != nil {
+		err = msgp.WrapError(err, "Capabilities")
+		return
+	}
+	for za0001 := range z.Capabilities {
+		err = en.WriteString(z.Capabilities[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, "Capabilities", za0001)
+			return
+		}
+	}
+	err = en.WriteString(z.TLSIssue)
+	if err != nil {
+		err = msgp.WrapError(err, "TLSIssue")
+		return
+	}
 	err = en.WriteString(z.Error)
 	if err != nil {
 		err = msgp.WrapError(err, "Error")
@@ -194,8 +629,8 @@ func (z *DiskInfo) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *DiskInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// array header, size 16
-	o = append(o, 0xdc, 0x0, 0x10)
+	// array header, size 18
+	o = append(o, 0xdc, 0x0, 0x12)
 	o = msgp.AppendUint64(o, z.Total)
 	o = msgp.AppendUint64(o, z.Free)
 	o = msgp.AppendUint64(o, z.Used)
@@ -215,6 +650,11 @@ func (z *DiskInfo) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Metrics")
 		return
 	}
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Capabilities)))
+	for za0001 := range z.Capabilities {
+		o = msgp.AppendString(o, z.Capabilities[za0001])
+	}
+	o = msgp.AppendString(o, z.TLSIssue)
 	o = msgp.AppendString(o, z.Error)
 	return
 }
@@ -227,8 +667,8 @@ func (z *DiskInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	if zb0001 != 16 {
-		err = msgp.ArrayError{Wanted: 16, Got: zb0001}
+	if zb0001 != 18 {
+		err = msgp.ArrayError{Wanted: 18, Got: zb0001}
 		return
 	}
 	z.Total, bts, err = msgp.ReadUint64Bytes(bts)
@@ -306,6 +746,29 @@ func (z *DiskInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Metrics")
 		return
 	}
+	var zb0002 uint32
+	zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err, "Capabilities")
+		return
+	}
+	if cap(z.Capabilities) >= int(zb0002) {
+		z.Capabilities = (z.Capabilities)[:zb0002]
+	} else {
+		z.Capabilities = make([]string, zb0002)
+	}
+	for za0001 := range z.Capabilities {
+		z.Capabilities[za0001], bts, err = msgp.ReadStringBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err, "Capabilities", za0001)
+			return
+		}
+	}
+	z.TLSIssue, bts, err = msgp.ReadStringBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err, "TLSIssue")
+		return
+	}
 	z.Error, bts, err = msgp.ReadStringBytes(bts)
 	if err != nil {
 		err = msgp.WrapError(err, "Error")
@@ -317,7 +780,11 @@ func (z *DiskInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DiskInfo) Msgsize() (s int) {
-	s = 3 + msgp.Uint64Size + msgp.Uint64Size + msgp.Uint64Size + msgp.Uint64Size + msgp.Uint64Size + msgp.Uint32Size + msgp.Uint32Size + msgp.StringPrefixSize + len(z.FSType) + msgp.BoolSize + msgp.BoolSize + msgp.BoolSize + msgp.StringPrefixSize + len(z.Endpoint) + msgp.StringPrefixSize + len(z.MountPath) + msgp.StringPrefixSize + len(z.ID) + z.Metrics.Msgsize() + msgp.StringPrefixSize + len(z.Error)
+	s = 3 + msgp.Uint64Size + msgp.Uint64Size + msgp.Uint64Size + msgp.Uint64Size + msgp.Uint64Size + msgp.Uint32Size + msgp.Uint32Size + msgp.StringPrefixSize + len(z.FSType) + msgp.BoolSize + msgp.BoolSize + msgp.BoolSize + msgp.StringPrefixSize + len(z.Endpoint) + msgp.StringPrefixSize + len(z.MountPath) + msgp.StringPrefixSize + len(z.ID) + z.Metrics.Msgsize() + msgp.ArrayHeaderSize
+	for za0001 := range z.Capabilities {
+		s += msgp.StringPrefixSize + len(z.Capabilities[za0001])
+	}
+	s += msgp.StringPrefixSize + len(z.TLSIssue) + msgp.StringPrefixSize + len(z.Error)
 	return
 }
 
@@ -399,6 +866,12 @@ func (z *DiskMetrics) DecodeMsg(dc *msgp.Reader) (err error) {
 				}
 				z.APICalls[za0003] = za0004
 			}
+		case "BackoffLevel":
+			z.BackoffLevel, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "BackoffLevel")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -412,9 +885,9 @@ func (z *DiskMetrics) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *DiskMetrics) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 2
+	// map header, size 3
 	// write "LastMinute"
-	err = en.Append(0x82, 0xaa, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65)
+	err = en.Append(0x83, 0xaa, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65)
 	if err != nil {
 		return
 	}
@@ -457,15 +930,25 @@ func (z *DiskMetrics) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	// write "BackoffLevel"
+	err = en.Append(0xac, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.BackoffLevel)
+	if err != nil {
+		err = msgp.WrapError(err, "BackoffLevel")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *DiskMetrics) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 3
 	// string "LastMinute"
-	o = append(o, 0x82, 0xaa, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65)
+	o = append(o, 0x83, 0xaa, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65)
 	o = msgp.AppendMapHeader(o, uint32(len(z.LastMinute)))
 	for za0001, za0002 := range z.LastMinute {
 		o = msgp.AppendString(o, za0001)
@@ -482,6 +965,9 @@ func (z *DiskMetrics) MarshalMsg(b []byte) (o []byte, err error) {
 		o = msgp.AppendString(o, za0003)
 		o = msgp.AppendUint64(o, za0004)
 	}
+	// string "BackoffLevel"
+	o = append(o, 0xac, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c)
+	o = msgp.AppendInt(o, z.BackoffLevel)
 	return
 }
 
@@ -563,6 +1049,12 @@ func (z *DiskMetrics) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.APICalls[za0003] = za0004
 			}
+		case "BackoffLevel":
+			z.BackoffLevel, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BackoffLevel")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -591,6 +1083,7 @@ func (z *DiskMetrics) Msgsize() (s int) {
 			s += msgp.StringPrefixSize + len(za0003) + msgp.Uint64Size
 		}
 	}
+	s += 13 + msgp.IntSize
 	return
 }
 
@@ -1430,20 +1923,192 @@ func (z *FileInfoVersions) UnmarshalMsg(bts []byte) (o []byte, err error) {
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z *FileInfoVersions) Msgsize() (s int) {
-	s = 1 + msgp.StringPrefixSize + len(z.Volume) + msgp.StringPrefixSize + len(z.Name) + msgp.TimeSize + msgp.ArrayHeaderSize
-	for za0001 := range z.Versions {
-		s += z.Versions[za0001].Msgsize()
-	}
-	s += msgp.ArrayHeaderSize
-	for za0002 := range z.FreeVersions {
-		s += z.FreeVersions[za0002].Msgsize()
+func (z *FileInfoVersions) Msgsize() (s int) {
+	s = 1 + msgp.StringPrefixSize + len(z.Volume) + msgp.StringPrefixSize + len(z.Name) + msgp.TimeSize + msgp.ArrayHeaderSize
+	for za0001 := range z.Versions {
+		s += z.Versions[za0001].Msgsize()
+	}
+	s += msgp.ArrayHeaderSize
+	for za0002 := range z.FreeVersions {
+		s += z.FreeVersions[za0002].Msgsize()
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *FilesInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Files":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Files")
+				return
+			}
+			if cap(z.Files) >= int(zb0002) {
+				z.Files = (z.Files)[:zb0002]
+			} else {
+				z.Files = make([]FileInfo, zb0002)
+			}
+			for za0001 := range z.Files {
+				err = z.Files[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Files", za0001)
+					return
+				}
+			}
+		case "IsTruncated":
+			z.IsTruncated, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "IsTruncated")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *FilesInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Files"
+	err = en.Append(0x82, 0xa5, 0x46, 0x69, 0x6c, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Files)))
+	if err != nil {
+		err = msgp.WrapError(err, "Files")
+		return
+	}
+	for za0001 := range z.Files {
+		err = z.Files[za0001].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Files", za0001)
+			return
+		}
+	}
+	// write "IsTruncated"
+	err = en.Append(0xab, 0x49, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.IsTruncated)
+	if err != nil {
+		err = msgp.WrapError(err, "IsTruncated")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *FilesInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Files"
+	o = append(o, 0x82, 0xa5, 0x46, 0x69, 0x6c, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Files)))
+	for za0001 := range z.Files {
+		o, err = z.Files[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Files", za0001)
+			return
+		}
+	}
+	// string "IsTruncated"
+	o = append(o, 0xab, 0x49, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64)
+	o = msgp.AppendBool(o, z.IsTruncated)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *FilesInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Files":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Files")
+				return
+			}
+			if cap(z.Files) >= int(zb0002) {
+				z.Files = (z.Files)[:zb0002]
+			} else {
+				z.Files = make([]FileInfo, zb0002)
+			}
+			for za0001 := range z.Files {
+				bts, err = z.Files[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Files", za0001)
+					return
+				}
+			}
+		case "IsTruncated":
+			z.IsTruncated, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "IsTruncated")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *FilesInfo) Msgsize() (s int) {
+	s = 1 + 6 + msgp.ArrayHeaderSize
+	for za0001 := range z.Files {
+		s += z.Files[za0001].Msgsize()
 	}
+	s += 12 + msgp.BoolSize
 	return
 }
 
 // DecodeMsg implements msgp.Decodable
-func (z *FilesInfo) DecodeMsg(dc *msgp.Reader) (err error) {
+func (z *MetadataPatch) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
 	_ = field
 	var zb0001 uint32
@@ -1460,29 +2125,46 @@ func (z *FilesInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
-		case "Files":
+		case "vid":
+			z.VersionID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "VersionID")
+				return
+			}
+		case "meta":
 			var zb0002 uint32
-			zb0002, err = dc.ReadArrayHeader()
+			zb0002, err = dc.ReadMapHeader()
 			if err != nil {
-				err = msgp.WrapError(err, "Files")
+				err = msgp.WrapError(err, "Metadata")
 				return
 			}
-			if cap(z.Files) >= int(zb0002) {
-				z.Files = (z.Files)[:zb0002]
-			} else {
-				z.Files = make([]FileInfo, zb0002)
+			if z.Metadata == nil {
+				z.Metadata = make(map[string]string, zb0002)
+			} else if len(z.Metadata) > 0 {
+				for key := range z.Metadata {
+					delete(z.Metadata, key)
+				}
 			}
-			for za0001 := range z.Files {
-				err = z.Files[za0001].DecodeMsg(dc)
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 string
+				za0001, err = dc.ReadString()
 				if err != nil {
-					err = msgp.WrapError(err, "Files", za0001)
+					err = msgp.WrapError(err, "Metadata")
+					return
+				}
+				za0002, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Metadata", za0001)
 					return
 				}
+				z.Metadata[za0001] = za0002
 			}
-		case "IsTruncated":
-			z.IsTruncated, err = dc.ReadBool()
+		case "mt":
+			z.ModTime, err = dc.ReadTime()
 			if err != nil {
-				err = msgp.WrapError(err, "IsTruncated")
+				err = msgp.WrapError(err, "ModTime")
 				return
 			}
 		default:
@@ -1497,60 +2179,106 @@ func (z *FilesInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 }
 
 // EncodeMsg implements msgp.Encodable
-func (z *FilesInfo) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 2
-	// write "Files"
-	err = en.Append(0x82, 0xa5, 0x46, 0x69, 0x6c, 0x65, 0x73)
+func (z *MetadataPatch) EncodeMsg(en *msgp.Writer) (err error) {
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	if z.VersionID == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
 		return
 	}
-	err = en.WriteArrayHeader(uint32(len(z.Files)))
+	if zb0001Len == 0 {
+		return
+	}
+	if (zb0001Mask & 0x1) == 0 { // if not empty
+		// write "vid"
+		err = en.Append(0xa3, 0x76, 0x69, 0x64)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.VersionID)
+		if err != nil {
+			err = msgp.WrapError(err, "VersionID")
+			return
+		}
+	}
+	// write "meta"
+	err = en.Append(0xa4, 0x6d, 0x65, 0x74, 0x61)
 	if err != nil {
-		err = msgp.WrapError(err, "Files")
 		return
 	}
-	for za0001 := range z.Files {
-		err = z.Files[za0001].EncodeMsg(en)
+	err = en.WriteMapHeader(uint32(len(z.Metadata)))
+	if err != nil {
+		err = msgp.WrapError(err, "Metadata")
+		return
+	}
+	for za0001, za0002 := range z.Metadata {
+		err = en.WriteString(za0001)
 		if err != nil {
-			err = msgp.WrapError(err, "Files", za0001)
+			err = msgp.WrapError(err, "Metadata")
+			return
+		}
+		err = en.WriteString(za0002)
+		if err != nil {
+			err = msgp.WrapError(err, "Metadata", za0001)
 			return
 		}
 	}
-	// write "IsTruncated"
-	err = en.Append(0xab, 0x49, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64)
+	// write "mt"
+	err = en.Append(0xa2, 0x6d, 0x74)
 	if err != nil {
 		return
 	}
-	err = en.WriteBool(z.IsTruncated)
+	err = en.WriteTime(z.ModTime)
 	if err != nil {
-		err = msgp.WrapError(err, "IsTruncated")
+		err = msgp.WrapError(err, "ModTime")
 		return
 	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z *FilesInfo) MarshalMsg(b []byte) (o []byte, err error) {
+func (z *MetadataPatch) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
-	// string "Files"
-	o = append(o, 0x82, 0xa5, 0x46, 0x69, 0x6c, 0x65, 0x73)
-	o = msgp.AppendArrayHeader(o, uint32(len(z.Files)))
-	for za0001 := range z.Files {
-		o, err = z.Files[za0001].MarshalMsg(o)
-		if err != nil {
-			err = msgp.WrapError(err, "Files", za0001)
-			return
-		}
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	_ = zb0001Mask
+	if z.VersionID == "" {
+		zb0001Len--
+		zb0001Mask |= 0x1
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len == 0 {
+		return
+	}
+	if (zb0001Mask & 0x1) == 0 { // if not empty
+		// string "vid"
+		o = append(o, 0xa3, 0x76, 0x69, 0x64)
+		o = msgp.AppendString(o, z.VersionID)
+	}
+	// string "meta"
+	o = append(o, 0xa4, 0x6d, 0x65, 0x74, 0x61)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Metadata)))
+	for za0001, za0002 := range z.Metadata {
+		o = msgp.AppendString(o, za0001)
+		o = msgp.AppendString(o, za0002)
 	}
-	// string "IsTruncated"
-	o = append(o, 0xab, 0x49, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64)
-	o = msgp.AppendBool(o, z.IsTruncated)
+	// string "mt"
+	o = append(o, 0xa2, 0x6d, 0x74)
+	o = msgp.AppendTime(o, z.ModTime)
 	return
 }
 
 // UnmarshalMsg implements msgp.Unmarshaler
-func (z *FilesInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+func (z *MetadataPatch) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
 	var zb0001 uint32
@@ -1567,29 +2295,46 @@ func (z *FilesInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
-		case "Files":
+		case "vid":
+			z.VersionID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VersionID")
+				return
+			}
+		case "meta":
 			var zb0002 uint32
-			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Files")
+				err = msgp.WrapError(err, "Metadata")
 				return
 			}
-			if cap(z.Files) >= int(zb0002) {
-				z.Files = (z.Files)[:zb0002]
-			} else {
-				z.Files = make([]FileInfo, zb0002)
+			if z.Metadata == nil {
+				z.Metadata = make(map[string]string, zb0002)
+			} else if len(z.Metadata) > 0 {
+				for key := range z.Metadata {
+					delete(z.Metadata, key)
+				}
 			}
-			for za0001 := range z.Files {
-				bts, err = z.Files[za0001].UnmarshalMsg(bts)
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 string
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
 				if err != nil {
-					err = msgp.WrapError(err, "Files", za0001)
+					err = msgp.WrapError(err, "Metadata")
+					return
+				}
+				za0002, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Metadata", za0001)
 					return
 				}
+				z.Metadata[za0001] = za0002
 			}
-		case "IsTruncated":
-			z.IsTruncated, bts, err = msgp.ReadBoolBytes(bts)
+		case "mt":
+			z.ModTime, bts, err = msgp.ReadTimeBytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "IsTruncated")
+				err = msgp.WrapError(err, "ModTime")
 				return
 			}
 		default:
@@ -1605,12 +2350,15 @@ func (z *FilesInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z *FilesInfo) Msgsize() (s int) {
-	s = 1 + 6 + msgp.ArrayHeaderSize
-	for za0001 := range z.Files {
-		s += z.Files[za0001].Msgsize()
+func (z *MetadataPatch) Msgsize() (s int) {
+	s = 1 + 4 + msgp.StringPrefixSize + len(z.VersionID) + 5 + msgp.MapHeaderSize
+	if z.Metadata != nil {
+		for za0001, za0002 := range z.Metadata {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
 	}
-	s += 12 + msgp.BoolSize
+	s += 3 + msgp.TimeSize
 	return
 }
 
@@ -2288,6 +3036,184 @@ func (z *ReadMultipleResp) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *RenameFilePair) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "SrcVolume":
+			z.SrcVolume, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "SrcVolume")
+				return
+			}
+		case "SrcPath":
+			z.SrcPath, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "SrcPath")
+				return
+			}
+		case "DstVolume":
+			z.DstVolume, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "DstVolume")
+				return
+			}
+		case "DstPath":
+			z.DstPath, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "DstPath")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *RenameFilePair) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "SrcVolume"
+	err = en.Append(0x84, 0xa9, 0x53, 0x72, 0x63, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.SrcVolume)
+	if err != nil {
+		err = msgp.WrapError(err, "SrcVolume")
+		return
+	}
+	// write "SrcPath"
+	err = en.Append(0xa7, 0x53, 0x72, 0x63, 0x50, 0x61, 0x74, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.SrcPath)
+	if err != nil {
+		err = msgp.WrapError(err, "SrcPath")
+		return
+	}
+	// write "DstVolume"
+	err = en.Append(0xa9, 0x44, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.DstVolume)
+	if err != nil {
+		err = msgp.WrapError(err, "DstVolume")
+		return
+	}
+	// write "DstPath"
+	err = en.Append(0xa7, 0x44, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.DstPath)
+	if err != nil {
+		err = msgp.WrapError(err, "DstPath")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *RenameFilePair) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "SrcVolume"
+	o = append(o, 0x84, 0xa9, 0x53, 0x72, 0x63, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.SrcVolume)
+	// string "SrcPath"
+	o = append(o, 0xa7, 0x53, 0x72, 0x63, 0x50, 0x61, 0x74, 0x68)
+	o = msgp.AppendString(o, z.SrcPath)
+	// string "DstVolume"
+	o = append(o, 0xa9, 0x44, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.DstVolume)
+	// string "DstPath"
+	o = append(o, 0xa7, 0x44, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68)
+	o = msgp.AppendString(o, z.DstPath)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *RenameFilePair) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "SrcVolume":
+			z.SrcVolume, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SrcVolume")
+				return
+			}
+		case "SrcPath":
+			z.SrcPath, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SrcPath")
+				return
+			}
+		case "DstVolume":
+			z.DstVolume, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DstVolume")
+				return
+			}
+		case "DstPath":
+			z.DstPath, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DstPath")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *RenameFilePair) Msgsize() (s int) {
+	s = 1 + 10 + msgp.StringPrefixSize + len(z.SrcVolume) + 8 + msgp.StringPrefixSize + len(z.SrcPath) + 10 + msgp.StringPrefixSize + len(z.DstVolume) + 8 + msgp.StringPrefixSize + len(z.DstPath)
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *VolInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 	var zb0001 uint32
@@ -57,18 +57,37 @@ var errFaultyDisk = StorageErr("drive is faulty")
 // errDiskAccessDenied - we don't have write permissions on disk.
 var errDiskAccessDenied = StorageErr("drive access denied")
 
+// errDiskBusy - remote drive queue depth is too high to serve
+// non-critical calls, callers should retry later.
+var errDiskBusy = StorageErr("drive is busy, retry the operation")
+
+// errDiskSlow - drive took longer than the configured read latency
+// budget to start returning data, callers should read elsewhere.
+var errDiskSlow = StorageErr("drive is too slow, exceeded read latency budget")
+
+// errFileGenerationMismatch - file changed on disk since the stream
+// being resumed was started, the read must restart from scratch.
+var errFileGenerationMismatch = StorageErr("file generation changed, cannot resume read")
+
 // errFileNotFound - cannot find the file.
 var errFileNotFound = StorageErr("file not found")
 
 // errFileNotFound - cannot find requested file version.
 var errFileVersionNotFound = StorageErr("file version not found")
 
+// errMetadataStale - metadata generation on disk is newer than the one
+// the caller last read, caller should re-read the metadata and retry.
+var errMetadataStale = StorageErr("metadata is stale, re-read and retry")
+
 // errTooManyOpenFiles - too many open files.
 var errTooManyOpenFiles = StorageErr("too many open files, please increase 'ulimit -n'")
 
 // errFileNameTooLong - given file name is too long than supported length.
 var errFileNameTooLong = StorageErr("file name too long")
 
+// errFileTooLarge - file is larger than the caller is willing to read.
+var errFileTooLarge = StorageErr("file is too large")
+
 // errVolumeExists - cannot create same volume again.
 var errVolumeExists = StorageErr("volume already exists")
 
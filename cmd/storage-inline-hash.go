@@ -0,0 +1,132 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"hash"
+	"io"
+)
+
+// inlineHashAlgo is the fixed algorithm used for inline hash trailers on
+// internode CreateFile()/ReadFileStream() exchanges, its sum size is part
+// of the wire format.
+const inlineHashAlgo = HighwayHash256S
+
+// inlineHashSize is the size of the trailer appended to the payload.
+var inlineHashSize = inlineHashAlgo.New().Size()
+
+// inlineHashCtxKey requests inline hash trailers on internode storage
+// calls made with this context.
+type inlineHashCtxKey struct{}
+
+// contextWithInlineHash requests inline hash trailers on internode
+// CreateFile()/ReadFileStream() exchanges made with the returned context.
+func contextWithInlineHash(ctx context.Context) context.Context {
+	return context.WithValue(ctx, inlineHashCtxKey{}, true)
+}
+
+// inlineHashFromContext reports whether inline hash trailers were
+// requested on this context.
+func inlineHashFromContext(ctx context.Context) bool {
+	v, _ := ctx.Value(inlineHashCtxKey{}).(bool)
+	return v
+}
+
+// newInlineHashAppender returns a reader streaming exactly length bytes
+// from r followed by a trailer carrying the hash of those bytes.
+func newInlineHashAppender(r io.Reader, length int64) io.Reader {
+	h := inlineHashAlgo.New()
+	return io.MultiReader(io.TeeReader(io.LimitReader(r, length), h), &hashSumReader{h: h})
+}
+
+// hashSumReader streams the sum of h, computed lazily on the first Read
+// so the wrapped stream has been fully hashed by then.
+type hashSumReader struct {
+	h   hash.Hash
+	buf *bytes.Reader
+}
+
+func (r *hashSumReader) Read(p []byte) (int, error) {
+	if r.buf == nil {
+		r.buf = bytes.NewReader(r.h.Sum(nil))
+	}
+	return r.buf.Read(p)
+}
+
+// newInlineHashVerifier returns a reader yielding exactly length bytes
+// from r, verified against the trailing hash the remote side appended.
+// A trailer mismatch surfaces as errFileCorrupt together with the last
+// bytes read, rejecting the corrupted transfer immediately.
+func newInlineHashVerifier(r io.Reader, length int64) io.Reader {
+	return &inlineHashVerifier{r: r, h: inlineHashAlgo.New(), remaining: length}
+}
+
+type inlineHashVerifier struct {
+	r         io.Reader
+	h         hash.Hash
+	remaining int64
+	verified  bool
+}
+
+func (v *inlineHashVerifier) Read(p []byte) (int, error) {
+	if v.remaining <= 0 {
+		if err := v.verify(); err != nil {
+			return 0, err
+		}
+		return 0, io.EOF
+	}
+	if int64(len(p)) > v.remaining {
+		p = p[:v.remaining]
+	}
+	n, err := v.r.Read(p)
+	v.h.Write(p[:n])
+	v.remaining -= int64(n)
+	if err != nil {
+		return n, err
+	}
+	if v.remaining == 0 {
+		if err := v.verify(); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (v *inlineHashVerifier) verify() error {
+	if v.verified {
+		return nil
+	}
+	v.verified = true
+	trailer := make([]byte, v.h.Size())
+	if _, err := io.ReadFull(v.r, trailer); err != nil {
+		return err
+	}
+	if !bytes.Equal(trailer, v.h.Sum(nil)) {
+		return errFileCorrupt
+	}
+	return nil
+}
+
+// inlineHashVerifyCloser wraps a response body with an inline hash
+// verifier while preserving Close of the underlying body.
+type inlineHashVerifyCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestInlineHashRoundTrip(t *testing.T) {
+	payload := make([]byte, 1<<20+17)
+	if _, err := rand.Read(payload); err != nil {
+		t.Fatal(err)
+	}
+
+	var wire bytes.Buffer
+	if _, err := io.Copy(&wire, newInlineHashAppender(bytes.NewReader(payload), int64(len(payload)))); err != nil {
+		t.Fatal(err)
+	}
+	if wire.Len() != len(payload)+inlineHashSize {
+		t.Fatalf("wire length: expected = %d, got = %d", len(payload)+inlineHashSize, wire.Len())
+	}
+
+	got, err := io.ReadAll(newInlineHashVerifier(&wire, int64(len(payload))))
+	if err != nil {
+		t.Fatalf("verify: expected no error, got = %v", err)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Fatal("verified payload does not match the original")
+	}
+}
+
+func TestInlineHashVerifierCorruption(t *testing.T) {
+	payload := make([]byte, 64<<10)
+	if _, err := rand.Read(payload); err != nil {
+		t.Fatal(err)
+	}
+
+	var wire bytes.Buffer
+	if _, err := io.Copy(&wire, newInlineHashAppender(bytes.NewReader(payload), int64(len(payload)))); err != nil {
+		t.Fatal(err)
+	}
+
+	// Flip a payload bit, the verifier must reject the stream.
+	corrupted := wire.Bytes()
+	corrupted[len(payload)/2] ^= 0x01
+
+	if _, err := io.ReadAll(newInlineHashVerifier(bytes.NewReader(corrupted), int64(len(payload)))); !errors.Is(err, errFileCorrupt) {
+		t.Fatalf("error: expected = %v, got = %v", errFileCorrupt, err)
+	}
+
+	// A truncated trailer must surface as an unexpected EOF, not as a
+	// clean stream.
+	truncated := wire.Bytes()[:wire.Len()-inlineHashSize/2]
+	if _, err := io.ReadAll(newInlineHashVerifier(bytes.NewReader(truncated), int64(len(payload)))); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("error: expected = %v, got = %v", io.ErrUnexpectedEOF, err)
+	}
+}
@@ -66,7 +66,7 @@ type StorageAPI interface {
 	// has never been replaced.
 	Healing() *healingTracker
 	DiskInfo(ctx context.Context) (info DiskInfo, err error)
-	NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode) (dataUsageCache, error)
+	NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode, scanErrs chan<- nsScannerError) (dataUsageCache, error)
 
 	// Volume operations.
 	MakeVol(ctx context.Context, volume string) (err error)
@@ -82,7 +82,9 @@ type StorageAPI interface {
 	DeleteVersion(ctx context.Context, volume, path string, fi FileInfo, forceDelMarker bool) error
 	DeleteVersions(ctx context.Context, volume string, versions []FileInfoVersions) []error
 	WriteMetadata(ctx context.Context, volume, path string, fi FileInfo) error
+	WriteMetadataIf(ctx context.Context, volume, path string, fi FileInfo, expectedSig uint64) error
 	UpdateMetadata(ctx context.Context, volume, path string, fi FileInfo) error
+	PatchMetadata(ctx context.Context, volume, path string, patch MetadataPatch) error
 	ReadVersion(ctx context.Context, volume, path, versionID string, readData bool) (FileInfo, error)
 	ReadXL(ctx context.Context, volume, path string, readData bool) (RawFileInfo, error)
 	RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (uint64, error)
@@ -94,12 +96,13 @@ type StorageAPI interface {
 	CreateFile(ctx context.Context, volume, path string, size int64, reader io.Reader) error
 	ReadFileStream(ctx context.Context, volume, path string, offset, length int64) (io.ReadCloser, error)
 	RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) error
-	CheckParts(ctx context.Context, volume string, path string, fi FileInfo) error
-	Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (err error)
+	RenameFiles(ctx context.Context, pairs []RenameFilePair) []error
+	CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (*CheckPartsResp, error)
+	Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (stats DeleteStats, err error)
 	VerifyFile(ctx context.Context, volume, path string, fi FileInfo) error
 	StatInfoFile(ctx context.Context, volume, path string, glob bool) (stat []StatInfo, err error)
 	ReadMultiple(ctx context.Context, req ReadMultipleReq, resp chan<- ReadMultipleResp) error
-	CleanAbandonedData(ctx context.Context, volume string, path string) error
+	CleanAbandonedData(ctx context.Context, volume string, path string) (CleanAbandonedDataResp, error)
 
 	// Write all data, syncs the data to disk.
 	// Should be used for smaller payloads.
@@ -107,6 +110,11 @@ type StorageAPI interface {
 
 	// Read all.
 	ReadAll(ctx context.Context, volume string, path string) (buf []byte, err error)
+
+	// Read up to length bytes starting at offset, additionally
+	// returning the total file size. Reads starting at or beyond the
+	// end of the file return io.EOF like a local read would.
+	ReadAllAt(ctx context.Context, volume string, path string, offset, length int64) (buf []byte, size int64, err error)
 	GetDiskLoc() (poolIdx, setIdx, diskIdx int) // Retrieve location indexes.
 	SetDiskLoc(poolIdx, setIdx, diskIdx int)    // Set location indexes.
 }
@@ -147,7 +155,7 @@ func (p *unrecognizedDisk) Healing() *healingTracker {
 	return nil
 }
 
-func (p *unrecognizedDisk) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode) (dataUsageCache, error) {
+func (p *unrecognizedDisk) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode, scanErrs chan<- nsScannerError) (dataUsageCache, error) {
 	return dataUsageCache{}, errDiskNotFound
 }
 
@@ -217,16 +225,25 @@ func (p *unrecognizedDisk) RenameFile(ctx context.Context, srcVolume, srcPath, d
 	return errDiskNotFound
 }
 
+func (p *unrecognizedDisk) RenameFiles(ctx context.Context, pairs []RenameFilePair) (errs []error) {
+	errs = make([]error, len(pairs))
+
+	for i := range errs {
+		errs[i] = errDiskNotFound
+	}
+	return errs
+}
+
 func (p *unrecognizedDisk) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (uint64, error) {
 	return 0, errDiskNotFound
 }
 
-func (p *unrecognizedDisk) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (err error) {
-	return errDiskNotFound
+func (p *unrecognizedDisk) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (*CheckPartsResp, error) {
+	return nil, errDiskNotFound
 }
 
-func (p *unrecognizedDisk) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (err error) {
-	return errDiskNotFound
+func (p *unrecognizedDisk) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (stats DeleteStats, err error) {
+	return DeleteStats{}, errDiskNotFound
 }
 
 // DeleteVersions deletes slice of versions, it can be same object
@@ -256,10 +273,18 @@ func (p *unrecognizedDisk) UpdateMetadata(ctx context.Context, volume, path stri
 	return errDiskNotFound
 }
 
+func (p *unrecognizedDisk) PatchMetadata(ctx context.Context, volume, path string, patch MetadataPatch) (err error) {
+	return errDiskNotFound
+}
+
 func (p *unrecognizedDisk) WriteMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
 	return errDiskNotFound
 }
 
+func (p *unrecognizedDisk) WriteMetadataIf(ctx context.Context, volume, path string, fi FileInfo, expectedSig uint64) (err error) {
+	return errDiskNotFound
+}
+
 func (p *unrecognizedDisk) ReadVersion(ctx context.Context, volume, path, versionID string, readData bool) (fi FileInfo, err error) {
 	return fi, errDiskNotFound
 }
@@ -272,6 +297,10 @@ func (p *unrecognizedDisk) ReadAll(ctx context.Context, volume string, path stri
 	return nil, errDiskNotFound
 }
 
+func (p *unrecognizedDisk) ReadAllAt(ctx context.Context, volume string, path string, offset, length int64) (buf []byte, size int64, err error) {
+	return nil, 0, errDiskNotFound
+}
+
 func (p *unrecognizedDisk) StatInfoFile(ctx context.Context, volume, path string, glob bool) (stat []StatInfo, err error) {
 	return nil, errDiskNotFound
 }
@@ -281,6 +310,6 @@ func (p *unrecognizedDisk) ReadMultiple(ctx context.Context, req ReadMultipleReq
 	return errDiskNotFound
 }
 
-func (p *unrecognizedDisk) CleanAbandonedData(ctx context.Context, volume string, path string) error {
-	return errDiskNotFound
+func (p *unrecognizedDisk) CleanAbandonedData(ctx context.Context, volume string, path string) (res CleanAbandonedDataResp, err error) {
+	return res, errDiskNotFound
 }
@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/gob"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -31,12 +32,16 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/klauspost/compress/s2"
 	"github.com/minio/madmin-go/v2"
 	xhttp "github.com/minio/minio/internal/http"
+	xioutil "github.com/minio/minio/internal/ioutil"
 	"github.com/minio/minio/internal/logger"
 	"github.com/minio/minio/internal/rest"
+	"github.com/minio/pkg/env"
 	xnet "github.com/minio/pkg/net"
 	xbufio "github.com/philhofer/fwd"
 	"github.com/tinylib/msgp/msgp"
@@ -51,6 +56,12 @@ func isNetworkError(err error) bool {
 		if down := xnet.IsNetworkOrHostDown(nerr.Err, false); down {
 			return true
 		}
+		// A failed TLS handshake means the peer cannot be talked to,
+		// same as any other transport failure.
+		var terr *rest.TLSHandshakeError
+		if errors.As(nerr.Err, &terr) {
+			return true
+		}
 	}
 
 	// More corner cases suitable for storage REST API
@@ -76,6 +87,14 @@ func toStorageErr(err error) error {
 		return nil
 	}
 
+	// TLS handshake failures stay distinct, collapsing them into
+	// errDiskNotFound would hide an expiring or mis-issued internode
+	// certificate behind a generic network error.
+	var terr *rest.TLSHandshakeError
+	if errors.As(err, &terr) {
+		return err
+	}
+
 	if isNetworkError(err) {
 		return errDiskNotFound
 	}
@@ -97,8 +116,12 @@ func toStorageErr(err error) error {
 		return errFileNotFound
 	case errFileVersionNotFound.Error():
 		return errFileVersionNotFound
+	case errMetadataStale.Error():
+		return errMetadataStale
 	case errFileNameTooLong.Error():
 		return errFileNameTooLong
+	case errFileTooLarge.Error():
+		return errFileTooLarge
 	case errFileAccessDenied.Error():
 		return errFileAccessDenied
 	case errPathNotFound.Error():
@@ -129,12 +152,56 @@ func toStorageErr(err error) error {
 		return errDiskNotFound
 	case errDiskNotFound.Error():
 		return errDiskNotFound
+	case errDiskBusy.Error():
+		return errDiskBusy
+	case errDiskSlow.Error():
+		return errDiskSlow
+	case errFileGenerationMismatch.Error():
+		return errFileGenerationMismatch
 	}
 	return err
 }
 
+// maxRemoteQueueDepth is the remote handler queue depth beyond which
+// non-critical calls (scanner, listing readahead) are shed with
+// errDiskBusy, data path calls always go through.
+const maxRemoteQueueDepth = 512
+
+// globalStorageShedRequests tracks the number of shed requests per
+// remote endpoint, exposed as internode metrics.
+var globalStorageShedRequests sync.Map // endpoint -> *uint64
+
+// globalStorageRESTClients tracks one rest client per remote endpoint
+// host, used to expose the TLS certificate expiry of each peer as
+// internode metrics.
+var globalStorageRESTClients sync.Map // host -> *rest.Client
+
+// storageReadLatencyBudget, when set, bounds how long a remote read may
+// take to start delivering bytes before the client gives up with
+// errDiskSlow, letting the erasure layer reconstruct from parity right
+// away instead of waiting out a hard timeout. Zero keeps the strict
+// behavior of waiting for the full request timeout.
+var storageReadLatencyBudget time.Duration
+
+func init() {
+	s := env.Get("_MINIO_DISK_READ_LATENCY_BUDGET", "")
+	if s == "" {
+		return
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		logger.Info("invalid _MINIO_DISK_READ_LATENCY_BUDGET value: %s, keeping strict reads", s)
+		return
+	}
+	storageReadLatencyBudget = d
+}
+
 // Abstracts a remote disk.
 type storageRESTClient struct {
+	// queueDepth is the handler queue depth last advertised by the
+	// remote endpoint. Aligned for atomic access.
+	queueDepth int64
+
 	endpoint   Endpoint
 	restClient *rest.Client
 	diskID     string
@@ -142,9 +209,45 @@ type storageRESTClient struct {
 	// Indexes, will be -1 until assigned a set.
 	poolIndex, setIndex, diskIndex int
 
+	// readLatencyBudget bounds how long ReadFile/ReadFileStream may
+	// take to start delivering bytes, zero means strict mode.
+	readLatencyBudget time.Duration
+
+	// Capabilities advertised by the remote, fetched once per
+	// connection, guarded by capsMu.
+	capsMu    sync.Mutex
+	caps      storageCapabilities
+	capsConn  time.Time
+	capsValid bool
+
 	diskInfoCache timedValue
 }
 
+// Capabilities returns the capabilities advertised by the remote storage
+// REST server. They are fetched on the first call after a (re)connect and
+// cached, servers too old for the handshake yield base capabilities with
+// only the API version filled in.
+func (client *storageRESTClient) Capabilities(ctx context.Context) storageCapabilities {
+	lastConn := client.restClient.LastConn()
+
+	client.capsMu.Lock()
+	defer client.capsMu.Unlock()
+
+	if client.capsValid && client.capsConn.Equal(lastConn) {
+		return client.caps
+	}
+	caps := storageCapabilities{APIVersion: storageRESTVersion}
+	respBody, err := client.restClient.Call(ctx, storageRESTMethodCapabilities, nil, nil, -1)
+	if err == nil {
+		if derr := json.NewDecoder(io.LimitReader(respBody, 16<<10)).Decode(&caps); derr != nil {
+			caps = storageCapabilities{APIVersion: storageRESTVersion}
+		}
+		xhttp.DrainBody(respBody)
+	}
+	client.caps, client.capsConn, client.capsValid = caps, lastConn, true
+	return caps
+}
+
 // Retrieve location indexes.
 func (client *storageRESTClient) GetDiskLoc() (poolIdx, setIdx, diskIdx int) {
 	return client.poolIndex, client.setIndex, client.diskIndex
@@ -157,6 +260,80 @@ func (client *storageRESTClient) SetDiskLoc(poolIdx, setIdx, diskIdx int) {
 	client.diskIndex = diskIdx
 }
 
+// shedNonCritical fails the call proactively with errDiskBusy if the
+// remote endpoint last advertised a handler queue depth beyond
+// maxRemoteQueueDepth. Only non-critical callers (scanner, listing)
+// consult this, callers treat errDiskBusy as retry-later.
+func (client *storageRESTClient) shedNonCritical() error {
+	if atomic.LoadInt64(&client.queueDepth) > maxRemoteQueueDepth {
+		v, _ := globalStorageShedRequests.LoadOrStore(client.endpoint.String(), new(uint64))
+		atomic.AddUint64(v.(*uint64), 1)
+		return errDiskBusy
+	}
+	return nil
+}
+
+// storageCallClass groups storage REST methods by their expected
+// duration, each class carries its own configurable deadline.
+type storageCallClass int
+
+const (
+	// storageCallNone leaves the call unbounded regardless of
+	// configuration.
+	storageCallNone storageCallClass = iota
+	// storageCallMetadata covers quick metadata operations.
+	storageCallMetadata
+	// storageCallSmallRead covers reads bounded in size.
+	storageCallSmallRead
+	// storageCallStreamRead covers reads streaming arbitrary amounts
+	// of data.
+	storageCallStreamRead
+	// storageCallWrite covers data writes.
+	storageCallWrite
+)
+
+// storageCallClassOf maps a storage REST method to its deadline class.
+func storageCallClassOf(method string) storageCallClass {
+	switch method {
+	case storageRESTMethodMakeVol, storageRESTMethodMakeVolBulk,
+		storageRESTMethodStatVol, storageRESTMethodDeleteVol,
+		storageRESTMethodListVols, storageRESTMethodWriteMetadata,
+		storageRESTMethodUpdateMetadata, storageRESTMethodPatchMetadata,
+		storageRESTMethodDeleteVersion, storageRESTMethodDeleteFile,
+		storageRESTMethodDeleteVersions, storageRESTMethodRenameFile,
+		storageRESTMethodRenameFiles, storageRESTMethodRenameData,
+		storageRESTMethodCheckParts, storageRESTMethodCheckVersion,
+		storageRESTMethodCleanAbandoned:
+		return storageCallMetadata
+	case storageRESTMethodDiskInfo, storageRESTMethodReadVersion,
+		storageRESTMethodReadXL, storageRESTMethodReadAll,
+		storageRESTMethodListDir, storageRESTMethodStatInfoFile:
+		return storageCallSmallRead
+	case storageRESTMethodReadFile, storageRESTMethodReadFileStream,
+		storageRESTMethodReadMultiple, storageRESTMethodWalkDir,
+		storageRESTMethodNSScanner:
+		return storageCallStreamRead
+	case storageRESTMethodAppendFile, storageRESTMethodCreateFile,
+		storageRESTMethodWriteAll, storageRESTMethodVerifyFile:
+		return storageCallWrite
+	}
+	return storageCallNone
+}
+
+// deadlineBody ties a response body to the cancel of the deadline
+// context derived for the call, so the deadline stays armed while the
+// caller reads the response and is released on Close.
+type deadlineBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *deadlineBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // Wrapper to restClient.Call to handle network errors, in case of network error the connection is makred disconnected
 // permanently. The only way to restore the storage connection is at the xl-sets layer by xlsets.monitorAndConnectEndpoints()
 // after verifying format.json
@@ -165,6 +342,20 @@ func (client *storageRESTClient) call(ctx context.Context, method string, values
 		values = make(url.Values)
 	}
 	values.Set(storageRESTDiskID, client.diskID)
+	if _, ok := ctx.Deadline(); !ok {
+		// Callers without a deadline inherit the configured one for
+		// this method class, if any.
+		if deadline := globalAPIConfig.getStorageDeadline(storageCallClassOf(method)); deadline > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, deadline)
+			respBody, err := client.restClient.Call(ctx, method, values, body, length)
+			if err != nil {
+				cancel()
+				return nil, toStorageErr(err)
+			}
+			return &deadlineBody{ReadCloser: respBody, cancel: cancel}, nil
+		}
+	}
 	respBody, err := client.restClient.Call(ctx, method, values, body, length)
 	if err == nil {
 		return respBody, nil
@@ -174,6 +365,29 @@ func (client *storageRESTClient) call(ctx context.Context, method string, values
 	return nil, err
 }
 
+// withStorageDeadline applies the configured per-class deadline to fn, a
+// call going through restClient directly rather than through call(). The
+// returned body keeps the deadline armed until it is closed, exactly as
+// call() arranges, so no storage method escapes the deadline map.
+func withStorageDeadline(ctx context.Context, method string, fn func(context.Context) (io.ReadCloser, http.Header, error)) (io.ReadCloser, http.Header, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		if deadline := globalAPIConfig.getStorageDeadline(storageCallClassOf(method)); deadline > 0 {
+			ctx, cancel := context.WithTimeout(ctx, deadline)
+			respBody, hdr, err := fn(ctx)
+			if err != nil {
+				cancel()
+				return nil, nil, toStorageErr(err)
+			}
+			return &deadlineBody{ReadCloser: respBody, cancel: cancel}, hdr, nil
+		}
+	}
+	respBody, hdr, err := fn(ctx)
+	if err != nil {
+		return nil, nil, toStorageErr(err)
+	}
+	return respBody, hdr, nil
+}
+
 // Stringer provides a canonicalized representation of network device.
 func (client *storageRESTClient) String() string {
 	return client.endpoint.String()
@@ -189,6 +403,25 @@ func (client *storageRESTClient) LastConn() time.Time {
 	return client.restClient.LastConn()
 }
 
+// PauseHealthChecks - suspends health checking of the remote disk for
+// planned maintenance, avoiding reconnect probes and flapping alerts
+// while the peer is expected to be down.
+func (client *storageRESTClient) PauseHealthChecks() {
+	client.restClient.PauseHealthCheck()
+}
+
+// ResumeHealthChecks - ends the maintenance state, the disk is brought
+// back online by the regular reconnect probe.
+func (client *storageRESTClient) ResumeHealthChecks() {
+	client.restClient.ResumeHealthCheck()
+}
+
+// InMaintenance - returns true while health checking is paused,
+// distinguishing planned maintenance from a disk that went down.
+func (client *storageRESTClient) InMaintenance() bool {
+	return client.restClient.IsInMaintenance()
+}
+
 func (client *storageRESTClient) IsLocal() bool {
 	return false
 }
@@ -207,8 +440,14 @@ func (client *storageRESTClient) Healing() *healingTracker {
 	return nil
 }
 
-func (client *storageRESTClient) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode) (dataUsageCache, error) {
+func (client *storageRESTClient) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode, scanErrs chan<- nsScannerError) (dataUsageCache, error) {
 	defer close(updates)
+	if scanErrs != nil {
+		defer close(scanErrs)
+	}
+	if err := client.shedNonCritical(); err != nil {
+		return cache, err
+	}
 	pr, pw := io.Pipe()
 	go func() {
 		pw.CloseWithError(cache.serializeTo(pw))
@@ -229,25 +468,51 @@ func (client *storageRESTClient) NSScanner(ctx context.Context, cache dataUsageC
 
 	ms := msgp.NewReader(rr)
 	for {
-		// Read whether it is an update.
+		// Read whether a streamed message follows.
 		upd, err := ms.ReadBool()
 		if err != nil {
 			rr.CloseWithError(err)
 			return cache, err
 		}
 		if !upd {
-			// No more updates... New cache follows.
+			// No more messages... New cache follows.
 			break
 		}
-		var update dataUsageEntry
-		err = update.DecodeMsg(ms)
-		if err != nil || err == io.EOF {
+		// Read the message kind.
+		kind, err := ms.ReadUint8()
+		if err != nil {
 			rr.CloseWithError(err)
 			return cache, err
 		}
-		select {
-		case <-ctx.Done():
-		case updates <- update:
+		switch kind {
+		case nsScannerMsgUpdate:
+			var update dataUsageEntry
+			err = update.DecodeMsg(ms)
+			if err != nil || err == io.EOF {
+				rr.CloseWithError(err)
+				return cache, err
+			}
+			select {
+			case <-ctx.Done():
+			case updates <- update:
+			}
+		case nsScannerMsgError:
+			var scanErr nsScannerError
+			err = scanErr.DecodeMsg(ms)
+			if err != nil || err == io.EOF {
+				rr.CloseWithError(err)
+				return cache, err
+			}
+			if scanErrs != nil {
+				select {
+				case <-ctx.Done():
+				case scanErrs <- scanErr:
+				}
+			}
+		default:
+			err = fmt.Errorf("NSScanner: unexpected message kind %d", kind)
+			rr.CloseWithError(err)
+			return cache, err
 		}
 	}
 	var newCache dataUsageCache
@@ -279,13 +544,25 @@ func (client *storageRESTClient) DiskInfo(_ context.Context) (info DiskInfo, err
 		// were attempted. This can lead to false success under certain conditions
 		// - this change attempts to avoid stale information if the underlying
 		// transport is already down.
+		info.TLSIssue = client.restClient.LastTLSIssue()
 		return info, errDiskNotFound
 	}
 	client.diskInfoCache.Once.Do(func() {
 		client.diskInfoCache.TTL = time.Second
+		// A flapping-but-online disk would otherwise be re-probed by
+		// every caller each second with the full call deadline, back
+		// off error states instead of retrying them in lockstep.
+		client.diskInfoCache.Backoff = true
 		client.diskInfoCache.Update = func() (interface{}, error) {
 			var info DiskInfo
-			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			// The configured small read deadline bounds the call,
+			// falling back to the historical 5 second bound when
+			// the class is unbounded.
+			deadline := globalAPIConfig.getStorageDeadline(storageCallSmallRead)
+			if deadline <= 0 {
+				deadline = 5 * time.Second
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), deadline)
 			defer cancel()
 			respBody, err := client.call(ctx, storageRESTMethodDiskInfo, nil, nil, -1)
 			if err != nil {
@@ -303,8 +580,9 @@ func (client *storageRESTClient) DiskInfo(_ context.Context) (info DiskInfo, err
 	})
 	val, err := client.diskInfoCache.Get()
 	if val != nil {
-		return val.(DiskInfo), err
+		info = val.(DiskInfo)
 	}
+	info.Metrics.BackoffLevel = client.diskInfoCache.BackoffLevel()
 	return info, err
 }
 
@@ -379,7 +657,15 @@ func (client *storageRESTClient) CreateFile(ctx context.Context, volume, path st
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
 	values.Set(storageRESTLength, strconv.Itoa(int(size)))
-	respBody, err := client.call(ctx, storageRESTMethodCreateFile, values, io.NopCloser(reader), size)
+	length := size
+	if inlineHashFromContext(ctx) {
+		// Append a trailing hash of the payload for the server to verify
+		// as it writes.
+		values.Set(storageRESTInlineHash, "true")
+		reader = newInlineHashAppender(reader, size)
+		length += int64(inlineHashSize)
+	}
+	respBody, err := client.call(ctx, storageRESTMethodCreateFile, values, io.NopCloser(reader), length)
 	defer xhttp.DrainBody(respBody)
 	if err != nil {
 		return err
@@ -388,17 +674,70 @@ func (client *storageRESTClient) CreateFile(ctx context.Context, volume, path st
 	return err
 }
 
+// metadataCompressMinSize is the smallest msgp encoded FileInfo worth
+// compressing on the wire, below it the cpu spent outweighs the
+// bandwidth saved.
+const metadataCompressMinSize = 8 << 10
+
+// callWithFileInfo posts the msgp encoded FileInfo to the given method,
+// compressing it on the wire when it is large enough and the server
+// advertised support for compressed metadata payloads.
+func (client *storageRESTClient) callWithFileInfo(ctx context.Context, method string, values url.Values, fi FileInfo) (io.ReadCloser, error) {
+	var reader bytes.Buffer
+	if err := msgp.Encode(&reader, &fi); err != nil {
+		return nil, err
+	}
+
+	if reader.Len() < metadataCompressMinSize || !client.Capabilities(ctx).Has(storageCapabilityCompressedMetadata) {
+		return client.call(ctx, method, values, &reader, -1)
+	}
+
+	var compressed bytes.Buffer
+	s2w := s2.NewWriter(&compressed)
+	if _, err := s2w.Write(reader.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := s2w.Close(); err != nil {
+		return nil, err
+	}
+
+	hdr := make(http.Header, 1)
+	hdr.Set(storageRESTEncodingHeader, storageRESTEncodingS2)
+	values.Set(storageRESTDiskID, client.diskID)
+	respBody, _, err := withStorageDeadline(ctx, method, func(ctx context.Context) (io.ReadCloser, http.Header, error) {
+		rc, err := client.restClient.CallWithRequestHeaders(ctx, method, values, &compressed, -1, hdr)
+		return rc, nil, err
+	})
+	return respBody, err
+}
+
 func (client *storageRESTClient) WriteMetadata(ctx context.Context, volume, path string, fi FileInfo) error {
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
 
-	var reader bytes.Buffer
-	if err := msgp.Encode(&reader, &fi); err != nil {
-		return err
+	respBody, err := client.callWithFileInfo(ctx, storageRESTMethodWriteMetadata, values, fi)
+	defer xhttp.DrainBody(respBody)
+	return err
+}
+
+// WriteMetadataIf writes fi only when the metadata on the remote disk still
+// matches expectedSig, errMetadataStale is returned on mismatch. Older peers
+// that do not advertise the extension fail with
+// errRPCAPIVersionUnsupported, conditional writes cannot be emulated safely
+// so callers decide themselves whether to fall back to an unconditional
+// write.
+func (client *storageRESTClient) WriteMetadataIf(ctx context.Context, volume, path string, fi FileInfo, expectedSig uint64) error {
+	if !client.Capabilities(ctx).Has(storageCapabilityWriteMetadataIf) {
+		return errRPCAPIVersionUnsupported
 	}
 
-	respBody, err := client.call(ctx, storageRESTMethodWriteMetadata, values, &reader, -1)
+	values := make(url.Values)
+	values.Set(storageRESTVolume, volume)
+	values.Set(storageRESTFilePath, path)
+	values.Set(storageRESTExpectedSig, strconv.FormatUint(expectedSig, 10))
+
+	respBody, err := client.callWithFileInfo(ctx, storageRESTMethodWriteMetadataIf, values, fi)
 	defer xhttp.DrainBody(respBody)
 	return err
 }
@@ -408,12 +747,31 @@ func (client *storageRESTClient) UpdateMetadata(ctx context.Context, volume, pat
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
 
+	respBody, err := client.callWithFileInfo(ctx, storageRESTMethodUpdateMetadata, values, fi)
+	defer xhttp.DrainBody(respBody)
+	return err
+}
+
+func (client *storageRESTClient) PatchMetadata(ctx context.Context, volume, path string, patch MetadataPatch) error {
+	if !client.Capabilities(ctx).Has(storageCapabilityMetadataPatch) {
+		// Older servers apply the same fields via the full update.
+		return client.UpdateMetadata(ctx, volume, path, FileInfo{
+			VersionID: patch.VersionID,
+			Metadata:  patch.Metadata,
+			ModTime:   patch.ModTime,
+		})
+	}
+
+	values := make(url.Values)
+	values.Set(storageRESTVolume, volume)
+	values.Set(storageRESTFilePath, path)
+
 	var reader bytes.Buffer
-	if err := msgp.Encode(&reader, &fi); err != nil {
+	if err := msgp.Encode(&reader, &patch); err != nil {
 		return err
 	}
 
-	respBody, err := client.call(ctx, storageRESTMethodUpdateMetadata, values, &reader, -1)
+	respBody, err := client.call(ctx, storageRESTMethodPatchMetadata, values, &reader, -1)
 	defer xhttp.DrainBody(respBody)
 	return err
 }
@@ -444,8 +802,9 @@ func (client *storageRESTClient) WriteAll(ctx context.Context, volume string, pa
 	return err
 }
 
-// CheckParts - stat all file parts.
-func (client *storageRESTClient) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) error {
+// CheckParts - stat all file parts, returns the per-part status report
+// of the remote disk along with the pass/fail view of it.
+func (client *storageRESTClient) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (*CheckPartsResp, error) {
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
@@ -453,44 +812,83 @@ func (client *storageRESTClient) CheckParts(ctx context.Context, volume string,
 	var reader bytes.Buffer
 	if err := msgp.Encode(&reader, &fi); err != nil {
 		logger.LogIf(context.Background(), err)
-		return err
+		return nil, err
 	}
 
 	respBody, err := client.call(ctx, storageRESTMethodCheckParts, values, &reader, -1)
 	defer xhttp.DrainBody(respBody)
-	return err
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &CheckPartsResp{}
+	if err = msgp.Decode(respBody, resp); err != nil {
+		return nil, err
+	}
+	return resp, resp.Err()
 }
 
 // RenameData - rename source path to destination path atomically, metadata and data file.
 func (client *storageRESTClient) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (sign uint64, err error) {
+	sign, _, err = client.renameData(ctx, srcVolume, srcPath, fi, dstVolume, dstPath, false)
+	return sign, err
+}
+
+// renameDataTimings breaks down where a RenameData call spent its
+// time. The server-side durations come out of RenameDataResp, Total is
+// measured around the round trip, the remainder is time on the network.
+// Against an older server the server-side durations stay zero.
+type renameDataTimings struct {
+	Total  time.Duration // full round trip as seen by the client
+	Decode time.Duration // server-side decode of the FileInfo payload
+	Rename time.Duration // server-side rename including any syncs
+}
+
+// RenameDataWithTimings behaves exactly like RenameData, additionally
+// returning a timing breakdown of the call. Meant for profiling
+// write-latency regressions, the regular write path uses RenameData.
+func (client *storageRESTClient) RenameDataWithTimings(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (sign uint64, timings renameDataTimings, err error) {
+	return client.renameData(ctx, srcVolume, srcPath, fi, dstVolume, dstPath, true)
+}
+
+func (client *storageRESTClient) renameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string, collectTimings bool) (sign uint64, timings renameDataTimings, err error) {
 	values := make(url.Values)
 	values.Set(storageRESTSrcVolume, srcVolume)
 	values.Set(storageRESTSrcPath, srcPath)
 	values.Set(storageRESTDstVolume, dstVolume)
 	values.Set(storageRESTDstPath, dstPath)
+	if collectTimings {
+		values.Set(storageRESTTimings, "true")
+	}
 
 	var reader bytes.Buffer
 	if err = msgp.Encode(&reader, &fi); err != nil {
-		return 0, err
+		return 0, timings, err
 	}
 
+	start := time.Now()
 	respBody, err := client.call(ctx, storageRESTMethodRenameData, values, &reader, -1)
 	defer xhttp.DrainBody(respBody)
 	if err != nil {
-		return 0, err
+		return 0, timings, err
 	}
 
 	respReader, err := waitForHTTPResponse(respBody)
 	if err != nil {
-		return 0, err
+		return 0, timings, err
 	}
 
 	resp := &RenameDataResp{}
 	if err = gob.NewDecoder(respReader).Decode(resp); err != nil {
-		return 0, err
+		return 0, timings, err
+	}
+	if collectTimings {
+		timings.Total = time.Since(start)
+		timings.Decode = resp.DecodeDuration
+		timings.Rename = resp.RenameDuration
 	}
 
-	return resp.Signature, toStorageErr(resp.Err)
+	return resp.Signature, timings, toStorageErr(resp.Err)
 }
 
 // where we keep old *Readers
@@ -528,6 +926,24 @@ func (client *storageRESTClient) ReadVersion(ctx context.Context, volume, path,
 	return fi, err
 }
 
+// CheckVersion is a cheaper existence-only variant of ReadVersion, it
+// probes for (volume, path, versionID) without shipping or decoding the
+// version's FileInfo. A missing version surfaces as errFileVersionNotFound,
+// callers needing metadata must use ReadVersion.
+func (client *storageRESTClient) CheckVersion(ctx context.Context, volume, path, versionID string) error {
+	values := make(url.Values)
+	values.Set(storageRESTVolume, volume)
+	values.Set(storageRESTFilePath, path)
+	values.Set(storageRESTVersionID, versionID)
+
+	respBody, err := client.call(ctx, storageRESTMethodCheckVersion, values, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(respBody)
+	return nil
+}
+
 // ReadXL - reads all contents of xl.meta of a file.
 func (client *storageRESTClient) ReadXL(ctx context.Context, volume string, path string, readData bool) (rf RawFileInfo, err error) {
 	values := make(url.Values)
@@ -548,30 +964,305 @@ func (client *storageRESTClient) ReadXL(ctx context.Context, volume string, path
 }
 
 // ReadAll - reads all contents of a file.
+// readAllDefaultLimit caps ReadAll responses, generous for the config and
+// metadata files read through it while protecting the caller from a
+// corrupted or adversarial remote returning an unbounded body.
+const readAllDefaultLimit = 64 << 20
+
+// ReadAll fetches the entire file at path, capped at readAllDefaultLimit.
+// Callers that genuinely need larger reads should use ReadAllLimited with
+// an explicit limit.
 func (client *storageRESTClient) ReadAll(ctx context.Context, volume string, path string) ([]byte, error) {
+	return client.ReadAllLimited(ctx, volume, path, readAllDefaultLimit)
+}
+
+// ReadAllLimited fetches the entire file at path, returning
+// errFileTooLarge when the response exceeds maxSize. The content length
+// advertised by the server is used to pre-allocate the buffer and to
+// reject oversized responses before reading them.
+func (client *storageRESTClient) ReadAllLimited(ctx context.Context, volume string, path string, maxSize int64) ([]byte, error) {
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
-	respBody, err := client.call(ctx, storageRESTMethodReadAll, values, nil, -1)
+	values.Set(storageRESTDiskID, client.diskID)
+	respBody, hdr, err := withStorageDeadline(ctx, storageRESTMethodReadAll, func(ctx context.Context) (io.ReadCloser, http.Header, error) {
+		return client.restClient.CallWithHeaders(ctx, storageRESTMethodReadAll, values, nil, -1)
+	})
 	if err != nil {
 		return nil, err
 	}
 	defer xhttp.DrainBody(respBody)
-	return io.ReadAll(respBody)
+
+	var buf bytes.Buffer
+	if length, perr := strconv.ParseInt(hdr.Get(xhttp.ContentLength), 10, 64); perr == nil {
+		if length > maxSize {
+			return nil, errFileTooLarge
+		}
+		buf.Grow(int(length))
+	}
+	if _, err = io.Copy(&buf, io.LimitReader(respBody, maxSize+1)); err != nil {
+		return nil, err
+	}
+	if int64(buf.Len()) > maxSize {
+		return nil, errFileTooLarge
+	}
+	return buf.Bytes(), nil
 }
 
-// ReadFileStream - returns a reader for the requested file.
-func (client *storageRESTClient) ReadFileStream(ctx context.Context, volume, path string, offset, length int64) (io.ReadCloser, error) {
+// ReadAllAt reads up to length bytes starting at offset, returning the
+// bytes read together with the total file size. Older servers without
+// the extension serve the read from a full ReadAll, semantics are
+// preserved at the cost of bandwidth.
+func (client *storageRESTClient) ReadAllAt(ctx context.Context, volume string, path string, offset, length int64) (buf []byte, size int64, err error) {
+	if offset < 0 || length < 0 {
+		return nil, 0, errInvalidArgument
+	}
+	if !client.Capabilities(ctx).Has(storageCapabilityReadAllAt) {
+		data, err := client.ReadAll(ctx, volume, path)
+		if err != nil {
+			return nil, 0, err
+		}
+		size = int64(len(data))
+		if offset >= size && length > 0 {
+			return nil, size, io.EOF
+		}
+		if max := size - offset; length > max {
+			length = max
+		}
+		return data[offset : offset+length], size, nil
+	}
+
+	values := make(url.Values)
+	values.Set(storageRESTVolume, volume)
+	values.Set(storageRESTFilePath, path)
+	values.Set(storageRESTOffset, strconv.FormatInt(offset, 10))
+	values.Set(storageRESTLength, strconv.FormatInt(length, 10))
+	values.Set(storageRESTDiskID, client.diskID)
+	respBody, hdr, err := withStorageDeadline(ctx, storageRESTMethodReadAllAt, func(ctx context.Context) (io.ReadCloser, http.Header, error) {
+		return client.restClient.CallWithHeaders(ctx, storageRESTMethodReadAllAt, values, nil, -1)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer xhttp.DrainBody(respBody)
+
+	size, err = strconv.ParseInt(hdr.Get(storageRESTFileSizeHeader), 10, 64)
+	if err != nil {
+		return nil, 0, err
+	}
+	// The server elides the io.EOF for off-the-end reads, reconstruct
+	// it here to match a local read.
+	if offset >= size && length > 0 {
+		return nil, size, io.EOF
+	}
+	buf, err = io.ReadAll(io.LimitReader(respBody, length))
+	if err != nil {
+		return nil, size, err
+	}
+	return buf, size, nil
+}
+
+// maxStreamResumeAttempts is the number of times a dropped ReadFileStream
+// is transparently resumed from the current offset before giving up.
+const maxStreamResumeAttempts = 3
+
+// resumableFileStream resumes a dropped ReadFileStream from the current
+// offset, the server rejects resumption if the file generation changed
+// since the stream was started.
+type resumableFileStream struct {
+	ctx            context.Context
+	client         *storageRESTClient
+	volume, path   string
+	offset, length int64 // remaining read window
+	gen            string
+	rc             io.ReadCloser
+	attempts       int
+}
+
+func (f *resumableFileStream) Read(p []byte) (n int, err error) {
+	n, err = f.rc.Read(p)
+	f.offset += int64(n)
+	f.length -= int64(n)
+	if err != nil && err != io.EOF && f.length > 0 && f.attempts < maxStreamResumeAttempts {
+		f.attempts++
+		f.rc.Close()
+		rc, _, rerr := f.client.readFileStream(f.ctx, f.volume, f.path, f.offset, f.length, f.gen)
+		if rerr == nil {
+			f.rc = rc
+			if n > 0 {
+				return n, nil
+			}
+			return f.Read(p)
+		}
+	}
+	return n, err
+}
+
+func (f *resumableFileStream) Close() error {
+	return f.rc.Close()
+}
+
+// cancelReadCloser releases the context of the call that produced the
+// stream once the caller is done with it.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelReadCloser) Close() error {
+	c.cancel()
+	return c.ReadCloser.Close()
+}
+
+// budgetFirstByteReader enforces the read latency budget on the first
+// byte of the stream, response headers alone do not prove the remote
+// drive has started reading data.
+type budgetFirstByteReader struct {
+	rc       io.ReadCloser
+	deadline time.Time
+	started  bool
+}
+
+func (b *budgetFirstByteReader) Read(p []byte) (int, error) {
+	if b.started {
+		return b.rc.Read(p)
+	}
+	b.started = true
+	wait := time.Until(b.deadline)
+	if wait <= 0 {
+		b.rc.Close()
+		return 0, errDiskSlow
+	}
+	// Read into a private buffer so an abandoned read cannot write
+	// into p after this call has returned.
+	buf := make([]byte, len(p))
+	type result struct {
+		n   int
+		err error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		n, err := b.rc.Read(buf)
+		resCh <- result{n: n, err: err}
+	}()
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case res := <-resCh:
+		copy(p, buf[:res.n])
+		return res.n, res.err
+	case <-timer.C:
+		b.rc.Close()
+		return 0, errDiskSlow
+	}
+}
+
+func (b *budgetFirstByteReader) Close() error {
+	return b.rc.Close()
+}
+
+// readWithBudget runs fn, a read call against the remote drive, and
+// gives up with errDiskSlow if the stream has not started delivering
+// bytes within the client's read latency budget. The abandoned call is
+// canceled and reaped in the background. With no budget configured fn
+// runs unmodified.
+func (client *storageRESTClient) readWithBudget(ctx context.Context, fn func(context.Context) (io.ReadCloser, error)) (io.ReadCloser, error) {
+	budget := client.readLatencyBudget
+	if budget <= 0 {
+		return fn(ctx)
+	}
+	deadline := time.Now().Add(budget)
+	rctx, cancel := context.WithCancel(ctx)
+	type result struct {
+		rc  io.ReadCloser
+		err error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		rc, err := fn(rctx)
+		resCh <- result{rc: rc, err: err}
+	}()
+	timer := time.NewTimer(budget)
+	defer timer.Stop()
+	select {
+	case res := <-resCh:
+		if res.err != nil {
+			cancel()
+			return nil, res.err
+		}
+		return &budgetFirstByteReader{
+			rc:       &cancelReadCloser{ReadCloser: res.rc, cancel: cancel},
+			deadline: deadline,
+		}, nil
+	case <-timer.C:
+		cancel()
+		go func() {
+			if res := <-resCh; res.rc != nil {
+				res.rc.Close()
+			}
+		}()
+		return nil, errDiskSlow
+	}
+}
+
+func (client *storageRESTClient) readFileStream(ctx context.Context, volume, path string, offset, length int64, fileGen string) (io.ReadCloser, http.Header, error) {
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
 	values.Set(storageRESTOffset, strconv.Itoa(int(offset)))
 	values.Set(storageRESTLength, strconv.Itoa(int(length)))
-	respBody, err := client.call(ctx, storageRESTMethodReadFileStream, values, nil, -1)
+	if fileGen != "" {
+		values.Set(storageRESTFileGen, fileGen)
+	}
+	// Only ask for an inline hash when the remote advertised support,
+	// older servers would silently serve a stream with no trailing hash.
+	inlineHash := inlineHashFromContext(ctx) && client.Capabilities(ctx).Has(storageCapabilityInlineHash)
+	if inlineHash {
+		values.Set(storageRESTInlineHash, "true")
+	}
+	values.Set(storageRESTDiskID, client.diskID)
+	respBody, hdr, err := withStorageDeadline(ctx, storageRESTMethodReadFileStream, func(ctx context.Context) (io.ReadCloser, http.Header, error) {
+		return client.restClient.CallWithHeaders(ctx, storageRESTMethodReadFileStream, values, nil, -1)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if inlineHash {
+		// The server appended a trailing hash of the bytes it sent,
+		// verify the stream inline as it is read.
+		return &inlineHashVerifyCloser{
+			Reader: newInlineHashVerifier(respBody, length),
+			Closer: respBody,
+		}, hdr, nil
+	}
+	return respBody, hdr, nil
+}
+
+// ReadFileStream - returns a reader for the requested file.
+func (client *storageRESTClient) ReadFileStream(ctx context.Context, volume, path string, offset, length int64) (io.ReadCloser, error) {
+	var hdr http.Header
+	respBody, err := client.readWithBudget(ctx, func(ctx context.Context) (rc io.ReadCloser, err error) {
+		rc, hdr, err = client.readFileStream(ctx, volume, path, offset, length, "")
+		return rc, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return respBody, nil
+	if gen := hdr.Get(storageRESTFileGenHeader); gen != "" {
+		return xioutil.NewCancelReader(ctx, &resumableFileStream{
+			ctx:    ctx,
+			client: client,
+			volume: volume,
+			path:   path,
+			offset: offset,
+			length: length,
+			gen:    gen,
+			rc:     respBody,
+		}), nil
+	}
+	// Tie the stream to the caller's context so cancellation aborts a
+	// blocked read promptly and frees the connection.
+	return xioutil.NewCancelReader(ctx, respBody), nil
 }
 
 // ReadFile - reads section of a file.
@@ -588,7 +1279,9 @@ func (client *storageRESTClient) ReadFile(ctx context.Context, volume string, pa
 		values.Set(storageRESTBitrotAlgo, "")
 		values.Set(storageRESTBitrotHash, "")
 	}
-	respBody, err := client.call(ctx, storageRESTMethodReadFile, values, nil, -1)
+	respBody, err := client.readWithBudget(ctx, func(ctx context.Context) (io.ReadCloser, error) {
+		return client.call(ctx, storageRESTMethodReadFile, values, nil, -1)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -599,6 +1292,9 @@ func (client *storageRESTClient) ReadFile(ctx context.Context, volume string, pa
 
 // ListDir - lists a directory.
 func (client *storageRESTClient) ListDir(ctx context.Context, volume, dirPath string, count int) (entries []string, err error) {
+	if err := client.shedNonCritical(); err != nil {
+		return nil, err
+	}
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTDirPath, dirPath)
@@ -613,16 +1309,31 @@ func (client *storageRESTClient) ListDir(ctx context.Context, volume, dirPath st
 }
 
 // DeleteFile - deletes a file.
-func (client *storageRESTClient) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) error {
+func (client *storageRESTClient) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (stats DeleteStats, err error) {
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
 	values.Set(storageRESTRecursive, strconv.FormatBool(deleteOpts.Recursive))
 	values.Set(storageRESTForceDelete, strconv.FormatBool(deleteOpts.Force))
 
+	// Older servers respond with an empty body, only ask for stats when
+	// the server can deliver them.
+	reportStats := deleteOpts.ReportStats && client.Capabilities(ctx).Has(storageCapabilityDeleteStats)
+	if reportStats {
+		values.Set(storageRESTReportStats, strconv.FormatBool(true))
+	}
+
 	respBody, err := client.call(ctx, storageRESTMethodDeleteFile, values, nil, -1)
 	defer xhttp.DrainBody(respBody)
-	return err
+	if err != nil {
+		return DeleteStats{}, err
+	}
+	if reportStats {
+		if err = msgp.Decode(respBody, &stats); err != nil {
+			return DeleteStats{}, toStorageErr(err)
+		}
+	}
+	return stats, nil
 }
 
 // DeleteVersions - deletes list of specified versions if present
@@ -691,6 +1402,68 @@ func (client *storageRESTClient) RenameFile(ctx context.Context, srcVolume, srcP
 	return err
 }
 
+// RenameFiles - renames a list of files in one request, errors are
+// reported per pair.
+func (client *storageRESTClient) RenameFiles(ctx context.Context, pairs []RenameFilePair) (errs []error) {
+	if len(pairs) == 0 {
+		return errs
+	}
+
+	errs = make([]error, len(pairs))
+
+	if !client.Capabilities(ctx).Has(storageCapabilityBulkRename) {
+		// Older servers take one rename per request.
+		for i, pair := range pairs {
+			errs[i] = client.RenameFile(ctx, pair.SrcVolume, pair.SrcPath, pair.DstVolume, pair.DstPath)
+		}
+		return errs
+	}
+
+	values := make(url.Values)
+	values.Set(storageRESTCount, strconv.Itoa(len(pairs)))
+
+	var buffer bytes.Buffer
+	encoder := msgp.NewWriter(&buffer)
+	for _, pair := range pairs {
+		pair.EncodeMsg(encoder)
+	}
+	logger.LogIf(ctx, encoder.Flush())
+
+	respBody, err := client.call(ctx, storageRESTMethodRenameFiles, values, &buffer, -1)
+	defer xhttp.DrainBody(respBody)
+	if err != nil {
+		if contextCanceled(ctx) {
+			err = ctx.Err()
+		}
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	reader, err := waitForHTTPResponse(respBody)
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	rErrResp := &RenameFilesErrsResp{}
+	if err = gob.NewDecoder(reader).Decode(rErrResp); err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	for i, rErr := range rErrResp.Errs {
+		errs[i] = toStorageErr(rErr)
+	}
+
+	return errs
+}
+
 func (client *storageRESTClient) VerifyFile(ctx context.Context, volume, path string, fi FileInfo) error {
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
@@ -738,6 +1511,9 @@ func (client *storageRESTClient) StatInfoFile(ctx context.Context, volume, path
 	defer readMsgpReaderPool.Put(rd)
 	for {
 		var st StatInfo
+		// StatInfo is msgp map encoded, the version detail fields newer
+		// servers send decode as zero values from older servers that
+		// still return the shorter struct.
 		err = st.DecodeMsg(rd)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
@@ -793,20 +1569,22 @@ func (client *storageRESTClient) ReadMultiple(ctx context.Context, req ReadMulti
 
 // CleanAbandonedData will read metadata of the object on disk
 // and delete any data directories and inline data that isn't referenced in metadata.
-func (client *storageRESTClient) CleanAbandonedData(ctx context.Context, volume string, path string) error {
+// Returns a summary of how many data directories and bytes were reclaimed.
+func (client *storageRESTClient) CleanAbandonedData(ctx context.Context, volume string, path string) (res CleanAbandonedDataResp, err error) {
 	values := make(url.Values)
 	values.Set(storageRESTVolume, volume)
 	values.Set(storageRESTFilePath, path)
 	respBody, err := client.call(ctx, storageRESTMethodCleanAbandoned, values, nil, -1)
 	if err != nil {
-		return err
+		return res, err
 	}
 	defer xhttp.DrainBody(respBody)
 	respReader, err := waitForHTTPResponse(respBody)
-	if err == nil {
-		io.Copy(io.Discard, respReader)
+	if err != nil {
+		return res, err
 	}
-	return err
+	err = msgp.Decode(respReader, &res)
+	return res, err
 }
 
 // Close - marks the client as closed.
@@ -824,6 +1602,26 @@ func newStorageRESTClient(endpoint Endpoint, healthcheck bool) *storageRESTClien
 	}
 
 	restClient := rest.NewClient(serverURL, globalInternodeTransport, newCachedAuthToken())
+	globalStorageRESTClients.Store(endpoint.Host, restClient)
+
+	client := &storageRESTClient{
+		endpoint:          endpoint,
+		restClient:        restClient,
+		poolIndex:         -1,
+		setIndex:          -1,
+		diskIndex:         -1,
+		readLatencyBudget: storageReadLatencyBudget,
+	}
+
+	// Track the handler queue depth advertised by the remote endpoint
+	// on every response, used to shed non-critical load.
+	restClient.RespHeaderFn = func(h http.Header) {
+		if v := h.Get(storageRESTQueueDepth); v != "" {
+			if depth, err := strconv.ParseInt(v, 10, 64); err == nil {
+				atomic.StoreInt64(&client.queueDepth, depth)
+			}
+		}
+	}
 
 	if healthcheck {
 		// Use a separate client to avoid recursive calls.
@@ -835,9 +1633,16 @@ func newStorageRESTClient(endpoint Endpoint, healthcheck bool) *storageRESTClien
 			defer cancel()
 			respBody, err := healthClient.Call(ctx, storageRESTMethodHealth, nil, nil, -1)
 			xhttp.DrainBody(respBody)
-			return toStorageErr(err) != errDiskNotFound
+			err = toStorageErr(err)
+			// TLS handshake failures stay distinct from errDiskNotFound
+			// but still mean the peer is unreachable.
+			var terr *rest.TLSHandshakeError
+			if errors.As(err, &terr) {
+				return false
+			}
+			return err != errDiskNotFound
 		}
 	}
 
-	return &storageRESTClient{endpoint: endpoint, restClient: restClient, poolIndex: -1, setIndex: -1, diskIndex: -1}
+	return client
 }
@@ -18,43 +18,54 @@
 package cmd
 
 const (
-	storageRESTVersion       = "v49" // Added RenameData() to return versions
+	storageRESTVersion       = "v53" // CheckParts responds with a per-part report
 	storageRESTVersionPrefix = SlashSeparator + storageRESTVersion
 	storageRESTPrefix        = minioReservedBucketPath + "/storage"
 )
 
+// storageRESTQueueDepth is the response header carrying the current
+// storage REST handler queue depth of the responding node, clients use
+// it to shed non-critical load when the node is busy.
+const storageRESTQueueDepth = "x-minio-storage-queue-depth"
+
 const (
-	storageRESTMethodHealth      = "/health"
-	storageRESTMethodDiskInfo    = "/diskinfo"
-	storageRESTMethodNSScanner   = "/nsscanner"
-	storageRESTMethodMakeVol     = "/makevol"
-	storageRESTMethodMakeVolBulk = "/makevolbulk"
-	storageRESTMethodStatVol     = "/statvol"
-	storageRESTMethodDeleteVol   = "/deletevol"
-	storageRESTMethodListVols    = "/listvols"
-
-	storageRESTMethodAppendFile     = "/appendfile"
-	storageRESTMethodCreateFile     = "/createfile"
-	storageRESTMethodWriteAll       = "/writeall"
-	storageRESTMethodWriteMetadata  = "/writemetadata"
-	storageRESTMethodUpdateMetadata = "/updatemetadata"
-	storageRESTMethodDeleteVersion  = "/deleteversion"
-	storageRESTMethodReadVersion    = "/readversion"
-	storageRESTMethodReadXL         = "/readxl"
-	storageRESTMethodRenameData     = "/renamedata"
-	storageRESTMethodCheckParts     = "/checkparts"
-	storageRESTMethodReadAll        = "/readall"
-	storageRESTMethodReadFile       = "/readfile"
-	storageRESTMethodReadFileStream = "/readfilestream"
-	storageRESTMethodListDir        = "/listdir"
-	storageRESTMethodDeleteFile     = "/deletefile"
-	storageRESTMethodDeleteVersions = "/deleteverions"
-	storageRESTMethodRenameFile     = "/renamefile"
-	storageRESTMethodVerifyFile     = "/verifyfile"
-	storageRESTMethodWalkDir        = "/walkdir"
-	storageRESTMethodStatInfoFile   = "/statfile"
-	storageRESTMethodReadMultiple   = "/readmultiple"
-	storageRESTMethodCleanAbandoned = "/cleanabandoned"
+	storageRESTMethodHealth       = "/health"
+	storageRESTMethodCapabilities = "/capabilities"
+	storageRESTMethodDiskInfo     = "/diskinfo"
+	storageRESTMethodNSScanner    = "/nsscanner"
+	storageRESTMethodMakeVol      = "/makevol"
+	storageRESTMethodMakeVolBulk  = "/makevolbulk"
+	storageRESTMethodStatVol      = "/statvol"
+	storageRESTMethodDeleteVol    = "/deletevol"
+	storageRESTMethodListVols     = "/listvols"
+
+	storageRESTMethodAppendFile      = "/appendfile"
+	storageRESTMethodCreateFile      = "/createfile"
+	storageRESTMethodWriteAll        = "/writeall"
+	storageRESTMethodWriteMetadata   = "/writemetadata"
+	storageRESTMethodWriteMetadataIf = "/writemetadataif"
+	storageRESTMethodUpdateMetadata  = "/updatemetadata"
+	storageRESTMethodPatchMetadata   = "/patchmetadata"
+	storageRESTMethodDeleteVersion   = "/deleteversion"
+	storageRESTMethodReadVersion     = "/readversion"
+	storageRESTMethodCheckVersion    = "/checkversion"
+	storageRESTMethodReadXL          = "/readxl"
+	storageRESTMethodRenameData      = "/renamedata"
+	storageRESTMethodCheckParts      = "/checkparts"
+	storageRESTMethodReadAll         = "/readall"
+	storageRESTMethodReadAllAt       = "/readallat"
+	storageRESTMethodReadFile        = "/readfile"
+	storageRESTMethodReadFileStream  = "/readfilestream"
+	storageRESTMethodListDir         = "/listdir"
+	storageRESTMethodDeleteFile      = "/deletefile"
+	storageRESTMethodDeleteVersions  = "/deleteverions"
+	storageRESTMethodRenameFile      = "/renamefile"
+	storageRESTMethodRenameFiles     = "/renamefiles"
+	storageRESTMethodVerifyFile      = "/verifyfile"
+	storageRESTMethodWalkDir         = "/walkdir"
+	storageRESTMethodStatInfoFile    = "/statfile"
+	storageRESTMethodReadMultiple    = "/readmultiple"
+	storageRESTMethodCleanAbandoned  = "/cleanabandoned"
 )
 
 const (
@@ -83,4 +94,111 @@ const (
 	storageRESTForceDelete    = "force-delete"
 	storageRESTGlob           = "glob"
 	storageRESTScanMode       = "scan-mode"
+	storageRESTFileGen        = "file-gen"
+	storageRESTInlineHash     = "inline-hash"
+	storageRESTReportStats    = "report-stats"
+	storageRESTTimings        = "timings"
+	storageRESTExpectedSig    = "expected-sig"
+)
+
+// NSScanner stream message kinds. Each streamed message is framed by a
+// true bool followed by a kind byte, a false bool indicates the final
+// cache follows.
+const (
+	nsScannerMsgUpdate uint8 = iota + 1
+	nsScannerMsgError
+)
+
+// storageRESTFileGenHeader is the response header carrying an opaque
+// token identifying the generation of the file served by ReadFileStream,
+// clients present it when resuming a dropped stream from an offset so
+// the server can reject resumption if the file changed in between.
+const storageRESTFileGenHeader = "x-minio-storage-file-gen"
+
+// storageRESTFileSizeHeader is the response header carrying the total
+// file size on ReadAllAt responses, the client uses it to reconstruct
+// io.EOF for reads starting at or beyond the end of the file.
+const storageRESTFileSizeHeader = "x-minio-storage-file-size"
+
+// Protocol extensions advertised through the capabilities handshake.
+const (
+	// ReadFileStream responses carry a file generation header and
+	// dropped streams can be resumed from an offset.
+	storageCapabilityResumeReads = "resume-reads"
+	// ReadFileStream can append a trailing content hash for inline
+	// verification when asked via the inline-hash query parameter.
+	storageCapabilityInlineHash = "inline-read-hash"
+	// Responses carry the handler queue depth header used for load
+	// shedding.
+	storageCapabilityQueueDepth = "queue-depth"
+	// WriteMetadata/UpdateMetadata accept s2 compressed msgp payloads
+	// declared via the storage encoding request header.
+	storageCapabilityCompressedMetadata = "compressed-metadata"
+	// PatchMetadata applies metadata-only updates without a full
+	// FileInfo on the wire.
+	storageCapabilityMetadataPatch = "metadata-patch"
+	// RenameFiles commits a list of renames in one request with
+	// per-pair errors.
+	storageCapabilityBulkRename = "bulk-rename"
+	// DeleteFile responds with DeleteStats when asked via the
+	// report-stats query parameter.
+	storageCapabilityDeleteStats = "delete-stats"
+	// ReadAllAt serves bounded ranged reads, responses carry the
+	// total file size in a header.
+	storageCapabilityReadAllAt = "read-all-at"
+	// WriteMetadataIf writes metadata only when the on-disk contents
+	// still match the signature presented by the caller.
+	storageCapabilityWriteMetadataIf = "write-metadata-if"
 )
+
+// storageRESTEncodingHeader is the request header declaring how the
+// msgp payload in the request body is encoded on the wire, absent
+// means plain msgp.
+const storageRESTEncodingHeader = "x-minio-storage-encoding"
+
+// Supported wire encodings of msgp payloads.
+const storageRESTEncodingS2 = "s2"
+
+// storageCapabilities describes what a storage REST server supports
+// beyond the base protocol version. Clients fetch it once per
+// connection so newer call sites can pick between new and legacy wire
+// formats up front instead of discovering errRPCAPIVersionUnsupported
+// mid-operation.
+type storageCapabilities struct {
+	APIVersion string   `json:"apiVersion"`
+	Extensions []string `json:"extensions,omitempty"`
+
+	// MaxReadAll is the largest ReadAll response the server expects
+	// clients to accept, in bytes.
+	MaxReadAll int64 `json:"maxReadAll,omitempty"`
+}
+
+// localStorageCapabilities returns the capabilities advertised by this
+// server build.
+func localStorageCapabilities() storageCapabilities {
+	return storageCapabilities{
+		APIVersion: storageRESTVersion,
+		Extensions: []string{
+			storageCapabilityResumeReads,
+			storageCapabilityInlineHash,
+			storageCapabilityQueueDepth,
+			storageCapabilityCompressedMetadata,
+			storageCapabilityMetadataPatch,
+			storageCapabilityBulkRename,
+			storageCapabilityDeleteStats,
+			storageCapabilityReadAllAt,
+			storageCapabilityWriteMetadataIf,
+		},
+		MaxReadAll: readAllDefaultLimit,
+	}
+}
+
+// Has returns true if the given protocol extension was advertised.
+func (c storageCapabilities) Has(ext string) bool {
+	for _, e := range c.Extensions {
+		if e == ext {
+			return true
+		}
+	}
+	return false
+}
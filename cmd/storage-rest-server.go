@@ -23,6 +23,7 @@ import (
 	"encoding/binary"
 	"encoding/gob"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -33,11 +34,13 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/tinylib/msgp/msgp"
 
 	jwtreq "github.com/golang-jwt/jwt/v4/request"
+	"github.com/klauspost/compress/s2"
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio/internal/config"
 	xhttp "github.com/minio/minio/internal/http"
@@ -50,6 +53,22 @@ import (
 
 var errDiskStale = errors.New("drive stale")
 
+// storageRESTInflightRequests tracks in-flight storage REST calls on this
+// node, the current depth is advertised to callers on every response so
+// that they can shed non-critical load when this node is busy.
+var storageRESTInflightRequests int64
+
+// queueDepthMiddleware accounts the in-flight storage REST calls and
+// advertises the current queue depth in a response header.
+func queueDepthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		depth := atomic.AddInt64(&storageRESTInflightRequests, 1)
+		defer atomic.AddInt64(&storageRESTInflightRequests, -1)
+		w.Header().Set(storageRESTQueueDepth, strconv.FormatInt(depth, 10))
+		next.ServeHTTP(w, r)
+	})
+}
+
 // To abstract a disk over network.
 type storageRESTServer struct {
 	storage *xlStorageDiskIDCheck
@@ -162,6 +181,21 @@ func (s *storageRESTServer) HealthHandler(w http.ResponseWriter, r *http.Request
 	s.IsValid(w, r)
 }
 
+// CapabilitiesHandler - advertises the protocol capabilities of this
+// server so clients can pick wire formats up front, see
+// storageCapabilities.
+func (s *storageRESTServer) CapabilitiesHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsAuthValid(w, r) {
+		return
+	}
+	buf, err := json.Marshal(localStorageCapabilities())
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+	w.Write(buf)
+}
+
 // DiskInfoHandler - returns disk info.
 func (s *storageRESTServer) DiskInfoHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsAuthValid(w, r) {
@@ -171,6 +205,7 @@ func (s *storageRESTServer) DiskInfoHandler(w http.ResponseWriter, r *http.Reque
 	if err != nil {
 		info.Error = err.Error()
 	}
+	info.Capabilities = localStorageCapabilities().Extensions
 	logger.LogIf(r.Context(), msgp.Encode(w, &info))
 }
 
@@ -207,17 +242,39 @@ func (s *storageRESTServer) NSScannerHandler(w http.ResponseWriter, r *http.Requ
 	}()
 	respW := msgp.NewWriter(resp)
 
-	// Collect updates, stream them before the full cache is sent.
+	// Collect updates and scan errors, stream them before the full cache
+	// is sent. Every streamed message is framed by a true bool followed by
+	// a kind byte, a false bool indicates the final cache follows.
 	updates := make(chan dataUsageEntry, 1)
+	scanErrs := make(chan nsScannerError, 1)
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		for update := range updates {
-			// Write true bool to indicate update.
+		upd, errs := updates, scanErrs
+		for upd != nil || errs != nil {
 			var err error
-			if err = respW.WriteBool(true); err == nil {
-				err = update.EncodeMsg(respW)
+			select {
+			case update, ok := <-upd:
+				if !ok {
+					upd = nil
+					continue
+				}
+				if err = respW.WriteBool(true); err == nil {
+					if err = respW.WriteUint8(nsScannerMsgUpdate); err == nil {
+						err = update.EncodeMsg(respW)
+					}
+				}
+			case scanErr, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				if err = respW.WriteBool(true); err == nil {
+					if err = respW.WriteUint8(nsScannerMsgError); err == nil {
+						err = scanErr.EncodeMsg(respW)
+					}
+				}
 			}
 			respW.Flush()
 			if err != nil {
@@ -227,7 +284,7 @@ func (s *storageRESTServer) NSScannerHandler(w http.ResponseWriter, r *http.Requ
 			}
 		}
 	}()
-	usageInfo, err := s.storage.NSScanner(ctx, cache, updates, madmin.HealScanMode(scanMode))
+	usageInfo, err := s.storage.NSScanner(ctx, cache, updates, madmin.HealScanMode(scanMode), scanErrs)
 	if err != nil {
 		respW.Flush()
 		resp.CloseWithError(err)
@@ -346,7 +403,14 @@ func (s *storageRESTServer) CreateFileHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	done, body := keepHTTPReqResponseAlive(w, r)
-	done(s.storage.CreateFile(r.Context(), volume, filePath, int64(fileSize), body))
+	var reader io.Reader = body
+	if r.Form.Get(storageRESTInlineHash) == "true" {
+		// The sender appended a trailing hash of the payload, verify it
+		// as the file is written so a corrupted shard transfer fails the
+		// call immediately instead of at read/heal time.
+		reader = newInlineHashVerifier(body, int64(fileSize))
+	}
+	done(s.storage.CreateFile(r.Context(), volume, filePath, int64(fileSize), reader))
 }
 
 // DeleteVersion delete updated metadata.
@@ -402,6 +466,40 @@ func (s *storageRESTServer) ReadVersionHandler(w http.ResponseWriter, r *http.Re
 	logger.LogIf(r.Context(), msgp.Encode(w, &fi))
 }
 
+// CheckVersionHandler is an existence-only probe of a version, it responds
+// with no body - the error alone tells the caller whether the version is
+// there.
+func (s *storageRESTServer) CheckVersionHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		return
+	}
+	volume := r.Form.Get(storageRESTVolume)
+	filePath := r.Form.Get(storageRESTFilePath)
+	versionID := r.Form.Get(storageRESTVersionID)
+
+	if _, err := s.storage.ReadVersion(r.Context(), volume, filePath, versionID, false); err != nil {
+		if err == errFileNotFound {
+			// Existence-only callers only care that the version is not
+			// there, regardless of whether the whole path is missing.
+			err = errFileVersionNotFound
+		}
+		s.writeErrorResponse(w, err)
+	}
+}
+
+// msgpBody returns the request body with any wire encoding declared
+// via the storage encoding header undone.
+func msgpBody(r *http.Request) (io.Reader, error) {
+	switch enc := r.Header.Get(storageRESTEncodingHeader); enc {
+	case "":
+		return r.Body, nil
+	case storageRESTEncodingS2:
+		return s2.NewReader(r.Body), nil
+	default:
+		return nil, fmt.Errorf("unknown storage encoding %q", enc)
+	}
+}
+
 // WriteMetadata write new updated metadata.
 func (s *storageRESTServer) WriteMetadataHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -415,15 +513,57 @@ func (s *storageRESTServer) WriteMetadataHandler(w http.ResponseWriter, r *http.
 		return
 	}
 
+	body, err := msgpBody(r)
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
 	var fi FileInfo
-	if err := msgp.Decode(r.Body, &fi); err != nil {
+	if err := msgp.Decode(body, &fi); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	if err = s.storage.WriteMetadata(r.Context(), volume, filePath, fi); err != nil {
 		s.writeErrorResponse(w, err)
+	}
+}
+
+// WriteMetadataIfHandler writes new metadata only when the on-disk contents
+// still match the signature presented by the caller.
+func (s *storageRESTServer) WriteMetadataIfHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		return
+	}
+	volume := r.Form.Get(storageRESTVolume)
+	filePath := r.Form.Get(storageRESTFilePath)
+
+	expectedSig, err := strconv.ParseUint(r.Form.Get(storageRESTExpectedSig), 10, 64)
+	if err != nil {
+		s.writeErrorResponse(w, errInvalidArgument)
+		return
+	}
+
+	if r.ContentLength < 0 {
+		s.writeErrorResponse(w, errInvalidArgument)
 		return
 	}
 
-	err := s.storage.WriteMetadata(r.Context(), volume, filePath, fi)
+	body, err := msgpBody(r)
 	if err != nil {
 		s.writeErrorResponse(w, err)
+		return
+	}
+
+	var fi FileInfo
+	if err := msgp.Decode(body, &fi); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	if err = s.storage.WriteMetadataIf(r.Context(), volume, filePath, fi, expectedSig); err != nil {
+		s.writeErrorResponse(w, err)
 	}
 }
 
@@ -440,14 +580,43 @@ func (s *storageRESTServer) UpdateMetadataHandler(w http.ResponseWriter, r *http
 		return
 	}
 
+	body, err := msgpBody(r)
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
 	var fi FileInfo
-	if err := msgp.Decode(r.Body, &fi); err != nil {
+	if err := msgp.Decode(body, &fi); err != nil {
 		s.writeErrorResponse(w, err)
 		return
 	}
 
-	err := s.storage.UpdateMetadata(r.Context(), volume, filePath, fi)
-	if err != nil {
+	if err = s.storage.UpdateMetadata(r.Context(), volume, filePath, fi); err != nil {
+		s.writeErrorResponse(w, err)
+	}
+}
+
+// PatchMetadataHandler applies a metadata-only patch to a version.
+func (s *storageRESTServer) PatchMetadataHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		return
+	}
+	volume := r.Form.Get(storageRESTVolume)
+	filePath := r.Form.Get(storageRESTFilePath)
+
+	if r.ContentLength < 0 {
+		s.writeErrorResponse(w, errInvalidArgument)
+		return
+	}
+
+	var patch MetadataPatch
+	if err := msgp.Decode(r.Body, &patch); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	if err := s.storage.PatchMetadata(r.Context(), volume, filePath, patch); err != nil {
 		s.writeErrorResponse(w, err)
 	}
 }
@@ -476,7 +645,9 @@ func (s *storageRESTServer) WriteAllHandler(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// CheckPartsHandler - check if a file metadata exists.
+// CheckPartsHandler - check if the object parts exist, responds with a
+// per-part status report. Part level problems live in the report only,
+// the client rebuilds the pass/fail error from it.
 func (s *storageRESTServer) CheckPartsHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
 		return
@@ -495,9 +666,12 @@ func (s *storageRESTServer) CheckPartsHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := s.storage.CheckParts(r.Context(), volume, filePath, fi); err != nil {
+	resp, err := s.storage.CheckParts(r.Context(), volume, filePath, fi)
+	if resp == nil {
 		s.writeErrorResponse(w, err)
+		return
 	}
+	logger.LogIf(r.Context(), msgp.Encode(w, resp))
 }
 
 // ReadAllHandler - read all the contents of a file.
@@ -519,6 +693,36 @@ func (s *storageRESTServer) ReadAllHandler(w http.ResponseWriter, r *http.Reques
 	w.Write(buf)
 }
 
+// ReadAllAtHandler - read a bounded range of a file, the total file
+// size travels in a response header. An io.EOF from the storage layer
+// is elided, the client reconstructs it from the size header.
+func (s *storageRESTServer) ReadAllAtHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		return
+	}
+	volume := r.Form.Get(storageRESTVolume)
+	filePath := r.Form.Get(storageRESTFilePath)
+	offset, err := strconv.ParseInt(r.Form.Get(storageRESTOffset), 10, 64)
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+	length, err := strconv.ParseInt(r.Form.Get(storageRESTLength), 10, 64)
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	buf, size, err := s.storage.ReadAllAt(r.Context(), volume, filePath, offset, length)
+	if err != nil && err != io.EOF {
+		s.writeErrorResponse(w, err)
+		return
+	}
+	w.Header().Set(storageRESTFileSizeHeader, strconv.FormatInt(size, 10))
+	w.Header().Set(xhttp.ContentLength, strconv.Itoa(len(buf)))
+	w.Write(buf)
+}
+
 // ReadXLHandler - read xl.meta for an object at path.
 func (s *storageRESTServer) ReadXLHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -602,6 +806,18 @@ func (s *storageRESTServer) ReadFileStreamHandler(w http.ResponseWriter, r *http
 		return
 	}
 
+	// Advertise the file generation so clients can resume a dropped
+	// stream from an offset, a resuming client presents the token it
+	// received and is rejected if the file changed in the meantime.
+	var fileGen string
+	if stats, serr := s.storage.StatInfoFile(r.Context(), volume, filePath, false); serr == nil && len(stats) > 0 {
+		fileGen = fmt.Sprintf("%x-%x", stats[0].ModTime.UnixNano(), stats[0].Size)
+	}
+	if reqGen := r.Form.Get(storageRESTFileGen); reqGen != "" && reqGen != fileGen {
+		s.writeErrorResponse(w, errFileGenerationMismatch)
+		return
+	}
+
 	rc, err := s.storage.ReadFileStream(r.Context(), volume, filePath, int64(offset), int64(length))
 	if err != nil {
 		s.writeErrorResponse(w, err)
@@ -609,8 +825,20 @@ func (s *storageRESTServer) ReadFileStreamHandler(w http.ResponseWriter, r *http
 	}
 	defer rc.Close()
 
-	w.Header().Set(xhttp.ContentLength, strconv.Itoa(length))
-	if _, err = xioutil.Copy(w, rc); err != nil {
+	if fileGen != "" {
+		w.Header().Set(storageRESTFileGenHeader, fileGen)
+	}
+
+	var body io.Reader = rc
+	respLength := length
+	if r.Form.Get(storageRESTInlineHash) == "true" {
+		// The client asked for a trailing hash of the bytes actually
+		// sent so it can verify the stream inline.
+		body = newInlineHashAppender(body, int64(length))
+		respLength += inlineHashSize
+	}
+	w.Header().Set(xhttp.ContentLength, strconv.Itoa(respLength))
+	if _, err = xioutil.Copy(w, body); err != nil {
 		if !xnet.IsNetworkOrHostDown(err, true) { // do not need to log disconnected clients
 			logger.LogIf(r.Context(), err)
 		}
@@ -656,12 +884,24 @@ func (s *storageRESTServer) DeleteFileHandler(w http.ResponseWriter, r *http.Req
 		s.writeErrorResponse(w, err)
 		return
 	}
-	err = s.storage.Delete(r.Context(), volume, filePath, DeleteOptions{
-		Recursive: recursive,
-		Force:     force,
+	var reportStats bool
+	if v := r.Form.Get(storageRESTReportStats); v != "" {
+		if reportStats, err = strconv.ParseBool(v); err != nil {
+			s.writeErrorResponse(w, err)
+			return
+		}
+	}
+	stats, err := s.storage.Delete(r.Context(), volume, filePath, DeleteOptions{
+		Recursive:   recursive,
+		Force:       force,
+		ReportStats: reportStats,
 	})
 	if err != nil {
 		s.writeErrorResponse(w, err)
+		return
+	}
+	if reportStats {
+		logger.LogIf(r.Context(), msgp.Encode(w, &stats))
 	}
 }
 
@@ -713,6 +953,13 @@ func (s *storageRESTServer) DeleteVersionsHandler(w http.ResponseWriter, r *http
 type RenameDataResp struct {
 	Signature uint64
 	Err       error
+
+	// Optional server-side timing breakdown of the call, only
+	// populated when the request carries the timings query parameter.
+	// Older peers simply leave the fields at zero, gob skips fields
+	// unknown to either side.
+	DecodeDuration time.Duration
+	RenameDuration time.Duration
 }
 
 // RenameDataHandler - renames a meta object and data dir to destination.
@@ -726,27 +973,43 @@ func (s *storageRESTServer) RenameDataHandler(w http.ResponseWriter, r *http.Req
 	dstVolume := r.Form.Get(storageRESTDstVolume)
 	dstFilePath := r.Form.Get(storageRESTDstPath)
 
+	var timings bool
+	if v := r.Form.Get(storageRESTTimings); v != "" {
+		var err error
+		if timings, err = strconv.ParseBool(v); err != nil {
+			s.writeErrorResponse(w, err)
+			return
+		}
+	}
+
 	if r.ContentLength < 0 {
 		s.writeErrorResponse(w, errInvalidArgument)
 		return
 	}
 
+	start := time.Now()
 	var fi FileInfo
 	if err := msgp.Decode(r.Body, &fi); err != nil {
 		s.writeErrorResponse(w, err)
 		return
 	}
+	decoded := time.Now()
 
 	setEventStreamHeaders(w)
 	encoder := gob.NewEncoder(w)
 	done := keepHTTPResponseAlive(w)
 
 	sign, err := s.storage.RenameData(r.Context(), srcVolume, srcFilePath, fi, dstVolume, dstFilePath)
+	renamed := time.Now()
 	done(nil)
 
 	resp := &RenameDataResp{
 		Signature: sign,
 	}
+	if timings {
+		resp.DecodeDuration = decoded.Sub(start)
+		resp.RenameDuration = renamed.Sub(decoded)
+	}
 	if err != nil {
 		resp.Err = StorageErr(err.Error())
 	}
@@ -768,6 +1031,49 @@ func (s *storageRESTServer) RenameFileHandler(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// RenameFilesErrsResp - collection of rename errors
+// for bulk renames
+type RenameFilesErrsResp struct {
+	Errs []error
+}
+
+// RenameFilesHandler - rename a list of files in one shot.
+func (s *storageRESTServer) RenameFilesHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		return
+	}
+
+	totalPairs, err := strconv.Atoi(r.Form.Get(storageRESTCount))
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	pairs := make([]RenameFilePair, totalPairs)
+	decoder := msgp.NewReader(r.Body)
+	for i := 0; i < totalPairs; i++ {
+		dst := &pairs[i]
+		if err := dst.DecodeMsg(decoder); err != nil {
+			s.writeErrorResponse(w, err)
+			return
+		}
+	}
+
+	rErrsResp := &RenameFilesErrsResp{Errs: make([]error, totalPairs)}
+
+	setEventStreamHeaders(w)
+	encoder := gob.NewEncoder(w)
+	done := keepHTTPResponseAlive(w)
+	errs := s.storage.RenameFiles(r.Context(), pairs)
+	done(nil)
+	for idx := range pairs {
+		if errs[idx] != nil {
+			rErrsResp.Errs[idx] = StorageErr(errs[idx].Error())
+		}
+	}
+	encoder.Encode(rErrsResp)
+}
+
 // CleanAbandonedDataHandler - Clean unused data directories.
 func (s *storageRESTServer) CleanAbandonedDataHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -778,7 +1084,13 @@ func (s *storageRESTServer) CleanAbandonedDataHandler(w http.ResponseWriter, r *
 	if volume == "" || filePath == "" {
 		return // Ignore
 	}
-	keepHTTPResponseAlive(w)(s.storage.CleanAbandonedData(r.Context(), volume, filePath))
+	done := keepHTTPResponseAlive(w)
+	res, err := s.storage.CleanAbandonedData(r.Context(), volume, filePath)
+	done(err)
+	if err != nil {
+		return
+	}
+	logger.LogIf(r.Context(), msgp.Encode(w, &res))
 }
 
 // closeNotifier is itself a ReadCloser that will notify when either an error occurs or
@@ -1360,8 +1672,10 @@ func registerStorageRESTHandlers(router *mux.Router, endpointServerPools Endpoin
 			server.storage.SetDiskID(storage.diskID)
 
 			subrouter := router.PathPrefix(path.Join(storageRESTPrefix, endpoint.Path)).Subrouter()
+			subrouter.Use(queueDepthMiddleware)
 
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodHealth).HandlerFunc(httpTraceHdrs(server.HealthHandler))
+			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodCapabilities).HandlerFunc(httpTraceHdrs(server.CapabilitiesHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodDiskInfo).HandlerFunc(httpTraceHdrs(server.DiskInfoHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodNSScanner).HandlerFunc(httpTraceHdrs(server.NSScannerHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodMakeVol).HandlerFunc(httpTraceHdrs(server.MakeVolHandler))
@@ -1373,14 +1687,18 @@ func registerStorageRESTHandlers(router *mux.Router, endpointServerPools Endpoin
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodAppendFile).HandlerFunc(httpTraceHdrs(server.AppendFileHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodWriteAll).HandlerFunc(httpTraceHdrs(server.WriteAllHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodWriteMetadata).HandlerFunc(httpTraceHdrs(server.WriteMetadataHandler))
+			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodWriteMetadataIf).HandlerFunc(httpTraceHdrs(server.WriteMetadataIfHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodUpdateMetadata).HandlerFunc(httpTraceHdrs(server.UpdateMetadataHandler))
+			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodPatchMetadata).HandlerFunc(httpTraceHdrs(server.PatchMetadataHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodDeleteVersion).HandlerFunc(httpTraceHdrs(server.DeleteVersionHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodReadVersion).HandlerFunc(httpTraceHdrs(server.ReadVersionHandler))
+			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodCheckVersion).HandlerFunc(httpTraceHdrs(server.CheckVersionHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodReadXL).HandlerFunc(httpTraceHdrs(server.ReadXLHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodRenameData).HandlerFunc(httpTraceHdrs(server.RenameDataHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodCreateFile).HandlerFunc(httpTraceHdrs(server.CreateFileHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodCheckParts).HandlerFunc(httpTraceHdrs(server.CheckPartsHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodReadAll).HandlerFunc(httpTraceHdrs(server.ReadAllHandler))
+			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodReadAllAt).HandlerFunc(httpTraceHdrs(server.ReadAllAtHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodReadFile).HandlerFunc(httpTraceHdrs(server.ReadFileHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodReadFileStream).HandlerFunc(httpTraceHdrs(server.ReadFileStreamHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodListDir).HandlerFunc(httpTraceHdrs(server.ListDirHandler))
@@ -1389,6 +1707,7 @@ func registerStorageRESTHandlers(router *mux.Router, endpointServerPools Endpoin
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodDeleteFile).HandlerFunc(httpTraceHdrs(server.DeleteFileHandler))
 
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodRenameFile).HandlerFunc(httpTraceHdrs(server.RenameFileHandler))
+			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodRenameFiles).HandlerFunc(httpTraceHdrs(server.RenameFilesHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodVerifyFile).HandlerFunc(httpTraceHdrs(server.VerifyFileHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodWalkDir).HandlerFunc(httpTraceHdrs(server.WalkDirHandler))
 			subrouter.Methods(http.MethodPost).Path(storageRESTVersionPrefix + storageRESTMethodStatInfoFile).HandlerFunc(httpTraceHdrs(server.StatInfoFile))
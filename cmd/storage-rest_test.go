@@ -20,13 +20,19 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http/httptest"
 	"reflect"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/minio/mux"
 	xnet "github.com/minio/pkg/net"
+	"github.com/tinylib/msgp/msgp"
 )
 
 // Storage REST server, storageRESTReceiver and StorageRESTClient are
@@ -264,6 +270,52 @@ func testStorageAPIReadAll(t *testing.T, storage StorageAPI) {
 	}
 }
 
+func testStorageAPIReadAllAt(t *testing.T, storage StorageAPI) {
+	err := storage.MakeVol(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	err = storage.AppendFile(context.Background(), "foo", "myobject", []byte("foobar"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	testCases := []struct {
+		volumeName     string
+		objectName     string
+		offset         int64
+		length         int64
+		expectedResult []byte
+		expectedErr    error
+	}{
+		{"foo", "myobject", 0, 6, []byte("foobar"), nil},
+		// reads are clamped to the end of the file.
+		{"foo", "myobject", 3, 100, []byte("bar"), nil},
+		{"foo", "myobject", 0, 3, []byte("foo"), nil},
+		// off-the-end offsets return io.EOF like a local read.
+		{"foo", "myobject", 6, 1, nil, io.EOF},
+		{"foo", "myobject", 100, 1, nil, io.EOF},
+		// file not found error.
+		{"foo", "yourobject", 0, 1, nil, errFileNotFound},
+	}
+
+	for i, testCase := range testCases {
+		result, size, err := storage.ReadAllAt(context.Background(), testCase.volumeName, testCase.objectName, testCase.offset, testCase.length)
+		if !errors.Is(err, testCase.expectedErr) {
+			t.Fatalf("case %v: error: expected: %v, got: %v", i+1, testCase.expectedErr, err)
+		}
+		if err != nil && err != io.EOF {
+			continue
+		}
+		if size != 6 {
+			t.Fatalf("case %v: size: expected: 6, got: %v", i+1, size)
+		}
+		if !bytes.Equal(result, testCase.expectedResult) {
+			t.Fatalf("case %v: result: expected: %v, got: %v", i+1, string(testCase.expectedResult), string(result))
+		}
+	}
+}
+
 func testStorageAPIReadFile(t *testing.T, storage StorageAPI) {
 	err := storage.MakeVol(context.Background(), "foo")
 	if err != nil {
@@ -381,7 +433,7 @@ func testStorageAPIDeleteFile(t *testing.T, storage StorageAPI) {
 	}
 
 	for i, testCase := range testCases {
-		err := storage.Delete(context.Background(), testCase.volumeName, testCase.objectName, DeleteOptions{
+		_, err := storage.Delete(context.Background(), testCase.volumeName, testCase.objectName, DeleteOptions{
 			Recursive: false,
 			Force:     false,
 		})
@@ -391,6 +443,41 @@ func testStorageAPIDeleteFile(t *testing.T, storage StorageAPI) {
 			t.Fatalf("case %v: error: expected: %v, got: %v", i+1, testCase.expectErr, expectErr)
 		}
 	}
+
+	// ReportStats returns the size of the deleted file and whether its
+	// parent directory was left without entries.
+	for _, objectName := range []string{"sub/dir/statobject", "sub/dir/sibling"} {
+		err = storage.AppendFile(context.Background(), "foo", objectName, []byte("0123456789"))
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+
+	stats, err := storage.Delete(context.Background(), "foo", "sub/dir/statobject", DeleteOptions{
+		ReportStats: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if stats.BytesFreed != 10 {
+		t.Fatalf("expected 10 bytes freed, got %v", stats.BytesFreed)
+	}
+	if stats.ParentEmpty {
+		t.Fatalf("parent directory still has an entry, reported empty")
+	}
+
+	stats, err = storage.Delete(context.Background(), "foo", "sub/dir/sibling", DeleteOptions{
+		ReportStats: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if stats.BytesFreed != 10 {
+		t.Fatalf("expected 10 bytes freed, got %v", stats.BytesFreed)
+	}
+	if !stats.ParentEmpty {
+		t.Fatalf("expected empty parent directory to be reported")
+	}
 }
 
 func testStorageAPIRenameFile(t *testing.T, storage StorageAPI) {
@@ -521,6 +608,49 @@ func TestStorageRESTClientReadAll(t *testing.T) {
 	testStorageAPIReadAll(t, restClient)
 }
 
+func TestStorageRESTClientReadAllAt(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	testStorageAPIReadAllAt(t, restClient)
+}
+
+// TestStorageRESTClientReadAllLimited verifies the response size cap on
+// ReadAll, covering the exact-limit and limit+1 boundary.
+func TestStorageRESTClientReadAllLimited(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	if err := restClient.MakeVol(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	payload := bytes.Repeat([]byte("a"), 100)
+	if err := restClient.AppendFile(context.Background(), "foo", "myobject", payload); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// A limit matching the file size exactly reads the whole file.
+	result, err := restClient.ReadAllLimited(context.Background(), "foo", "myobject", int64(len(payload)))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !bytes.Equal(result, payload) {
+		t.Fatalf("expected %d bytes, got %d", len(payload), len(result))
+	}
+
+	// A limit one byte short of the file size is rejected.
+	if _, err = restClient.ReadAllLimited(context.Background(), "foo", "myobject", int64(len(payload))-1); err != errFileTooLarge {
+		t.Fatalf("expected %v, got %v", errFileTooLarge, err)
+	}
+
+	// The default limit is generous enough for regular metadata reads.
+	result, err = restClient.ReadAll(context.Background(), "foo", "myobject")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !bytes.Equal(result, payload) {
+		t.Fatalf("expected %d bytes, got %d", len(payload), len(result))
+	}
+}
+
 func TestStorageRESTClientReadFile(t *testing.T) {
 	restClient := newStorageRESTHTTPServerClient(t)
 
@@ -544,3 +674,410 @@ func TestStorageRESTClientRenameFile(t *testing.T) {
 
 	testStorageAPIRenameFile(t, restClient)
 }
+
+func TestStorageRESTClientRenameFiles(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	err := restClient.MakeVol(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for _, name := range []string{"myobject", "otherobject"} {
+		err = restClient.AppendFile(context.Background(), "foo", name, []byte("foo"))
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+
+	errs := restClient.RenameFiles(context.Background(), []RenameFilePair{
+		{SrcVolume: "foo", SrcPath: "myobject", DstVolume: "foo", DstPath: "yourobject"},
+		{SrcVolume: "foo", SrcPath: "otherobject", DstVolume: "foo", DstPath: "anotherobject"},
+		// missing source, must fail without affecting the others.
+		{SrcVolume: "foo", SrcPath: "missingobject", DstVolume: "foo", DstPath: "whatever"},
+	})
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 results, got %v", len(errs))
+	}
+	for i, err := range errs[:2] {
+		if err != nil {
+			t.Fatalf("pair %v: unexpected error %v", i+1, err)
+		}
+	}
+	if errs[2] == nil {
+		t.Fatal("expected an error renaming a missing source, got none")
+	}
+
+	for _, name := range []string{"yourobject", "anotherobject"} {
+		if _, err = restClient.ReadAll(context.Background(), "foo", name); err != nil {
+			t.Fatalf("unexpected error reading renamed file %v: %v", name, err)
+		}
+	}
+}
+
+func TestStorageRESTClientCheckVersion(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	err := restClient.MakeVol(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// A delete marker is the simplest valid version entry to write.
+	fi := FileInfo{
+		Volume:  "foo",
+		Name:    "myobject",
+		ModTime: UTCNow(),
+		Deleted: true,
+	}
+	err = restClient.WriteMetadata(context.Background(), "foo", "myobject", fi)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if err = restClient.CheckVersion(context.Background(), "foo", "myobject", ""); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Missing object surfaces as a missing version.
+	if err = restClient.CheckVersion(context.Background(), "foo", "yourobject", ""); err != errFileVersionNotFound {
+		t.Fatalf("expected %v, got %v", errFileVersionNotFound, err)
+	}
+}
+
+func TestStorageRESTClientWriteMetadataIf(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	err := restClient.MakeVol(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	fi := FileInfo{
+		Volume:  "foo",
+		Name:    "myobject",
+		ModTime: UTCNow(),
+		Deleted: true,
+	}
+
+	// No metadata exists yet, so only the zero signature is accepted.
+	if err = restClient.WriteMetadataIf(context.Background(), "foo", "myobject", fi, 42); err != errMetadataStale {
+		t.Fatalf("expected %v against missing metadata, got %v", errMetadataStale, err)
+	}
+	if err = restClient.WriteMetadataIf(context.Background(), "foo", "myobject", fi, 0); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	buf, err := restClient.ReadAll(context.Background(), "foo", pathJoin("myobject", xlStorageFormatFile))
+	if err != nil {
+		t.Fatalf("unexpected error reading back metadata: %v", err)
+	}
+	sig := metadataSignature(buf)
+
+	fi2 := fi
+	fi2.ModTime = UTCNow()
+	if err = restClient.WriteMetadataIf(context.Background(), "foo", "myobject", fi2, sig); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// The write above changed the metadata, the old signature is now stale.
+	if err = restClient.WriteMetadataIf(context.Background(), "foo", "myobject", fi2, sig); err != errMetadataStale {
+		t.Fatalf("expected %v with a stale signature, got %v", errMetadataStale, err)
+	}
+}
+
+type blockingReadCloser struct {
+	unblock chan struct{}
+	closed  chan struct{}
+}
+
+func (b *blockingReadCloser) Read(p []byte) (int, error) {
+	select {
+	case <-b.unblock:
+		copy(p, "x")
+		return 1, io.EOF
+	case <-b.closed:
+		return 0, errors.New("closed")
+	}
+}
+
+func (b *blockingReadCloser) Close() error {
+	close(b.closed)
+	return nil
+}
+
+func TestStorageRESTClientReadLatencyBudget(t *testing.T) {
+	ctx := context.Background()
+
+	// Without a budget the call runs unmodified, however slow.
+	client := &storageRESTClient{}
+	rc, err := client.readWithBudget(ctx, func(ctx context.Context) (io.ReadCloser, error) {
+		time.Sleep(20 * time.Millisecond)
+		return io.NopCloser(bytes.NewReader([]byte("hello"))), nil
+	})
+	if err != nil {
+		t.Fatalf("expected success without a budget, got %v", err)
+	}
+	buf, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil || string(buf) != "hello" {
+		t.Fatalf("expected 'hello', got %q, %v", string(buf), err)
+	}
+
+	// A call that does not return within the budget fails soft.
+	client.readLatencyBudget = 20 * time.Millisecond
+	blocked := make(chan struct{})
+	defer close(blocked)
+	_, err = client.readWithBudget(ctx, func(ctx context.Context) (io.ReadCloser, error) {
+		<-blocked
+		return nil, errors.New("never reached in time")
+	})
+	if err != errDiskSlow {
+		t.Fatalf("expected errDiskSlow, got %v", err)
+	}
+
+	// A stream that returns headers but no bytes within the budget
+	// fails soft on the first read.
+	brc := &blockingReadCloser{unblock: make(chan struct{}), closed: make(chan struct{})}
+	rc, err = client.readWithBudget(ctx, func(ctx context.Context) (io.ReadCloser, error) {
+		return brc, nil
+	})
+	if err != nil {
+		t.Fatalf("expected the call itself to succeed, got %v", err)
+	}
+	if _, err = rc.Read(make([]byte, 1)); err != errDiskSlow {
+		t.Fatalf("expected errDiskSlow on first read, got %v", err)
+	}
+
+	// A prompt stream passes through untouched.
+	brc = &blockingReadCloser{unblock: make(chan struct{}), closed: make(chan struct{})}
+	close(brc.unblock)
+	rc, err = client.readWithBudget(ctx, func(ctx context.Context) (io.ReadCloser, error) {
+		return brc, nil
+	})
+	if err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	p := make([]byte, 1)
+	if n, err := rc.Read(p); n != 1 || (err != nil && err != io.EOF) {
+		t.Fatalf("expected 1 byte, got %d, %v", n, err)
+	}
+	rc.Close()
+}
+
+func TestStorageRESTClientCapabilities(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	caps := restClient.Capabilities(context.Background())
+	if caps.APIVersion != storageRESTVersion {
+		t.Fatalf("expected API version %s, got %s", storageRESTVersion, caps.APIVersion)
+	}
+	for _, ext := range []string{
+		storageCapabilityResumeReads,
+		storageCapabilityInlineHash,
+		storageCapabilityQueueDepth,
+	} {
+		if !caps.Has(ext) {
+			t.Fatalf("expected extension %s to be advertised", ext)
+		}
+	}
+	if caps.Has("no-such-extension") {
+		t.Fatal("unexpected extension advertised")
+	}
+
+	// A second call must be served from the cache.
+	if !restClient.capsValid {
+		t.Fatal("expected capabilities to be cached")
+	}
+	caps2 := restClient.Capabilities(context.Background())
+	if caps2.APIVersion != caps.APIVersion || len(caps2.Extensions) != len(caps.Extensions) {
+		t.Fatal("cached capabilities differ from the fetched ones")
+	}
+}
+
+func TestStorageRESTClientWriteMetadataCompressed(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	if !restClient.Capabilities(context.Background()).Has(storageCapabilityCompressedMetadata) {
+		t.Fatal("expected compressed metadata capability to be advertised")
+	}
+
+	err := restClient.MakeVol(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Enough metadata to push the encoded FileInfo over the
+	// compression threshold.
+	fi := newFileInfo("myobject", 1, 1)
+	fi.Volume = "foo"
+	fi.Name = "myobject"
+	fi.DataDir = mustGetUUID()
+	fi.ModTime = UTCNow()
+	fi.Erasure.Index = 1
+	fi.Metadata = make(map[string]string)
+	for i := 0; i < 256; i++ {
+		fi.Metadata[fmt.Sprintf("X-Amz-Meta-Key-%03d", i)] = strings.Repeat("v", 64)
+	}
+
+	var buf bytes.Buffer
+	if err = msgp.Encode(&buf, &fi); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if buf.Len() < metadataCompressMinSize {
+		t.Fatalf("test payload too small to exercise compression: %d bytes", buf.Len())
+	}
+
+	if err = restClient.WriteMetadata(context.Background(), "foo", "myobject", fi); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	got, err := restClient.ReadVersion(context.Background(), "foo", "myobject", "", false)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for k, v := range fi.Metadata {
+		if got.Metadata[k] != v {
+			t.Fatalf("metadata key %s did not round-trip", k)
+		}
+	}
+}
+
+func TestStorageRESTClientCheckParts(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	err := restClient.MakeVol(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// One data block and a large block size keep the expected shard
+	// size equal to the part size.
+	fi := newFileInfo("myobject", 1, 0)
+	fi.Volume = "foo"
+	fi.Name = "myobject"
+	fi.DataDir = mustGetUUID()
+	fi.Erasure.BlockSize = 1024 * 1024
+	fi.Parts = []ObjectPartInfo{
+		{Number: 1, Size: 32},
+		{Number: 2, Size: 32},
+		{Number: 3, Size: 32},
+	}
+
+	// part.1 is intact, part.2 is missing, part.3 is truncated.
+	if err = restClient.WriteAll(context.Background(), "foo", pathJoin("myobject", fi.DataDir, "part.1"), bytes.Repeat([]byte("a"), 32)); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err = restClient.WriteAll(context.Background(), "foo", pathJoin("myobject", fi.DataDir, "part.3"), bytes.Repeat([]byte("a"), 16)); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	resp, err := restClient.CheckParts(context.Background(), "foo", "myobject", fi)
+	if err != errFileNotFound {
+		t.Fatalf("expected the convenience error %v, got %v", errFileNotFound, err)
+	}
+	if resp == nil {
+		t.Fatal("expected a per-part report alongside the error")
+	}
+	want := []int{checkPartSuccess, checkPartFileNotFound, checkPartFileCorrupt}
+	if len(resp.Results) != len(want) {
+		t.Fatalf("expected %d results, got %v", len(want), resp.Results)
+	}
+	for i := range want {
+		if resp.Results[i] != want[i] {
+			t.Fatalf("part %d: expected result %d, got %d", i+1, want[i], resp.Results[i])
+		}
+	}
+
+	// Repairing the missing and truncated parts clears the report.
+	if err = restClient.WriteAll(context.Background(), "foo", pathJoin("myobject", fi.DataDir, "part.2"), bytes.Repeat([]byte("a"), 32)); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err = restClient.WriteAll(context.Background(), "foo", pathJoin("myobject", fi.DataDir, "part.3"), bytes.Repeat([]byte("a"), 32)); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	resp, err = restClient.CheckParts(context.Background(), "foo", "myobject", fi)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for i, result := range resp.Results {
+		if result != checkPartSuccess {
+			t.Fatalf("part %d: expected success, got %d", i+1, result)
+		}
+	}
+}
+
+func TestStorageCallClassOf(t *testing.T) {
+	// Every storage REST method except the handshake endpoints must
+	// belong to a deadline class, so new methods cannot silently
+	// escape the configured deadlines.
+	methods := []string{
+		storageRESTMethodDiskInfo, storageRESTMethodNSScanner,
+		storageRESTMethodMakeVol, storageRESTMethodMakeVolBulk,
+		storageRESTMethodStatVol, storageRESTMethodDeleteVol,
+		storageRESTMethodListVols, storageRESTMethodAppendFile,
+		storageRESTMethodCreateFile, storageRESTMethodWriteAll,
+		storageRESTMethodWriteMetadata, storageRESTMethodUpdateMetadata,
+		storageRESTMethodPatchMetadata, storageRESTMethodDeleteVersion,
+		storageRESTMethodReadVersion, storageRESTMethodCheckVersion,
+		storageRESTMethodReadXL, storageRESTMethodRenameData,
+		storageRESTMethodCheckParts, storageRESTMethodReadAll,
+		storageRESTMethodReadFile, storageRESTMethodReadFileStream,
+		storageRESTMethodListDir, storageRESTMethodDeleteFile,
+		storageRESTMethodDeleteVersions, storageRESTMethodRenameFile,
+		storageRESTMethodRenameFiles, storageRESTMethodVerifyFile,
+		storageRESTMethodWalkDir, storageRESTMethodStatInfoFile,
+		storageRESTMethodReadMultiple, storageRESTMethodCleanAbandoned,
+	}
+	for _, method := range methods {
+		if storageCallClassOf(method) == storageCallNone {
+			t.Errorf("method %s has no deadline class", method)
+		}
+	}
+	if storageCallClassOf(storageRESTMethodHealth) != storageCallNone {
+		t.Errorf("health checks must not inherit a class deadline")
+	}
+}
+
+func TestStorageRESTClientReadFileStreamCancel(t *testing.T) {
+	restClient := newStorageRESTHTTPServerClient(t)
+
+	err := restClient.MakeVol(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	payload := bytes.Repeat([]byte("0123456789abcdef"), 1<<16) // 1 MiB
+	if err = restClient.AppendFile(context.Background(), "foo", "myobject", payload); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rc, err := restClient.ReadFileStream(ctx, "foo", "myobject", 0, int64(len(payload)))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	defer rc.Close()
+
+	// Read a little, then cancel mid-stream.
+	if _, err = io.ReadFull(rc, make([]byte, 4096)); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	cancel()
+
+	// The next read must return the context error promptly.
+	readErr := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(io.Discard, rc)
+		readErr <- err
+	}()
+	select {
+	case err := <-readErr:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read did not abort after cancellation")
+	}
+}
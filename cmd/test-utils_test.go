@@ -1304,6 +1304,34 @@ func getDeletePolicyURL(endPoint, bucketName string) string {
 	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
 }
 
+// return URL for putting bucket tagging.
+func getPutBucketTaggingURL(endPoint, bucketName string) string {
+	queryValue := url.Values{}
+	queryValue.Set("tagging", "")
+	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
+}
+
+// return URL for fetching bucket tagging.
+func getGetBucketTaggingURL(endPoint, bucketName string) string {
+	queryValue := url.Values{}
+	queryValue.Set("tagging", "")
+	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
+}
+
+// return URL for fetching bucket tagging history.
+func getGetBucketTaggingHistoryURL(endPoint, bucketName string) string {
+	queryValue := url.Values{}
+	queryValue.Set("tagging-history", "")
+	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
+}
+
+// return URL for fetching bucket object lock configuration.
+func getGetBucketObjectLockConfigURL(endPoint, bucketName string) string {
+	queryValue := url.Values{}
+	queryValue.Set("object-lock", "")
+	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
+}
+
 // return URL for creating the bucket.
 func getMakeBucketURL(endPoint, bucketName string) string {
 	return makeTestTargetURL(endPoint, bucketName, "", url.Values{})
@@ -1948,6 +1976,9 @@ func ExecObjectLayerStaleFilesTest(t *testing.T, objTest objTestStaleFilesType)
 func registerBucketLevelFunc(bucket *mux.Router, api objectAPIHandlers, apiFunctions ...string) {
 	for _, apiFunction := range apiFunctions {
 		switch apiFunction {
+		case "MakeBucket":
+			// Register PutBucket handler.
+			bucket.Methods(http.MethodPut).HandlerFunc(api.PutBucketHandler)
 		case "PostPolicy":
 			// Register PostPolicy handler.
 			bucket.Methods(http.MethodPost).HeadersRegexp("Content-Type", "multipart/form-data*").HandlerFunc(api.PostPolicyBucketHandler)
@@ -1975,6 +2006,24 @@ func registerBucketLevelFunc(bucket *mux.Router, api objectAPIHandlers, apiFunct
 		case "GetBucketPolicy":
 			// Register Get Bucket policy HTTP Handler.
 			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketPolicyHandler).Queries("policy", "")
+		case "PutBucketTagging":
+			// Register Put Bucket tagging HTTP Handler.
+			bucket.Methods(http.MethodPut).HandlerFunc(api.PutBucketTaggingHandler).Queries("tagging", "")
+		case "GetBucketTagging":
+			// Register Get Bucket tagging HTTP Handler.
+			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketTaggingHandler).Queries("tagging", "")
+		case "GetBucketMetadataExport":
+			// Register Get Bucket metadata export HTTP Handler.
+			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketMetadataExportHandler).Queries("metadata-export", "")
+		case "PutBucketMetadataImport":
+			// Register Put Bucket metadata import HTTP Handler.
+			bucket.Methods(http.MethodPut).HandlerFunc(api.PutBucketMetadataImportHandler).Queries("metadata-import", "")
+		case "GetBucketTaggingHistory":
+			// Register Get Bucket tagging history HTTP Handler.
+			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketTaggingHistoryHandler).Queries("tagging-history", "")
+		case "GetBucketObjectLockConfig":
+			// Register Get Bucket object lock configuration HTTP Handler.
+			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketObjectLockConfigHandler).Queries("object-lock", "")
 		case "GetBucketLifecycle":
 			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketLifecycleHandler).Queries("lifecycle", "")
 		case "PutBucketLifecycle":
@@ -94,6 +94,18 @@ func (os *objSweeper) SetTransitionState(info TransitionedObject) {
 	os.TransitionVersionID = info.VersionID
 }
 
+// newDeleteSweeper returns the sweeper for one entry of a multi-delete
+// request. The transition state is copied from goi only when the fetch for
+// this very entry succeeded, so a sweeper never carries state from a failed
+// read or from another entry of the batch.
+func newDeleteSweeper(bucket, object string, opts ObjectOptions, goi ObjectInfo, gerr error) *objSweeper {
+	os := newObjSweeper(bucket, object).WithVersion(opts.VersionID).WithVersioning(opts.Versioned, opts.VersionSuspended)
+	if gerr == nil {
+		os.SetTransitionState(goi.TransitionedObject)
+	}
+	return os
+}
+
 // shouldRemoveRemoteObject determines if a transitioned object should be
 // removed from remote tier. If remote object is to be deleted, returns the
 // corresponding tier deletion journal entry and true. Otherwise returns empty
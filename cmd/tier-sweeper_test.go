@@ -0,0 +1,111 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/minio/minio/internal/bucket/lifecycle"
+)
+
+// Regression test for sweepers built in a multi-delete loop: each sweeper
+// must carry the transition state of its own object only, a failed fetch
+// must leave the state unset instead of reusing a previous entry's.
+func TestNewDeleteSweeper(t *testing.T) {
+	testCases := []struct {
+		object string
+		goi    ObjectInfo
+		gerr   error
+		want   TransitionedObject
+	}{
+		{
+			object: "tiered-1",
+			goi: ObjectInfo{
+				TransitionedObject: TransitionedObject{
+					Name:      "remote/tiered-1",
+					VersionID: "v1",
+					Tier:      "WARM",
+					Status:    lifecycle.TransitionComplete,
+				},
+			},
+			want: TransitionedObject{
+				Name:      "remote/tiered-1",
+				VersionID: "v1",
+				Tier:      "WARM",
+				Status:    lifecycle.TransitionComplete,
+			},
+		},
+		{
+			object: "not-tiered",
+			goi:    ObjectInfo{},
+			want:   TransitionedObject{},
+		},
+		{
+			// A failed fetch must not attach any transition state, even
+			// when a stale ObjectInfo is passed alongside the error.
+			object: "fetch-failed",
+			goi: ObjectInfo{
+				TransitionedObject: TransitionedObject{
+					Name:   "remote/tiered-1",
+					Tier:   "WARM",
+					Status: lifecycle.TransitionComplete,
+				},
+			},
+			gerr: errFileNotFound,
+			want: TransitionedObject{},
+		},
+		{
+			object: "tiered-2",
+			goi: ObjectInfo{
+				TransitionedObject: TransitionedObject{
+					Name:      "remote/tiered-2",
+					VersionID: "v2",
+					Tier:      "COLD",
+					Status:    lifecycle.TransitionComplete,
+				},
+			},
+			want: TransitionedObject{
+				Name:      "remote/tiered-2",
+				VersionID: "v2",
+				Tier:      "COLD",
+				Status:    lifecycle.TransitionComplete,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.object, func(t *testing.T) {
+			os := newDeleteSweeper("bucket", tc.object, ObjectOptions{VersionID: "ovid", Versioned: true}, tc.goi, tc.gerr)
+			if os.Bucket != "bucket" || os.Object != tc.object {
+				t.Fatalf("sweeper identifies %s/%s, expected bucket/%s", os.Bucket, os.Object, tc.object)
+			}
+			if os.VersionID != "ovid" || !os.Versioned || os.Suspended {
+				t.Fatalf("sweeper versioning not taken from opts: %+v", os)
+			}
+			got := TransitionedObject{
+				Name:      os.RemoteObject,
+				VersionID: os.TransitionVersionID,
+				Tier:      os.TransitionTier,
+				Status:    os.TransitionStatus,
+			}
+			if got != tc.want {
+				t.Fatalf("sweeper transition state %+v, expected %+v", got, tc.want)
+			}
+		})
+	}
+}
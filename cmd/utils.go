@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net"
 	"net/http"
 	"net/url"
@@ -848,6 +849,9 @@ func newContext(r *http.Request, w http.ResponseWriter, api string) context.Cont
 		},
 	)
 
+	// Enable structured error details when the client opted in.
+	ctx = contextWithErrorDetail(ctx, r)
+
 	return logger.SetReqInfo(ctx, reqInfo)
 }
 
@@ -955,19 +959,46 @@ type timedValue struct {
 	// even if updating the value errors out
 	Relax bool
 
+	// Backoff enables negative-result caching. When Update returns an
+	// error the error itself is cached and re-probed only after an
+	// exponentially growing interval, starting at TTL and doubling up
+	// to BackoffCap, with a per-instance random jitter so many values
+	// erroring at once do not re-probe in lockstep. The first
+	// successful Update resets the interval back to TTL.
+	Backoff bool
+
+	// BackoffCap bounds the negative-result interval when Backoff is
+	// set. If not set 30 second cap is assumed.
+	BackoffCap time.Duration
+
 	// Once can be used to initialize values for lazy initialization.
 	// Should be set before calling Get().
 	Once sync.Once
 
 	// Managed values.
-	value      interface{}
-	lastUpdate time.Time
-	mu         sync.RWMutex
+	value        interface{}
+	lastUpdate   time.Time
+	lastError    error
+	lastErrTime  time.Time
+	errTTL       time.Duration
+	backoffLevel int
+	jitter       float64
+	mu           sync.RWMutex
 }
 
 // Get will return a cached value or fetch a new one.
-// If the Update function returns an error the value is forwarded as is and not cached.
+// If the Update function returns an error the value is forwarded as is and not cached,
+// unless Backoff is set in which case the error itself is cached and the next probe
+// is delayed by the current backoff interval.
 func (t *timedValue) Get() (interface{}, error) {
+	if t.Backoff {
+		if err := t.getErr(); err != nil {
+			// The last probe failed and its backoff interval has
+			// not elapsed yet, do not hit the backend again.
+			return t.get(0), err
+		}
+	}
+
 	v := t.get(t.ttl())
 	if v != nil {
 		return v, nil
@@ -975,6 +1006,9 @@ func (t *timedValue) Get() (interface{}, error) {
 
 	v, err := t.Update()
 	if err != nil {
+		if t.Backoff {
+			t.updateErr(err)
+		}
 		if t.Relax {
 			// if update fails, return current
 			// cached value along with error.
@@ -992,6 +1026,53 @@ func (t *timedValue) Get() (interface{}, error) {
 	return v, nil
 }
 
+// BackoffLevel returns the number of consecutive failed updates, zero
+// when the last update succeeded.
+func (t *timedValue) BackoffLevel() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.backoffLevel
+}
+
+// getErr returns the cached error while its backoff interval has not
+// elapsed, nil when the value is due for a fresh probe.
+func (t *timedValue) getErr() error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.lastError == nil {
+		return nil
+	}
+	if time.Since(t.lastErrTime) < time.Duration(float64(t.errTTL)*t.jitter) {
+		return t.lastError
+	}
+	return nil
+}
+
+// updateErr caches the error and doubles the backoff interval up to
+// BackoffCap, applying a fresh jitter factor for the next probe.
+func (t *timedValue) updateErr(err error) {
+	maxTTL := t.BackoffCap
+	if maxTTL <= 0 {
+		maxTTL = 30 * time.Second
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastError = err
+	t.lastErrTime = time.Now()
+	t.backoffLevel++
+	if t.errTTL == 0 {
+		t.errTTL = t.ttl()
+	} else if t.errTTL < maxTTL {
+		t.errTTL *= 2
+		if t.errTTL > maxTTL {
+			t.errTTL = maxTTL
+		}
+	}
+	// Spread the next probe within [0.5, 1.5) of the interval so
+	// clients that failed together do not retry together.
+	t.jitter = 0.5 + rand.Float64()
+}
+
 func (t *timedValue) ttl() time.Duration {
 	ttl := t.TTL
 	if ttl <= 0 {
@@ -1018,6 +1099,10 @@ func (t *timedValue) update(v interface{}) {
 	defer t.mu.Unlock()
 	t.value = v
 	t.lastUpdate = time.Now()
+	// A success resets the negative-result backoff to the normal TTL.
+	t.lastError = nil
+	t.errTTL = 0
+	t.backoffLevel = 0
 }
 
 // On MinIO a directory object is stored as a regular object with "__XLDIR__" suffix.
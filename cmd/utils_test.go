@@ -463,3 +463,60 @@ func TestTimedValue(t *testing.T) {
 		t.Fatalf("expected time to be un-equal: %s == %s", t1, t3)
 	}
 }
+
+func TestTimedValueBackoff(t *testing.T) {
+	t.Parallel()
+	var calls int
+	updateErr := errors.New("update failed")
+	var cache timedValue
+	cache.Once.Do(func() {
+		cache.TTL = 100 * time.Millisecond
+		cache.Backoff = true
+		cache.Update = func() (interface{}, error) {
+			calls++
+			if calls <= 2 {
+				return nil, updateErr
+			}
+			return calls, nil
+		}
+	})
+
+	if _, err := cache.Get(); err != updateErr {
+		t.Fatalf("expected update error, got %v", err)
+	}
+	if lvl := cache.BackoffLevel(); lvl != 1 {
+		t.Fatalf("expected backoff level 1, got %d", lvl)
+	}
+
+	// While the backoff interval has not elapsed the cached error is
+	// returned without calling Update again.
+	if _, err := cache.Get(); err != updateErr {
+		t.Fatalf("expected cached update error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single update call, got %d", calls)
+	}
+
+	// Wait out the worst-case jittered interval, the next probe fails
+	// again and doubles the backoff level.
+	time.Sleep(200 * time.Millisecond)
+	if _, err := cache.Get(); err != updateErr {
+		t.Fatalf("expected update error, got %v", err)
+	}
+	if lvl := cache.BackoffLevel(); lvl != 2 {
+		t.Fatalf("expected backoff level 2, got %d", lvl)
+	}
+
+	// A success resets the backoff level.
+	time.Sleep(400 * time.Millisecond)
+	v, err := cache.Get()
+	if err != nil {
+		t.Fatalf("expected update to succeed, got %v", err)
+	}
+	if v.(int) != 3 {
+		t.Fatalf("expected third update call value, got %v", v)
+	}
+	if lvl := cache.BackoffLevel(); lvl != 0 {
+		t.Fatalf("expected backoff level reset, got %d", lvl)
+	}
+}
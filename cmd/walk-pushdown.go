@@ -0,0 +1,177 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/minio/minio-go/v7/pkg/tags"
+	xhttp "github.com/minio/minio/internal/http"
+	"github.com/minio/pkg/wildcard"
+)
+
+// WalkPushdownKV is a single key value check evaluated against the raw
+// xl.meta of walked entries, the value may be a plain string or a
+// wildcard-prefix pattern such as 'prefix*'.
+type WalkPushdownKV struct {
+	Key   string
+	Value string
+}
+
+// Empty indicates if kv is not set.
+func (kv WalkPushdownKV) Empty() bool {
+	return kv.Key == "" && kv.Value == ""
+}
+
+// Match matches the input key and value against kv, mirroring the batch
+// job filter semantics: keys compare case insensitively and values are
+// wildcard matched.
+func (kv WalkPushdownKV) Match(key, value string) bool {
+	if kv.Empty() {
+		return true
+	}
+	if strings.EqualFold(kv.Key, key) {
+		return wildcard.Match(kv.Value, value)
+	}
+	return false
+}
+
+// simple reports whether the value is a plain equality or a
+// wildcard-prefix check, the only patterns pushed down to the storage
+// layer.
+func (kv WalkPushdownKV) simple() bool {
+	if strings.Contains(kv.Value, "?") {
+		return false
+	}
+	if i := strings.Index(kv.Value, "*"); i >= 0 && i != len(kv.Value)-1 {
+		return false
+	}
+	return true
+}
+
+// WalkPushdownFilter restricts a Walk to entries where at least one
+// version satisfies the tag or metadata checks. It is evaluated against
+// the raw xl.meta so entries that cannot match are skipped before all
+// their versions are decoded. Tag checks take precedence over metadata
+// checks, matching the batch job filter semantics.
+type WalkPushdownFilter struct {
+	Tags     []WalkPushdownKV
+	Metadata []WalkPushdownKV
+}
+
+// newWalkPushdownFilter returns a pushdown filter for the given tag and
+// metadata checks, or nil if there is nothing to push down or any check
+// is not a simple equality/wildcard-prefix pattern.
+func newWalkPushdownFilter(tagKVs, metaKVs []WalkPushdownKV) *WalkPushdownFilter {
+	if len(tagKVs) == 0 && len(metaKVs) == 0 {
+		return nil
+	}
+	for _, kv := range tagKVs {
+		if !kv.simple() {
+			return nil
+		}
+	}
+	for _, kv := range metaKVs {
+		if !kv.simple() {
+			return nil
+		}
+	}
+	return &WalkPushdownFilter{Tags: tagKVs, Metadata: metaKVs}
+}
+
+// matchMeta evaluates the filter against a single version's user
+// metadata, following the same semantics as the batch job skip
+// functions.
+func (f *WalkPushdownFilter) matchMeta(metaUser map[string]string) bool {
+	if len(f.Tags) > 0 {
+		tagMap := map[string]string{}
+		if tagStr := metaUser[xhttp.AmzObjectTagging]; len(tagStr) != 0 {
+			t, err := tags.ParseObjectTags(tagStr)
+			if err != nil {
+				return false
+			}
+			tagMap = t.ToMap()
+		}
+		for _, kv := range f.Tags {
+			for t, v := range tagMap {
+				if kv.Match(t, v) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if len(f.Metadata) > 0 {
+		for _, kv := range f.Metadata {
+			for k, v := range metaUser {
+				if !strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") && !isStandardHeader(k) {
+					continue
+				}
+				// We only need to match x-amz-meta or standardHeaders
+				if kv.Match(k, v) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// matchRawMeta scans the serialized versions in a raw xl.meta buffer and
+// reports whether any of them satisfies the filter, decoding one version
+// at a time and stopping at the first match. ok is false when the buffer
+// is not in a directly scannable format, the caller must then fall back
+// to fully decoding the entry.
+func (f *WalkPushdownFilter) matchRawMeta(buf []byte) (matched, ok bool) {
+	meta, _, err := isIndexedMetaV2(buf)
+	if meta == nil || err != nil {
+		return false, false
+	}
+	vers, _, metaV, mbuf, err := decodeXLHeaders(meta)
+	if err != nil {
+		return false, false
+	}
+	var version xlMetaV2Version
+	if derr := decodeVersions(mbuf, vers, func(idx int, hdr, meta []byte) error {
+		if _, err := version.unmarshalV(metaV, meta); err != nil {
+			return err
+		}
+		switch version.Type {
+		case ObjectType:
+			if f.matchMeta(version.ObjectV2.MetaUser) {
+				matched = true
+				return errDoneForNow
+			}
+		case DeleteType:
+			// Delete markers carry no user metadata and can never
+			// satisfy a tag or metadata check.
+		default:
+			// Cannot evaluate this version here, let the entry
+			// through so the walk filter decides.
+			matched = true
+			return errDoneForNow
+		}
+		return nil
+	}); derr != nil {
+		return false, false
+	}
+	return matched, true
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	xhttp "github.com/minio/minio/internal/http"
+)
+
+func TestNewWalkPushdownFilter(t *testing.T) {
+	if f := newWalkPushdownFilter(nil, nil); f != nil {
+		t.Fatal("expected nil filter when there is nothing to push down")
+	}
+	if f := newWalkPushdownFilter([]WalkPushdownKV{{Key: "env", Value: "prod"}}, nil); f == nil {
+		t.Fatal("expected filter for plain equality check")
+	}
+	if f := newWalkPushdownFilter([]WalkPushdownKV{{Key: "env", Value: "pr*"}}, nil); f == nil {
+		t.Fatal("expected filter for wildcard-prefix check")
+	}
+	if f := newWalkPushdownFilter([]WalkPushdownKV{{Key: "env", Value: "p*d"}}, nil); f != nil {
+		t.Fatal("expected nil filter for embedded wildcard check")
+	}
+	if f := newWalkPushdownFilter(nil, []WalkPushdownKV{{Key: "x-amz-meta-a", Value: "b?"}}); f != nil {
+		t.Fatal("expected nil filter for single character wildcard check")
+	}
+}
+
+func TestWalkPushdownMatchRawMeta(t *testing.T) {
+	fi := FileInfo{
+		Volume:    "volume",
+		Name:      "object-name",
+		VersionID: mustGetUUID(),
+		DataDir:   mustGetUUID(),
+		ModTime:   time.Now(),
+		Metadata: map[string]string{
+			xhttp.AmzObjectTagging: "env=prod&team=eng",
+			"x-amz-meta-flavor":    "vanilla",
+		},
+		Erasure: ErasureInfo{
+			Algorithm:    ReedSolomon.String(),
+			DataBlocks:   4,
+			ParityBlocks: 2,
+			BlockSize:    10000,
+			Index:        1,
+			Distribution: []int{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+
+	var xl xlMetaV2
+	if err := xl.AddVersion(fi); err != nil {
+		t.Fatal(err)
+	}
+
+	// Add an older delete marker, it carries no user metadata.
+	dm := fi
+	dm.VersionID = mustGetUUID()
+	dm.DataDir = ""
+	dm.Metadata = nil
+	dm.Deleted = true
+	dm.ModTime = fi.ModTime.Add(-time.Hour)
+	if err := xl.AddVersion(dm); err != nil {
+		t.Fatal(err)
+	}
+
+	buf, err := xl.AppendTo(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		filter  *WalkPushdownFilter
+		matched bool
+	}{
+		{
+			name:    "tag-equality-hit",
+			filter:  &WalkPushdownFilter{Tags: []WalkPushdownKV{{Key: "env", Value: "prod"}}},
+			matched: true,
+		},
+		{
+			name:    "tag-equality-miss",
+			filter:  &WalkPushdownFilter{Tags: []WalkPushdownKV{{Key: "env", Value: "dev"}}},
+			matched: false,
+		},
+		{
+			name:    "tag-wildcard-prefix-hit",
+			filter:  &WalkPushdownFilter{Tags: []WalkPushdownKV{{Key: "team", Value: "e*"}}},
+			matched: true,
+		},
+		{
+			name:    "metadata-equality-hit",
+			filter:  &WalkPushdownFilter{Metadata: []WalkPushdownKV{{Key: "x-amz-meta-flavor", Value: "vanilla"}}},
+			matched: true,
+		},
+		{
+			name:    "metadata-equality-miss",
+			filter:  &WalkPushdownFilter{Metadata: []WalkPushdownKV{{Key: "x-amz-meta-flavor", Value: "chocolate"}}},
+			matched: false,
+		},
+		{
+			name: "tags-take-precedence-over-metadata",
+			filter: &WalkPushdownFilter{
+				Tags:     []WalkPushdownKV{{Key: "env", Value: "dev"}},
+				Metadata: []WalkPushdownKV{{Key: "x-amz-meta-flavor", Value: "vanilla"}},
+			},
+			matched: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			matched, ok := testCase.filter.matchRawMeta(buf)
+			if !ok {
+				t.Fatal("expected raw metadata to be scannable")
+			}
+			if matched != testCase.matched {
+				t.Fatalf("expected matched=%v, got %v", testCase.matched, matched)
+			}
+		})
+	}
+
+	// Buffers that are not xl.meta must report not-ok so callers fall
+	// back to fully decoding the entry.
+	filter := &WalkPushdownFilter{Tags: []WalkPushdownKV{{Key: "env", Value: "prod"}}}
+	if _, ok := filter.matchRawMeta([]byte("not an xl.meta")); ok {
+		t.Fatal("expected not-ok for a buffer that is not xl.meta")
+	}
+}
@@ -67,6 +67,7 @@ const (
 	storageMetricReadMultiple
 	storageMetricDeleteAbandonedParts
 	storageMetricDiskInfo
+	storageMetricReadAllAt
 
 	// .... add more
 
@@ -173,17 +174,23 @@ func (p *xlStorageDiskIDCheck) Healing() *healingTracker {
 	return p.storage.Healing()
 }
 
-func (p *xlStorageDiskIDCheck) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode) (dataUsageCache, error) {
+func (p *xlStorageDiskIDCheck) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode, scanErrs chan<- nsScannerError) (dataUsageCache, error) {
 	if contextCanceled(ctx) {
 		close(updates)
+		if scanErrs != nil {
+			close(scanErrs)
+		}
 		return dataUsageCache{}, ctx.Err()
 	}
 
 	if err := p.checkDiskStale(); err != nil {
 		close(updates)
+		if scanErrs != nil {
+			close(scanErrs)
+		}
 		return dataUsageCache{}, err
 	}
-	return p.storage.NSScanner(ctx, cache, updates, scanMode)
+	return p.storage.NSScanner(ctx, cache, updates, scanMode, scanErrs)
 }
 
 func (p *xlStorageDiskIDCheck) GetDiskLoc() (poolIdx, setIdx, diskIdx int) {
@@ -370,6 +377,32 @@ func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPat
 	return p.storage.RenameFile(ctx, srcVolume, srcPath, dstVolume, dstPath)
 }
 
+func (p *xlStorageDiskIDCheck) RenameFiles(ctx context.Context, pairs []RenameFilePair) (errs []error) {
+	// Merely for tracing storage
+	path := ""
+	if len(pairs) > 0 {
+		path = pairs[0].SrcPath
+	}
+	errs = make([]error, len(pairs))
+	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricRenameFile, path)
+	if err != nil {
+		for i := range errs {
+			errs[i] = ctx.Err()
+		}
+		return errs
+	}
+	defer done(&err)
+	errs = p.storage.RenameFiles(ctx, pairs)
+	for i := range errs {
+		if errs[i] != nil {
+			err = errs[i]
+			break
+		}
+	}
+
+	return errs
+}
+
 func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (sign uint64, err error) {
 	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricRenameData, srcPath, fi.DataDir, dstVolume, dstPath)
 	if err != nil {
@@ -380,20 +413,20 @@ func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPat
 	return p.storage.RenameData(ctx, srcVolume, srcPath, fi, dstVolume, dstPath)
 }
 
-func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (err error) {
+func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (resp *CheckPartsResp, err error) {
 	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricCheckParts, volume, path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer done(&err)
 
 	return p.storage.CheckParts(ctx, volume, path, fi)
 }
 
-func (p *xlStorageDiskIDCheck) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (err error) {
+func (p *xlStorageDiskIDCheck) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (stats DeleteStats, err error) {
 	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricDelete, volume, path)
 	if err != nil {
-		return err
+		return DeleteStats{}, err
 	}
 	defer done(&err)
 
@@ -468,6 +501,16 @@ func (p *xlStorageDiskIDCheck) UpdateMetadata(ctx context.Context, volume, path
 	return p.storage.UpdateMetadata(ctx, volume, path, fi)
 }
 
+func (p *xlStorageDiskIDCheck) PatchMetadata(ctx context.Context, volume, path string, patch MetadataPatch) (err error) {
+	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricUpdateMetadata, volume, path)
+	if err != nil {
+		return err
+	}
+	defer done(&err)
+
+	return p.storage.PatchMetadata(ctx, volume, path, patch)
+}
+
 func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
 	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricWriteMetadata, volume, path)
 	if err != nil {
@@ -478,6 +521,16 @@ func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path s
 	return p.storage.WriteMetadata(ctx, volume, path, fi)
 }
 
+func (p *xlStorageDiskIDCheck) WriteMetadataIf(ctx context.Context, volume, path string, fi FileInfo, expectedSig uint64) (err error) {
+	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricWriteMetadata, volume, path)
+	if err != nil {
+		return err
+	}
+	defer done(&err)
+
+	return p.storage.WriteMetadataIf(ctx, volume, path, fi, expectedSig)
+}
+
 func (p *xlStorageDiskIDCheck) ReadVersion(ctx context.Context, volume, path, versionID string, readData bool) (fi FileInfo, err error) {
 	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricReadVersion, volume, path)
 	if err != nil {
@@ -498,6 +551,16 @@ func (p *xlStorageDiskIDCheck) ReadAll(ctx context.Context, volume string, path
 	return p.storage.ReadAll(ctx, volume, path)
 }
 
+func (p *xlStorageDiskIDCheck) ReadAllAt(ctx context.Context, volume string, path string, offset, length int64) (buf []byte, size int64, err error) {
+	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricReadAllAt, volume, path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer done(&err)
+
+	return p.storage.ReadAllAt(ctx, volume, path, offset, length)
+}
+
 func (p *xlStorageDiskIDCheck) ReadXL(ctx context.Context, volume string, path string, readData bool) (rf RawFileInfo, err error) {
 	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricReadXL, volume, path)
 	if err != nil {
@@ -535,10 +598,10 @@ func (p *xlStorageDiskIDCheck) ReadMultiple(ctx context.Context, req ReadMultipl
 
 // CleanAbandonedData will read metadata of the object on disk
 // and delete any data directories and inline data that isn't referenced in metadata.
-func (p *xlStorageDiskIDCheck) CleanAbandonedData(ctx context.Context, volume string, path string) error {
+func (p *xlStorageDiskIDCheck) CleanAbandonedData(ctx context.Context, volume string, path string) (res CleanAbandonedDataResp, err error) {
 	ctx, done, err := p.TrackDiskHealth(ctx, storageMetricDeleteAbandonedParts, volume, path)
 	if err != nil {
-		return err
+		return res, err
 	}
 	defer done(&err)
 
@@ -808,7 +871,7 @@ func (p *xlStorageDiskIDCheck) monitorDiskStatus() {
 		if err != nil || len(b) != 10001 {
 			continue
 		}
-		err = p.storage.Delete(context.Background(), minioMetaTmpBucket, fn, DeleteOptions{
+		_, err = p.storage.Delete(context.Background(), minioMetaTmpBucket, fn, DeleteOptions{
 			Recursive: false,
 			Force:     false,
 		})
@@ -86,6 +86,13 @@ type StatInfo struct {
 	Name    string    `json:"name"`
 	Dir     bool      `json:"dir"`
 	Mode    uint32    `json:"mode"`
+
+	// Version details, only populated for `xl.meta` entries when they are
+	// cheaply available from the metadata headers. Additive msgp fields,
+	// they decode as zero values from older servers that do not send them.
+	NumVersions     int       `json:"numVersions,omitempty"`     // Number of versions excluding free versions.
+	LatestVersionID string    `json:"latestVersionId,omitempty"` // VersionID of the latest version.
+	LatestModTime   time.Time `json:"latestModTime,omitempty"`   // ModTime of the latest version.
 }
 
 // ErasureInfo holds erasure coding and bitrot related information.
@@ -965,6 +965,24 @@ func (z *StatInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Mode")
 				return
 			}
+		case "NumVersions":
+			z.NumVersions, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "NumVersions")
+				return
+			}
+		case "LatestVersionID":
+			z.LatestVersionID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "LatestVersionID")
+				return
+			}
+		case "LatestModTime":
+			z.LatestModTime, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "LatestModTime")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -978,9 +996,9 @@ func (z *StatInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *StatInfo) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 5
+	// map header, size 8
 	// write "Size"
-	err = en.Append(0x85, 0xa4, 0x53, 0x69, 0x7a, 0x65)
+	err = en.Append(0x88, 0xa4, 0x53, 0x69, 0x7a, 0x65)
 	if err != nil {
 		return
 	}
@@ -1029,15 +1047,45 @@ func (z *StatInfo) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Mode")
 		return
 	}
+	// write "NumVersions"
+	err = en.Append(0xab, 0x4e, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.NumVersions)
+	if err != nil {
+		err = msgp.WrapError(err, "NumVersions")
+		return
+	}
+	// write "LatestVersionID"
+	err = en.Append(0xaf, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.LatestVersionID)
+	if err != nil {
+		err = msgp.WrapError(err, "LatestVersionID")
+		return
+	}
+	// write "LatestModTime"
+	err = en.Append(0xad, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.LatestModTime)
+	if err != nil {
+		err = msgp.WrapError(err, "LatestModTime")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *StatInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 5
+	// map header, size 8
 	// string "Size"
-	o = append(o, 0x85, 0xa4, 0x53, 0x69, 0x7a, 0x65)
+	o = append(o, 0x88, 0xa4, 0x53, 0x69, 0x7a, 0x65)
 	o = msgp.AppendInt64(o, z.Size)
 	// string "ModTime"
 	o = append(o, 0xa7, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65)
@@ -1051,6 +1099,15 @@ func (z *StatInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "Mode"
 	o = append(o, 0xa4, 0x4d, 0x6f, 0x64, 0x65)
 	o = msgp.AppendUint32(o, z.Mode)
+	// string "NumVersions"
+	o = append(o, 0xab, 0x4e, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73)
+	o = msgp.AppendInt(o, z.NumVersions)
+	// string "LatestVersionID"
+	o = append(o, 0xaf, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44)
+	o = msgp.AppendString(o, z.LatestVersionID)
+	// string "LatestModTime"
+	o = append(o, 0xad, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65)
+	o = msgp.AppendTime(o, z.LatestModTime)
 	return
 }
 
@@ -1102,6 +1159,24 @@ func (z *StatInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Mode")
 				return
 			}
+		case "NumVersions":
+			z.NumVersions, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumVersions")
+				return
+			}
+		case "LatestVersionID":
+			z.LatestVersionID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LatestVersionID")
+				return
+			}
+		case "LatestModTime":
+			z.LatestModTime, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LatestModTime")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -1116,7 +1191,7 @@ func (z *StatInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *StatInfo) Msgsize() (s int) {
-	s = 1 + 5 + msgp.Int64Size + 8 + msgp.TimeSize + 5 + msgp.StringPrefixSize + len(z.Name) + 4 + msgp.BoolSize + 5 + msgp.Uint32Size
+	s = 1 + 5 + msgp.Int64Size + 8 + msgp.TimeSize + 5 + msgp.StringPrefixSize + len(z.Name) + 4 + msgp.BoolSize + 5 + msgp.Uint32Size + 12 + msgp.IntSize + 16 + msgp.StringPrefixSize + len(z.LatestVersionID) + 14 + msgp.TimeSize
 	return
 }
 
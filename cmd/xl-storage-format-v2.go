@@ -27,6 +27,7 @@ import (
 	"io"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -508,6 +509,11 @@ const (
 	metaTierObjName   = ReservedMetadataPrefixLower + TransitionedObjectName
 	metaTierVersionID = ReservedMetadataPrefixLower + TransitionedVersionID
 	metaTierName      = ReservedMetadataPrefixLower + TransitionTier
+
+	// metaGeneration counts metadata updates on a version, used by
+	// UpdateObjectVersion() for optimistic concurrency. Servers unaware
+	// of this key simply persist it along with the rest of the metadata.
+	metaGeneration = ReservedMetadataPrefixLower + "metadata-generation"
 )
 
 func (j *xlMetaV2Object) SetTransition(fi FileInfo) {
@@ -859,6 +865,49 @@ func isIndexedMetaV2(buf []byte) (meta xlMetaBuf, data xlMetaInlineData, err err
 	return meta, data, nil
 }
 
+// statXLVersionSummary returns the version count, the latest version ID and
+// its mod time from the version headers of a raw `xl.meta` buffer. Only the
+// fixed-size headers are decoded, version bodies are left untouched. Free
+// versions are excluded from the count. An error is returned when the buffer
+// is not indexed metadata that can be scanned this way.
+func statXLVersionSummary(buf []byte) (numVersions int, versionID string, modTime time.Time, err error) {
+	meta, _, err := isIndexedMetaV2(buf)
+	if err != nil {
+		return 0, "", time.Time{}, err
+	}
+	if meta == nil {
+		return 0, "", time.Time{}, errFileCorrupt
+	}
+	versions, headerV, _, mbuf, err := decodeXLHeaders(meta)
+	if err != nil {
+		return 0, "", time.Time{}, err
+	}
+	var header xlMetaV2VersionHeader
+	numVersions = versions
+	found := false
+	if err = decodeVersions(mbuf, versions, func(idx int, hdr, _ []byte) error {
+		if _, err := header.unmarshalV(headerV, hdr); err != nil {
+			return err
+		}
+		if header.FreeVersion() {
+			numVersions--
+			return nil
+		}
+		if !found {
+			// Headers are sorted newest first.
+			found = true
+			if header.VersionID != [16]byte{} {
+				versionID = uuid.UUID(header.VersionID).String()
+			}
+			modTime = time.Unix(0, header.ModTime).UTC()
+		}
+		return nil
+	}); err != nil {
+		return 0, "", time.Time{}, err
+	}
+	return numVersions, versionID, modTime, nil
+}
+
 type xlMetaV2ShallowVersion struct {
 	header xlMetaV2VersionHeader
 	meta   []byte
@@ -1471,6 +1520,17 @@ func (x *xlMetaV2) UpdateObjectVersion(fi FileInfo) error {
 				if err != nil {
 					return err
 				}
+				if ver.ObjectV2.MetaSys == nil {
+					ver.ObjectV2.MetaSys = make(map[string][]byte)
+				}
+				// When the caller sends the generation it last read,
+				// refuse the update if the on-disk generation has moved
+				// on, the caller must re-read and retry.
+				if expected, ok := fi.Metadata[metaGeneration]; ok {
+					if cur := string(ver.ObjectV2.MetaSys[metaGeneration]); cur != "" && cur != expected {
+						return errMetadataStale
+					}
+				}
 				for k, v := range fi.Metadata {
 					if len(k) > len(ReservedMetadataPrefixLower) && strings.EqualFold(k[:len(ReservedMetadataPrefixLower)], ReservedMetadataPrefixLower) {
 						ver.ObjectV2.MetaSys[k] = []byte(v)
@@ -1478,6 +1538,8 @@ func (x *xlMetaV2) UpdateObjectVersion(fi FileInfo) error {
 						ver.ObjectV2.MetaUser[k] = v
 					}
 				}
+				gen, _ := strconv.ParseUint(string(ver.ObjectV2.MetaSys[metaGeneration]), 10, 64)
+				ver.ObjectV2.MetaSys[metaGeneration] = []byte(strconv.FormatUint(gen+1, 10))
 				if !fi.ModTime.IsZero() {
 					ver.ObjectV2.ModTime = fi.ModTime.UnixNano()
 				}
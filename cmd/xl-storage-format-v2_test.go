@@ -1058,3 +1058,57 @@ func TestXMinIOHealingSkip(t *testing.T) {
 		t.Fatal("Expected fi.Healing to be false")
 	}
 }
+
+func TestStatXLVersionSummary(t *testing.T) {
+	latestVersionID := mustGetUUID()
+	fi := FileInfo{
+		Volume:    "volume",
+		Name:      "object-name",
+		VersionID: mustGetUUID(),
+		DataDir:   mustGetUUID(),
+		ModTime:   time.Now().Add(-time.Hour),
+		Erasure: ErasureInfo{
+			Algorithm:    ReedSolomon.String(),
+			DataBlocks:   4,
+			ParityBlocks: 2,
+			BlockSize:    10000,
+			Index:        1,
+			Distribution: []int{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+	}
+	var xl xlMetaV2
+	if err := xl.AddVersion(fi); err != nil {
+		t.Fatal(err)
+	}
+
+	latest := fi
+	latest.VersionID = latestVersionID
+	latest.DataDir = mustGetUUID()
+	latest.ModTime = time.Now()
+	if err := xl.AddVersion(latest); err != nil {
+		t.Fatal(err)
+	}
+
+	buf, err := xl.AppendTo(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numVersions, versionID, modTime, err := statXLVersionSummary(buf)
+	if err != nil {
+		t.Fatalf("statXLVersionSummary failed with %v", err)
+	}
+	if numVersions != 2 {
+		t.Errorf("Expected 2 versions, got %d", numVersions)
+	}
+	if versionID != latestVersionID {
+		t.Errorf("Expected latest version %s, got %s", latestVersionID, versionID)
+	}
+	if !modTime.Equal(latest.ModTime.UTC().Truncate(0)) {
+		t.Errorf("Expected latest mod time %s, got %s", latest.ModTime.UTC(), modTime)
+	}
+
+	if _, _, _, err = statXLVersionSummary([]byte("not an xl.meta buffer")); err == nil {
+		t.Error("Expected an error for a buffer that is not xl.meta")
+	}
+}
@@ -437,7 +437,7 @@ func (s *xlStorage) readMetadata(ctx context.Context, itemPath string) ([]byte,
 	return buf, err
 }
 
-func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode) (dataUsageCache, error) {
+func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache, updates chan<- dataUsageEntry, scanMode madmin.HealScanMode, scanErrs chan<- nsScannerError) (dataUsageCache, error) {
 	atomic.AddInt32(&s.scanning, 1)
 	defer atomic.AddInt32(&s.scanning, -1)
 	var err error
@@ -450,8 +450,11 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache, updates
 		stopFn(res)
 	}()
 
-	// Updates must be closed before we return.
+	// Updates and scan errors must be closed before we return.
 	defer close(updates)
+	if scanErrs != nil {
+		defer close(scanErrs)
+	}
 	var lc *lifecycle.Lifecycle
 
 	// Check if the current bucket has a configured lifecycle policy
@@ -485,6 +488,7 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache, updates
 	}
 
 	cache.Info.updates = updates
+	cache.Info.scanErrs = scanErrs
 
 	poolIdx, setIdx, _ := s.GetDiskLoc()
 
@@ -1071,10 +1075,11 @@ func (s *xlStorage) moveToTrash(filePath string, recursive, force bool) error {
 // will force creating a new `xl.meta` to create a new delete marker
 func (s *xlStorage) DeleteVersion(ctx context.Context, volume, path string, fi FileInfo, forceDelMarker bool) error {
 	if HasSuffix(path, SlashSeparator) {
-		return s.Delete(ctx, volume, path, DeleteOptions{
+		_, err := s.Delete(ctx, volume, path, DeleteOptions{
 			Recursive: false,
 			Force:     false,
 		})
+		return err
 	}
 
 	var legacyJSON bool
@@ -1211,6 +1216,16 @@ func (s *xlStorage) UpdateMetadata(ctx context.Context, volume, path string, fi
 	return s.WriteAll(ctx, volume, pathJoin(path, xlStorageFormatFile), wbuf)
 }
 
+// PatchMetadata - applies a metadata-only patch to the version at `xl.meta`,
+// only the fields carried by the patch are consulted.
+func (s *xlStorage) PatchMetadata(ctx context.Context, volume, path string, patch MetadataPatch) error {
+	return s.UpdateMetadata(ctx, volume, path, FileInfo{
+		VersionID: patch.VersionID,
+		Metadata:  patch.Metadata,
+		ModTime:   patch.ModTime,
+	})
+}
+
 // WriteMetadata - writes FileInfo metadata for path at `xl.meta`
 func (s *xlStorage) WriteMetadata(ctx context.Context, volume, path string, fi FileInfo) error {
 	if fi.Fresh {
@@ -1276,6 +1291,53 @@ func (s *xlStorage) WriteMetadata(ctx context.Context, volume, path string, fi F
 	return s.WriteAll(ctx, volume, pathJoin(path, xlStorageFormatFile), buf)
 }
 
+// metadataSignature summarizes raw `xl.meta` contents for compare-and-swap
+// writes, zero identifies a missing metadata file.
+func metadataSignature(buf []byte) uint64 {
+	if len(buf) == 0 {
+		return 0
+	}
+	return xxh3.Hash(buf)
+}
+
+// WriteMetadataIf - writes FileInfo metadata for path at `xl.meta` only when
+// the current on-disk contents still match expectedSig, as computed by
+// metadataSignature over the raw `xl.meta` bytes. A zero signature requires
+// that no metadata exists yet. errMetadataStale is returned on mismatch,
+// callers re-read and retry.
+func (s *xlStorage) WriteMetadataIf(ctx context.Context, volume, path string, fi FileInfo, expectedSig uint64) error {
+	buf, err := s.ReadAll(ctx, volume, pathJoin(path, xlStorageFormatFile))
+	if err != nil && err != errFileNotFound {
+		return err
+	}
+	defer metaDataPoolPut(buf)
+
+	if metadataSignature(buf) != expectedSig {
+		return errMetadataStale
+	}
+
+	var xlMeta xlMetaV2
+	if isXL2V1Format(buf) {
+		if err = xlMeta.Load(buf); err != nil {
+			logger.LogIf(ctx, err)
+			return err
+		}
+	}
+	if err = xlMeta.AddVersion(fi); err != nil {
+		logger.LogIf(ctx, err)
+		return err
+	}
+
+	wbuf, err := xlMeta.AppendTo(metaDataPoolGet())
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return err
+	}
+	defer metaDataPoolPut(wbuf)
+
+	return s.WriteAll(ctx, volume, pathJoin(path, xlStorageFormatFile), wbuf)
+}
+
 func (s *xlStorage) renameLegacyMetadata(volumeDir, path string) (err error) {
 	s.RLock()
 	legacy := s.formatLegacy
@@ -1571,6 +1633,81 @@ func (s *xlStorage) ReadAll(ctx context.Context, volume string, path string) (bu
 	return buf, err
 }
 
+// ReadAllAt reads up to length bytes of the file at path starting at
+// offset, returning the bytes read together with the total file size.
+// Unlike ReadFile no bitrot verification is performed, the method is
+// meant for config and journal style files where the caller only
+// needs a header or tail. Reads starting at or beyond the end of the
+// file return io.EOF, same as ReadAt on a local file.
+func (s *xlStorage) ReadAllAt(ctx context.Context, volume string, path string, offset, length int64) (buf []byte, size int64, err error) {
+	if offset < 0 || length < 0 {
+		return nil, 0, errInvalidArgument
+	}
+
+	volumeDir, err := s.getVolDir(volume)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Stat a volume entry.
+	if err = Access(volumeDir); err != nil {
+		return nil, 0, convertAccessError(err, errFileAccessDenied)
+	}
+
+	// Validate effective path length before reading.
+	filePath := pathJoin(volumeDir, path)
+	if err = checkPathLength(filePath); err != nil {
+		return nil, 0, err
+	}
+
+	// Open the file for reading.
+	file, err := OpenFile(filePath, readMode, 0o666)
+	if err != nil {
+		switch {
+		case osIsNotExist(err):
+			return nil, 0, errFileNotFound
+		case osIsPermission(err):
+			return nil, 0, errFileAccessDenied
+		case isSysErrNotDir(err):
+			return nil, 0, errFileAccessDenied
+		case isSysErrIO(err):
+			return nil, 0, errFaultyDisk
+		case isSysErrTooManyFiles(err):
+			return nil, 0, errTooManyOpenFiles
+		default:
+			return nil, 0, err
+		}
+	}
+
+	// Close the file descriptor.
+	defer file.Close()
+
+	st, err := file.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Verify it is a regular file, otherwise subsequent reads are
+	// undefined.
+	if !st.Mode().IsRegular() {
+		return nil, 0, errIsNotRegular
+	}
+
+	size = st.Size()
+	if offset >= size && length > 0 {
+		return nil, size, io.EOF
+	}
+	if max := size - offset; length > max {
+		length = max
+	}
+	buf = make([]byte, length)
+	n, err := file.ReadAt(buf, offset)
+	if err != nil && err != io.EOF {
+		return nil, size, err
+	}
+	return buf[:n], size, nil
+}
+
 // ReadFile reads exactly len(buf) bytes into buf. It returns the
 // number of bytes copied. The error is EOF only if no bytes were
 // read. On return, n == len(buf) if and only if err == nil. n == 0
@@ -2010,41 +2147,53 @@ func (s *xlStorage) AppendFile(ctx context.Context, volume string, path string,
 	return nil
 }
 
-// CheckParts check if path has necessary parts available.
-func (s *xlStorage) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) error {
+// CheckParts check if path has necessary parts available. Every part is
+// checked even after the first bad one so the report covers the whole
+// object, the returned error is the pass/fail view of the report.
+func (s *xlStorage) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (*CheckPartsResp, error) {
 	volumeDir, err := s.getVolDir(volume)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Stat a volume entry.
 	if err = Access(volumeDir); err != nil {
 		if osIsNotExist(err) {
-			return errVolumeNotFound
+			return nil, errVolumeNotFound
 		}
-		return err
+		return nil, err
 	}
 
-	for _, part := range fi.Parts {
+	resp := CheckPartsResp{Results: make([]int, len(fi.Parts))}
+	for i, part := range fi.Parts {
 		partPath := pathJoin(path, fi.DataDir, fmt.Sprintf("part.%d", part.Number))
 		filePath := pathJoin(volumeDir, partPath)
 		if err = checkPathLength(filePath); err != nil {
-			return err
+			return nil, err
 		}
 		st, err := Lstat(filePath)
 		if err != nil {
-			return osErrToFileErr(err)
+			if ferr := osErrToFileErr(err); ferr != errFileNotFound {
+				// A disk level failure, not a missing part, the
+				// per-part report would be meaningless.
+				return nil, ferr
+			}
+			resp.Results[i] = checkPartFileNotFound
+			continue
 		}
 		if st.Mode().IsDir() {
-			return errFileNotFound
+			resp.Results[i] = checkPartFileNotFound
+			continue
 		}
 		// Check if shard is truncated.
 		if st.Size() < fi.Erasure.ShardFileSize(part.Size) {
-			return errFileCorrupt
+			resp.Results[i] = checkPartFileCorrupt
+			continue
 		}
+		resp.Results[i] = checkPartSuccess
 	}
 
-	return nil
+	return &resp, resp.Err()
 }
 
 // deleteFile deletes a file or a directory if its empty unless recursive
@@ -2104,26 +2253,50 @@ func (s *xlStorage) deleteFile(basePath, deletePath string, recursive, force boo
 }
 
 // DeleteFile - delete a file at path.
-func (s *xlStorage) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (err error) {
+func (s *xlStorage) Delete(ctx context.Context, volume string, path string, deleteOpts DeleteOptions) (stats DeleteStats, err error) {
 	volumeDir, err := s.getVolDir(volume)
 	if err != nil {
-		return err
+		return DeleteStats{}, err
 	}
 
 	// Stat a volume entry.
 	if err = Access(volumeDir); err != nil {
-		return convertAccessError(err, errVolumeAccessDenied)
+		return DeleteStats{}, convertAccessError(err, errVolumeAccessDenied)
 	}
 
 	// Following code is needed so that we retain SlashSeparator suffix if any in
 	// path argument.
 	filePath := pathJoin(volumeDir, path)
 	if err = checkPathLength(filePath); err != nil {
-		return err
+		return DeleteStats{}, err
+	}
+
+	if deleteOpts.ReportStats {
+		// Record the size upfront, the file is gone once deleteFile
+		// returns. Directories report zero.
+		if st, serr := Lstat(filePath); serr == nil && st.Mode().IsRegular() {
+			stats.BytesFreed = st.Size()
+		}
 	}
 
 	// Delete file and delete parent directory as well if it's empty.
-	return s.deleteFile(volumeDir, filePath, deleteOpts.Recursive, deleteOpts.Force)
+	if err = s.deleteFile(volumeDir, filePath, deleteOpts.Recursive, deleteOpts.Force); err != nil {
+		return DeleteStats{}, err
+	}
+
+	if deleteOpts.ReportStats {
+		parentDir := pathutil.Dir(pathutil.Clean(filePath))
+		if parentDir != pathutil.Clean(volumeDir) {
+			if serr := Access(parentDir); osIsNotExist(serr) {
+				// deleteFile already pruned the empty parent.
+				stats.ParentEmpty = true
+			} else if serr == nil {
+				stats.ParentEmpty = isDirEmpty(parentDir)
+			}
+		}
+	}
+
+	return stats, nil
 }
 
 func skipAccessChecks(volume string) (ok bool) {
@@ -2547,6 +2720,16 @@ func (s *xlStorage) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolum
 	return nil
 }
 
+// RenameFiles - renames a list of source paths to their destination
+// paths, errors are reported per pair.
+func (s *xlStorage) RenameFiles(ctx context.Context, pairs []RenameFilePair) []error {
+	errs := make([]error, len(pairs))
+	for i, pair := range pairs {
+		errs[i] = s.RenameFile(ctx, pair.SrcVolume, pair.SrcPath, pair.DstVolume, pair.DstPath)
+	}
+	return errs
+}
+
 func (s *xlStorage) bitrotVerify(ctx context.Context, partPath string, partSize int64, algo BitrotAlgorithm, sum []byte, shardSize int64) error {
 	// Open the file for reading.
 	file, err := OpenFile(partPath, readMode, 0o666)
@@ -2692,13 +2875,26 @@ func (s *xlStorage) StatInfoFile(ctx context.Context, volume, path string, glob
 		if err != nil {
 			name = filePath
 		}
-		stat = append(stat, StatInfo{
+		si := StatInfo{
 			Name:    filepath.ToSlash(name),
 			Size:    st.Size(),
 			Dir:     st.IsDir(),
 			Mode:    uint32(st.Mode()),
 			ModTime: st.ModTime(),
-		})
+		}
+		if !si.Dir && filepath.Base(filePath) == xlStorageFormatFile {
+			// Carry version details when they are cheaply readable from
+			// the metadata headers, callers then do not need a ReadVersion
+			// round trip per matched entry.
+			if buf, rerr := xioutil.ReadFile(filePath); rerr == nil {
+				if nv, vid, mt, verr := statXLVersionSummary(buf); verr == nil {
+					si.NumVersions = nv
+					si.LatestVersionID = vid
+					si.LatestModTime = mt
+				}
+			}
+		}
+		stat = append(stat, si)
 	}
 	return stat, nil
 }
@@ -2706,30 +2902,31 @@ func (s *xlStorage) StatInfoFile(ctx context.Context, volume, path string, glob
 // CleanAbandonedData will read metadata of the object on disk
 // and delete any data directories and inline data that isn't referenced in metadata.
 // Metadata itself is not modified, only inline data.
-func (s *xlStorage) CleanAbandonedData(ctx context.Context, volume string, path string) error {
+// Returns a summary of how many data directories and bytes were reclaimed.
+func (s *xlStorage) CleanAbandonedData(ctx context.Context, volume string, path string) (res CleanAbandonedDataResp, err error) {
 	if volume == "" || path == "" {
-		return nil // Ignore
+		return res, nil // Ignore
 	}
 
 	volumeDir, err := s.getVolDir(volume)
 	if err != nil {
-		return err
+		return res, err
 	}
 	baseDir := pathJoin(volumeDir, path+slashSeparator)
 	metaPath := pathutil.Join(baseDir, xlStorageFormatFile)
 	buf, _, err := s.readAllData(ctx, volumeDir, metaPath)
 	if err != nil {
-		return err
+		return res, err
 	}
 	defer metaDataPoolPut(buf)
 
 	if !isXL2V1Format(buf) {
-		return nil
+		return res, nil
 	}
 	var xl xlMetaV2
 	err = xl.LoadOrConvert(buf)
 	if err != nil {
-		return err
+		return res, err
 	}
 	foundDirs := make(map[string]struct{}, len(xl.versions))
 	err = readDirFn(baseDir, func(name string, typ os.FileMode) error {
@@ -2745,11 +2942,11 @@ func (s *xlStorage) CleanAbandonedData(ctx context.Context, volume string, path
 		return nil
 	})
 	if err != nil {
-		return err
+		return res, err
 	}
 	wantDirs, err := xl.getDataDirs()
 	if err != nil {
-		return err
+		return res, err
 	}
 
 	// Delete all directories we expect to be there.
@@ -2761,14 +2958,19 @@ func (s *xlStorage) CleanAbandonedData(ctx context.Context, volume string, path
 	// Do not abort on context errors.
 	for dir := range foundDirs {
 		toRemove := pathJoin(volumeDir, path, dir+SlashSeparator)
+		reclaimed := s.dirUsage(toRemove)
 		err := s.deleteFile(volumeDir, toRemove, true, true)
 		diskHealthCheckOK(ctx, err)
+		if err == nil {
+			res.DataDirs++
+			res.BytesReclaimed += reclaimed
+		}
 	}
 
 	// Do the same for inline data
 	dirs, err := xl.data.list()
 	if err != nil {
-		return err
+		return res, err
 	}
 	// Clear and repopulate
 	for k := range foundDirs {
@@ -2789,16 +2991,33 @@ func (s *xlStorage) CleanAbandonedData(ctx context.Context, volume string, path
 		dirs = dirs[:0]
 		for dir := range foundDirs {
 			dirs = append(dirs, dir)
+			res.BytesReclaimed += uint64(len(xl.data.find(dir)))
 		}
 		if xl.data.remove(dirs...) {
+			res.DataDirs += len(dirs)
 			newBuf, err := xl.AppendTo(metaDataPoolGet())
 			if err == nil {
 				defer metaDataPoolPut(newBuf)
-				return s.writeAll(ctx, volume, pathJoin(path, xlStorageFormatFile), buf, false)
+				return res, s.writeAll(ctx, volume, pathJoin(path, xlStorageFormatFile), buf, false)
 			}
 		}
 	}
-	return nil
+	return res, nil
+}
+
+// dirUsage returns the total size of regular files below dir.
+func (s *xlStorage) dirUsage(dir string) (usage uint64) {
+	readDirFn(dir, func(name string, typ os.FileMode) error {
+		if typ.IsDir() {
+			usage += s.dirUsage(pathJoin(dir, name))
+			return nil
+		}
+		if st, err := os.Lstat(pathJoin(dir, name)); err == nil {
+			usage += uint64(st.Size())
+		}
+		return nil
+	})
+	return usage
 }
 
 func convertAccessError(err, permErr error) error {
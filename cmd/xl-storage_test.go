@@ -886,7 +886,7 @@ func TestXLStorageListDir(t *testing.T) {
 			t.Fatalf("Unable to initialize xlStorage, %s", err)
 		}
 
-		if err = xlStorageNew.Delete(context.Background(), "mybucket", "myobject", DeleteOptions{
+		if _, err = xlStorageNew.Delete(context.Background(), "mybucket", "myobject", DeleteOptions{
 			Recursive: false,
 			Force:     false,
 		}); err != errVolumeAccessDenied {
@@ -896,7 +896,7 @@ func TestXLStorageListDir(t *testing.T) {
 
 	// TestXLStorage for delete on an removed disk.
 	// should fail with disk not found.
-	err = xlStorageDeletedStorage.Delete(context.Background(), "del-vol", "my-file", DeleteOptions{
+	_, err = xlStorageDeletedStorage.Delete(context.Background(), "del-vol", "my-file", DeleteOptions{
 		Recursive: false,
 		Force:     false,
 	})
@@ -990,7 +990,7 @@ func TestXLStorageDeleteFile(t *testing.T) {
 	}
 
 	for i, testCase := range testCases {
-		if err = xlStorage.Delete(context.Background(), testCase.srcVol, testCase.srcPath, DeleteOptions{
+		if _, err = xlStorage.Delete(context.Background(), testCase.srcVol, testCase.srcPath, DeleteOptions{
 			Recursive: false,
 			Force:     false,
 		}); err != testCase.expectedErr {
@@ -1017,7 +1017,7 @@ func TestXLStorageDeleteFile(t *testing.T) {
 			t.Fatalf("Unable to initialize xlStorage, %s", err)
 		}
 
-		if err = xlStorageNew.Delete(context.Background(), "mybucket", "myobject", DeleteOptions{
+		if _, err = xlStorageNew.Delete(context.Background(), "mybucket", "myobject", DeleteOptions{
 			Recursive: false,
 			Force:     false,
 		}); err != errVolumeAccessDenied {
@@ -1038,7 +1038,7 @@ func TestXLStorageDeleteFile(t *testing.T) {
 
 	// TestXLStorage for delete on an removed disk.
 	// should fail with disk not found.
-	err = xlStorageDeletedStorage.Delete(context.Background(), "del-vol", "my-file", DeleteOptions{
+	_, err = xlStorageDeletedStorage.Delete(context.Background(), "del-vol", "my-file", DeleteOptions{
 		Recursive: false,
 		Force:     false,
 	})
@@ -1864,7 +1864,7 @@ func TestXLStorageVerifyFile(t *testing.T) {
 		t.Fatal("expected to fail bitrot check")
 	}
 
-	if err := storage.Delete(context.Background(), volName, fileName, DeleteOptions{
+	if _, err := storage.Delete(context.Background(), volName, fileName, DeleteOptions{
 		Recursive: false,
 		Force:     false,
 	}); err != nil {
@@ -80,6 +80,11 @@ type BucketSSEConfig struct {
 	XMLNS   string   `xml:"xmlns,attr,omitempty"`
 	XMLName xml.Name `xml:"ServerSideEncryptionConfiguration"`
 	Rules   []Rule   `xml:"Rule"`
+
+	// Enforce is a MinIO extension: when set, uploads whose encryption
+	// headers conflict with this configuration are rejected instead of
+	// being silently rewritten to the bucket default.
+	Enforce bool `xml:"Enforce,omitempty"`
 }
 
 // ParseBucketSSEConfig - Decodes given XML to a valid default bucket encryption config
@@ -152,6 +157,12 @@ func (b *BucketSSEConfig) Apply(headers http.Header, opts ApplyOptions) {
 	}
 }
 
+// Enforced returns true when the configuration demands that conflicting
+// encryption headers are rejected instead of rewritten.
+func (b *BucketSSEConfig) Enforced() bool {
+	return b != nil && b.Enforce
+}
+
 // Algo returns the SSE algorithm specified by the SSE configuration.
 func (b *BucketSSEConfig) Algo() Algorithm {
 	for _, rule := range b.Rules {
@@ -68,6 +68,22 @@ func TestParseBucketSSEConfig(t *testing.T) {
 		},
 	}
 
+	actualEnforcedKMSConfig := &BucketSSEConfig{
+		XMLNS: xmlNS,
+		XMLName: xml.Name{
+			Local: "ServerSideEncryptionConfiguration",
+		},
+		Rules: []Rule{
+			{
+				DefaultEncryptionAction: EncryptionAction{
+					Algorithm:   AWSKms,
+					MasterKeyID: "arn:aws:kms:my-minio-key",
+				},
+			},
+		},
+		Enforce: true,
+	}
+
 	testCases := []struct {
 		inputXML       string
 		keyID          string
@@ -127,6 +143,14 @@ func TestParseBucketSSEConfig(t *testing.T) {
 			expectedErr: errors.New("MasterKeyID contains unsupported characters"),
 			shouldPass:  false,
 		},
+		// 9. Valid XML SSE-KMS with the MinIO enforcement extension
+		{
+			inputXML:       `<ServerSideEncryptionConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>aws:kms</SSEAlgorithm><KMSMasterKeyID>arn:aws:kms:my-minio-key</KMSMasterKeyID></ApplyServerSideEncryptionByDefault></Rule><Enforce>true</Enforce></ServerSideEncryptionConfiguration>`,
+			expectedErr:    nil,
+			shouldPass:     true,
+			expectedConfig: actualEnforcedKMSConfig,
+			keyID:          "my-minio-key",
+		},
 	}
 
 	for i, tc := range testCases {
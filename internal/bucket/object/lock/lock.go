@@ -218,6 +218,15 @@ func (dr *DefaultRetention) UnmarshalXML(d *xml.Decoder, start xml.StartElement)
 	return nil
 }
 
+// PrefixRule - MinIO extension carrying a default retention rule scoped
+// to objects whose name starts with Prefix. Standard S3 clients never
+// send or receive this element.
+type PrefixRule struct {
+	XMLName          xml.Name         `xml:"PrefixRule"`
+	Prefix           string           `xml:"Prefix"`
+	DefaultRetention DefaultRetention `xml:"DefaultRetention"`
+}
+
 // Config - object lock configuration specified in
 // https://docs.aws.amazon.com/AmazonS3/latest/API/Type_API_ObjectLockConfiguration.html
 type Config struct {
@@ -227,6 +236,7 @@ type Config struct {
 	Rule              *struct {
 		DefaultRetention DefaultRetention `xml:"DefaultRetention"`
 	} `xml:"Rule,omitempty"`
+	PrefixRules []PrefixRule `xml:"PrefixRule,omitempty"`
 }
 
 // Enabled returns true if config.ObjectLockEnabled is set to Enabled
@@ -248,17 +258,60 @@ func (config *Config) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
 		return fmt.Errorf("only 'Enabled' value is allowed to ObjectLockEnabled element")
 	}
 
+	for i := range parsedConfig.PrefixRules {
+		pr := &parsedConfig.PrefixRules[i]
+		if pr.Prefix == "" {
+			return fmt.Errorf("prefix rule must specify a non-empty Prefix")
+		}
+		if pr.DefaultRetention.Days == nil && pr.DefaultRetention.Years == nil {
+			return fmt.Errorf("prefix rule for %q must specify a DefaultRetention", pr.Prefix)
+		}
+		for _, prev := range parsedConfig.PrefixRules[:i] {
+			if strings.HasPrefix(pr.Prefix, prev.Prefix) || strings.HasPrefix(prev.Prefix, pr.Prefix) {
+				return fmt.Errorf("overlapping prefixes %q and %q in prefix rules", prev.Prefix, pr.Prefix)
+			}
+		}
+	}
+
 	*config = Config(parsedConfig)
 	return nil
 }
 
 // ToRetention - convert to Retention type.
 func (config *Config) ToRetention() Retention {
+	var dr *DefaultRetention
+	if config.Rule != nil {
+		dr = &config.Rule.DefaultRetention
+	}
+	return config.toRetention(dr)
+}
+
+// ToRetentionForObject - convert to Retention type for the given object
+// name. The prefix rule with the longest prefix matching the object name
+// wins, falling back to the bucket-wide default rule when no prefix rule
+// matches.
+func (config *Config) ToRetentionForObject(object string) Retention {
+	var dr *DefaultRetention
+	if config.Rule != nil {
+		dr = &config.Rule.DefaultRetention
+	}
+	matched := -1
+	for i := range config.PrefixRules {
+		rule := &config.PrefixRules[i]
+		if strings.HasPrefix(object, rule.Prefix) && len(rule.Prefix) > matched {
+			dr = &rule.DefaultRetention
+			matched = len(rule.Prefix)
+		}
+	}
+	return config.toRetention(dr)
+}
+
+func (config *Config) toRetention(dr *DefaultRetention) Retention {
 	r := Retention{
 		LockEnabled: config.ObjectLockEnabled == Enabled,
 	}
-	if config.Rule != nil {
-		r.Mode = config.Rule.DefaultRetention.Mode
+	if dr != nil {
+		r.Mode = dr.Mode
 
 		t, err := UTCNowNTP()
 		if err != nil {
@@ -268,10 +321,10 @@ func (config *Config) ToRetention() Retention {
 			return r
 		}
 
-		if config.Rule.DefaultRetention.Days != nil {
-			r.Validity = t.AddDate(0, 0, int(*config.Rule.DefaultRetention.Days)).Sub(t)
+		if dr.Days != nil {
+			r.Validity = t.AddDate(0, 0, int(*dr.Days)).Sub(t)
 		} else {
-			r.Validity = t.AddDate(int(*config.Rule.DefaultRetention.Years), 0, 0).Sub(t)
+			r.Validity = t.AddDate(int(*dr.Years), 0, 0).Sub(t)
 		}
 	}
 
@@ -172,6 +172,26 @@ func TestParseObjectLockConfig(t *testing.T) {
 			expectedErr: nil,
 			expectErr:   false,
 		},
+		{
+			value:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><PrefixRule><Prefix>records/</Prefix><DefaultRetention><Mode>COMPLIANCE</Mode><Years>7</Years></DefaultRetention></PrefixRule><PrefixRule><Prefix>staging/</Prefix><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></PrefixRule></ObjectLockConfiguration>`,
+			expectedErr: nil,
+			expectErr:   false,
+		},
+		{
+			value:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><PrefixRule><Prefix></Prefix><DefaultRetention><Mode>COMPLIANCE</Mode><Days>30</Days></DefaultRetention></PrefixRule></ObjectLockConfiguration>`,
+			expectedErr: fmt.Errorf("prefix rule must specify a non-empty Prefix"),
+			expectErr:   true,
+		},
+		{
+			value:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><PrefixRule><Prefix>records/</Prefix><DefaultRetention><Mode>COMPLIANCE</Mode><Years>7</Years></DefaultRetention></PrefixRule><PrefixRule><Prefix>records/2020/</Prefix><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></PrefixRule></ObjectLockConfiguration>`,
+			expectedErr: fmt.Errorf("overlapping prefixes \"records/\" and \"records/2020/\" in prefix rules"),
+			expectErr:   true,
+		},
+		{
+			value:       `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><PrefixRule><Prefix>records/</Prefix></PrefixRule></ObjectLockConfiguration>`,
+			expectedErr: fmt.Errorf("prefix rule for \"records/\" must specify a DefaultRetention"),
+			expectErr:   true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -191,6 +211,38 @@ func TestParseObjectLockConfig(t *testing.T) {
 	}
 }
 
+func TestToRetentionForObject(t *testing.T) {
+	value := `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><ObjectLockEnabled>Enabled</ObjectLockEnabled><Rule><DefaultRetention><Mode>GOVERNANCE</Mode><Days>1</Days></DefaultRetention></Rule><PrefixRule><Prefix>records/</Prefix><DefaultRetention><Mode>COMPLIANCE</Mode><Years>7</Years></DefaultRetention></PrefixRule><PrefixRule><Prefix>tmp/</Prefix><DefaultRetention><Mode>GOVERNANCE</Mode><Days>30</Days></DefaultRetention></PrefixRule></ObjectLockConfiguration>`
+	config, err := ParseObjectLockConfig(strings.NewReader(value))
+	if err != nil {
+		t.Fatalf("error: expected = <nil>, got = %v", err)
+	}
+	tests := []struct {
+		object       string
+		expectedMode RetMode
+	}{
+		{object: "records/ledger.csv", expectedMode: RetCompliance},
+		{object: "tmp/scratch.bin", expectedMode: RetGovernance},
+		{object: "other/object", expectedMode: RetGovernance},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.object, func(t *testing.T) {
+			r := config.ToRetentionForObject(tt.object)
+			if !r.LockEnabled {
+				t.Fatal("expected lock to be enabled")
+			}
+			if r.Mode != tt.expectedMode {
+				t.Fatalf("mode: expected = %v, got = %v", tt.expectedMode, r.Mode)
+			}
+		})
+	}
+	// Objects not matching any prefix rule inherit the bucket-wide rule.
+	if got, want := config.ToRetentionForObject("other/object"), config.ToRetention(); got != want {
+		t.Fatalf("retention: expected = %v, got = %v", want, got)
+	}
+}
+
 func TestParseObjectRetention(t *testing.T) {
 	tests := []struct {
 		value       string
@@ -46,6 +46,11 @@ const (
 	apiDisableODirect              = "disable_odirect"
 	apiGzipObjects                 = "gzip_objects"
 	apiRootAccess                  = "root_access"
+	apiFanOutConcurrency           = "fan_out_concurrency"
+	apiStorageMetadataDeadline     = "storage_metadata_deadline"
+	apiStorageSmallReadDeadline    = "storage_small_read_deadline"
+	apiStorageStreamReadDeadline   = "storage_stream_read_deadline"
+	apiStorageWriteDeadline        = "storage_write_deadline"
 
 	EnvAPIRequestsMax             = "MINIO_API_REQUESTS_MAX"
 	EnvAPIRequestsDeadline        = "MINIO_API_REQUESTS_DEADLINE"
@@ -63,6 +68,11 @@ const (
 	EnvAPIDisableODirect              = "MINIO_API_DISABLE_ODIRECT"
 	EnvAPIGzipObjects                 = "MINIO_API_GZIP_OBJECTS"
 	EnvAPIRootAccess                  = "MINIO_API_ROOT_ACCESS" // default "on"
+	EnvAPIFanOutConcurrency           = "MINIO_API_FAN_OUT_CONCURRENCY"
+	EnvAPIStorageMetadataDeadline     = "MINIO_API_STORAGE_METADATA_DEADLINE"
+	EnvAPIStorageSmallReadDeadline    = "MINIO_API_STORAGE_SMALL_READ_DEADLINE"
+	EnvAPIStorageStreamReadDeadline   = "MINIO_API_STORAGE_STREAM_READ_DEADLINE"
+	EnvAPIStorageWriteDeadline        = "MINIO_API_STORAGE_WRITE_DEADLINE"
 )
 
 // Deprecated key and ENVs
@@ -136,6 +146,26 @@ var (
 			Key:   apiRootAccess,
 			Value: "on",
 		},
+		config.KV{
+			Key:   apiFanOutConcurrency,
+			Value: "0",
+		},
+		config.KV{
+			Key:   apiStorageMetadataDeadline,
+			Value: "0s",
+		},
+		config.KV{
+			Key:   apiStorageSmallReadDeadline,
+			Value: "0s",
+		},
+		config.KV{
+			Key:   apiStorageStreamReadDeadline,
+			Value: "0s",
+		},
+		config.KV{
+			Key:   apiStorageWriteDeadline,
+			Value: "0s",
+		},
 	}
 )
 
@@ -155,6 +185,15 @@ type Config struct {
 	DisableODirect              bool          `json:"disable_odirect"`
 	GzipObjects                 bool          `json:"gzip_objects"`
 	RootAccess                  bool          `json:"root_access"`
+	FanOutConcurrency           int           `json:"fan_out_concurrency"`
+
+	// Per method-class deadlines for storage REST calls, applied when
+	// the caller's context carries no deadline. Zero disables the
+	// class deadline.
+	StorageMetadataDeadline   time.Duration `json:"storage_metadata_deadline"`
+	StorageSmallReadDeadline  time.Duration `json:"storage_small_read_deadline"`
+	StorageStreamReadDeadline time.Duration `json:"storage_stream_read_deadline"`
+	StorageWriteDeadline      time.Duration `json:"storage_write_deadline"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -252,6 +291,35 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		return cfg, err
 	}
 
+	fanOutConcurrency, err := strconv.Atoi(env.Get(EnvAPIFanOutConcurrency, kvs.GetWithDefault(apiFanOutConcurrency, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+
+	if fanOutConcurrency < 0 {
+		return cfg, errors.New("invalid API fan-out concurrency value")
+	}
+
+	storageMetadataDeadline, err := time.ParseDuration(env.Get(EnvAPIStorageMetadataDeadline, kvs.GetWithDefault(apiStorageMetadataDeadline, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+
+	storageSmallReadDeadline, err := time.ParseDuration(env.Get(EnvAPIStorageSmallReadDeadline, kvs.GetWithDefault(apiStorageSmallReadDeadline, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+
+	storageStreamReadDeadline, err := time.ParseDuration(env.Get(EnvAPIStorageStreamReadDeadline, kvs.GetWithDefault(apiStorageStreamReadDeadline, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+
+	storageWriteDeadline, err := time.ParseDuration(env.Get(EnvAPIStorageWriteDeadline, kvs.GetWithDefault(apiStorageWriteDeadline, DefaultKVS)))
+	if err != nil {
+		return cfg, err
+	}
+
 	disableODirect := env.Get(EnvAPIDisableODirect, kvs.Get(apiDisableODirect)) == config.EnableOn
 	gzipObjects := env.Get(EnvAPIGzipObjects, kvs.Get(apiGzipObjects)) == config.EnableOn
 	rootAccess := env.Get(EnvAPIRootAccess, kvs.Get(apiRootAccess)) == config.EnableOn
@@ -271,5 +339,10 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		DisableODirect:              disableODirect,
 		GzipObjects:                 gzipObjects,
 		RootAccess:                  rootAccess,
+		FanOutConcurrency:           fanOutConcurrency,
+		StorageMetadataDeadline:     storageMetadataDeadline,
+		StorageSmallReadDeadline:    storageSmallReadDeadline,
+		StorageStreamReadDeadline:   storageStreamReadDeadline,
+		StorageWriteDeadline:        storageWriteDeadline,
 	}, nil
 }
@@ -104,5 +104,35 @@ var (
 			Optional:    true,
 			Type:        "boolean",
 		},
+		config.HelpKV{
+			Key:         apiFanOutConcurrency,
+			Description: `set the concurrency used per fan-out upload, defaults to min(100, GOMAXPROCS) when '0'` + defaultHelpPostfix(apiFanOutConcurrency),
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         apiStorageMetadataDeadline,
+			Description: `set the deadline for internode storage metadata operations, '0s' leaves them unbounded` + defaultHelpPostfix(apiStorageMetadataDeadline),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         apiStorageSmallReadDeadline,
+			Description: `set the deadline for small internode storage reads such as disk info, '0s' leaves them unbounded` + defaultHelpPostfix(apiStorageSmallReadDeadline),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         apiStorageStreamReadDeadline,
+			Description: `set the deadline for streaming internode storage reads, '0s' leaves them unbounded` + defaultHelpPostfix(apiStorageStreamReadDeadline),
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         apiStorageWriteDeadline,
+			Description: `set the deadline for internode storage writes, '0s' leaves them unbounded` + defaultHelpPostfix(apiStorageWriteDeadline),
+			Optional:    true,
+			Type:        "duration",
+		},
 	}
 )
@@ -40,6 +40,14 @@ var ErrDomainMissing = errors.New("domain is missing")
 
 const etcdPathSeparator = "/"
 
+// DNS labels are case-insensitive, bucket names containing uppercase
+// letters (legal in legacy buckets) must map onto the same records
+// regardless of how callers case the name. All key construction funnels
+// through this helper so Get/Put/Delete agree on the stored key.
+func normalizeBucketDNS(bucket string) string {
+	return strings.ToLower(bucket)
+}
+
 // create a new coredns service record for the bucket.
 func newCoreDNSMsg(ip string, port string, ttl uint32, t time.Time) ([]byte, error) {
 	return json.Marshal(&SrvRecord{
@@ -77,6 +85,7 @@ func (c *CoreDNS) List() (map[string][]SrvRecord, error) {
 
 // Get - Retrieves DNS records for a bucket.
 func (c *CoreDNS) Get(bucket string) ([]SrvRecord, error) {
+	bucket = normalizeBucketDNS(bucket)
 	var srvRecords []SrvRecord
 	for _, domainName := range c.domainNames {
 		key := msg.Path(fmt.Sprintf("%s.%s.", bucket, domainName), c.prefixPath)
@@ -163,6 +172,7 @@ func (c *CoreDNS) list(key string, domain bool) ([]SrvRecord, error) {
 
 // Put - Adds DNS entries into etcd endpoint in CoreDNS etcd message format.
 func (c *CoreDNS) Put(bucket string) error {
+	bucket = normalizeBucketDNS(bucket)
 	c.Delete(bucket) // delete any existing entries.
 
 	t := time.Now().UTC()
@@ -190,6 +200,7 @@ func (c *CoreDNS) Put(bucket string) error {
 
 // Delete - Removes DNS entries added in Put().
 func (c *CoreDNS) Delete(bucket string) error {
+	bucket = normalizeBucketDNS(bucket)
 	for _, domainName := range c.domainNames {
 		key := msg.Path(fmt.Sprintf("%s.%s.", bucket, domainName), c.prefixPath)
 		ctx, cancel := context.WithTimeout(context.Background(), defaultContextTimeout)
@@ -205,7 +216,7 @@ func (c *CoreDNS) Delete(bucket string) error {
 // DeleteRecord - Removes a specific DNS entry
 func (c *CoreDNS) DeleteRecord(record SrvRecord) error {
 	for _, domainName := range c.domainNames {
-		key := msg.Path(fmt.Sprintf("%s.%s.", record.Key, domainName), c.prefixPath)
+		key := msg.Path(fmt.Sprintf("%s.%s.", normalizeBucketDNS(record.Key), domainName), c.prefixPath)
 
 		ctx, cancel := context.WithTimeout(context.Background(), defaultContextTimeout)
 		_, err := c.etcdClient.Delete(ctx, key+etcdPathSeparator+record.Host)
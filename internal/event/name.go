@@ -59,6 +59,7 @@ const (
 	ObjectTransitionComplete
 	ObjectManyVersions
 	PrefixManyFolders
+	ObjectScanQuarantined
 
 	objectSingleTypesEnd
 	// Start Compound types that require expansion:
@@ -214,6 +215,8 @@ func (name Name) String() string {
 		return "s3:Scanner:ManyVersions"
 	case PrefixManyFolders:
 		return "s3:Scanner:BigPrefix"
+	case ObjectScanQuarantined:
+		return "s3:ObjectScan:Quarantined"
 	}
 
 	return ""
@@ -330,6 +333,8 @@ func ParseName(s string) (Name, error) {
 		return ObjectManyVersions, nil
 	case "s3:Scanner:BigPrefix":
 		return PrefixManyFolders, nil
+	case "s3:ObjectScan:Quarantined":
+		return ObjectScanQuarantined, nil
 	default:
 		return 0, &ErrInvalidEventName{s}
 	}
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 const (
@@ -58,6 +59,13 @@ type TargetStat struct {
 	CurrentQueue int // Populated if target has a store.
 }
 
+// TargetCheck is the cached result of the most recent connectivity check
+// performed against a target.
+type TargetCheck struct {
+	LastCheck time.Time
+	Active    bool
+}
+
 // TargetList - holds list of targets indexed by target ID.
 type TargetList struct {
 	// The number of concurrent async Send calls to all targets
@@ -65,6 +73,7 @@ type TargetList struct {
 
 	sync.RWMutex
 	targets map[TargetID]Target
+	checks  map[TargetID]TargetCheck
 }
 
 // Add - adds unique target to target list.
@@ -82,6 +91,34 @@ func (list *TargetList) Add(targets ...Target) error {
 	return nil
 }
 
+// CheckActive - performs a connectivity check against the target with the
+// given ID and caches the outcome along with the check time.
+func (list *TargetList) CheckActive(id TargetID) (bool, error) {
+	list.RLock()
+	target, ok := list.targets[id]
+	list.RUnlock()
+
+	if !ok {
+		return false, fmt.Errorf("target %v not found", id)
+	}
+
+	active, err := target.IsActive()
+	list.Lock()
+	list.checks[id] = TargetCheck{LastCheck: time.Now(), Active: active && err == nil}
+	list.Unlock()
+	return active, err
+}
+
+// LastCheck - returns the cached result of the most recent connectivity
+// check against the target, ok is false if it was never checked.
+func (list *TargetList) LastCheck(id TargetID) (check TargetCheck, ok bool) {
+	list.RLock()
+	defer list.RUnlock()
+
+	check, ok = list.checks[id]
+	return check, ok
+}
+
 // Exists - checks whether target by target ID exists or not.
 func (list *TargetList) Exists(id TargetID) bool {
 	list.RLock()
@@ -214,5 +251,8 @@ func (list *TargetList) Stats() TargetStats {
 
 // NewTargetList - creates TargetList.
 func NewTargetList() *TargetList {
-	return &TargetList{targets: make(map[TargetID]Target)}
+	return &TargetList{
+		targets: make(map[TargetID]Target),
+		checks:  make(map[TargetID]TargetCheck),
+	}
 }
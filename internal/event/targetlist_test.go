@@ -264,3 +264,33 @@ func TestNewTargetList(t *testing.T) {
 		t.Fatalf("test: result: expected: <non-nil>, got: <nil>")
 	}
 }
+
+func TestTargetListCheckActive(t *testing.T) {
+	targetList := NewTargetList()
+	if err := targetList.Add(&ExampleTarget{TargetID{"1", "webhook"}, false, false}); err != nil {
+		panic(err)
+	}
+
+	if _, err := targetList.CheckActive(TargetID{"2", "webhook"}); err == nil {
+		t.Fatalf("test: error: expected: <non-nil>, got: <nil>")
+	}
+	if _, ok := targetList.LastCheck(TargetID{"2", "webhook"}); ok {
+		t.Fatalf("test: ok: expected: false, got: true")
+	}
+
+	// ExampleTarget.IsActive always fails, the failed check must be cached.
+	active, err := targetList.CheckActive(TargetID{"1", "webhook"})
+	if active || err == nil {
+		t.Fatalf("test: result: expected: false, <non-nil>, got: %v, %v", active, err)
+	}
+	check, ok := targetList.LastCheck(TargetID{"1", "webhook"})
+	if !ok {
+		t.Fatalf("test: ok: expected: true, got: false")
+	}
+	if check.Active {
+		t.Fatalf("test: active: expected: false, got: true")
+	}
+	if check.LastCheck.IsZero() {
+		t.Fatalf("test: lastCheck: expected: <non-zero>, got: %v", check.LastCheck)
+	}
+}
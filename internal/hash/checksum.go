@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"hash"
 	"hash/crc32"
+	"hash/crc64"
 	"net/http"
 	"strings"
 
@@ -60,11 +61,19 @@ const (
 	ChecksumMultipart
 	// ChecksumIncludesMultipart indicates the checksum also contains part checksums.
 	ChecksumIncludesMultipart
+	// ChecksumCRC64NVME indicates a CRC64 checksum with the NVMe polynomial.
+	ChecksumCRC64NVME
 
 	// ChecksumNone indicates no checksum.
 	ChecksumNone ChecksumType = 0
 )
 
+// crc64NVMEPolynomial is the CRC-64/NVME polynomial, in reversed form as
+// expected by hash/crc64. AWS SDKs default to this algorithm for uploads.
+const crc64NVMEPolynomial = 0x9a6c9329ac4bc9b5
+
+var crc64NVMETable = crc64.MakeTable(crc64NVMEPolynomial)
+
 // Checksum is a type and base 64 encoded value.
 type Checksum struct {
 	Type    ChecksumType
@@ -88,6 +97,8 @@ func (c ChecksumType) Key() string {
 		return xhttp.AmzChecksumCRC32
 	case c.Is(ChecksumCRC32C):
 		return xhttp.AmzChecksumCRC32C
+	case c.Is(ChecksumCRC64NVME):
+		return xhttp.AmzChecksumCRC64NVME
 	case c.Is(ChecksumSHA1):
 		return xhttp.AmzChecksumSHA1
 	case c.Is(ChecksumSHA256):
@@ -103,6 +114,8 @@ func (c ChecksumType) RawByteLen() int {
 		return 4
 	case c.Is(ChecksumCRC32C):
 		return 4
+	case c.Is(ChecksumCRC64NVME):
+		return crc64.Size
 	case c.Is(ChecksumSHA1):
 		return sha1.Size
 	case c.Is(ChecksumSHA256):
@@ -123,6 +136,8 @@ func NewChecksumType(alg string) ChecksumType {
 		return ChecksumCRC32
 	case "CRC32C":
 		return ChecksumCRC32C
+	case "CRC64NVME":
+		return ChecksumCRC64NVME
 	case "SHA1":
 		return ChecksumSHA1
 	case "SHA256":
@@ -140,6 +155,8 @@ func (c ChecksumType) String() string {
 		return "CRC32"
 	case c.Is(ChecksumCRC32C):
 		return "CRC32C"
+	case c.Is(ChecksumCRC64NVME):
+		return "CRC64NVME"
 	case c.Is(ChecksumSHA1):
 		return "SHA1"
 	case c.Is(ChecksumSHA256):
@@ -158,6 +175,8 @@ func (c ChecksumType) Hasher() hash.Hash {
 		return crc32.NewIEEE()
 	case c.Is(ChecksumCRC32C):
 		return crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case c.Is(ChecksumCRC64NVME):
+		return crc64.New(crc64NVMETable)
 	case c.Is(ChecksumSHA1):
 		return sha1.New()
 	case c.Is(ChecksumSHA256):
@@ -208,7 +227,11 @@ func ReadCheckSums(b []byte, part int) map[string]string {
 			if n < 0 {
 				break
 			}
-			cs = fmt.Sprintf("%s-%d", cs, t)
+			if !typ.Is(ChecksumCRC64NVME) {
+				// The CRC-64/NVME value covers the full object rather than
+				// the part checksums, so it carries no part count suffix.
+				cs = fmt.Sprintf("%s-%d", cs, t)
+			}
 			b = b[n:]
 			if part > 0 {
 				cs = ""
@@ -240,6 +263,69 @@ func ReadCheckSums(b []byte, part int) map[string]string {
 	return res
 }
 
+// gf2MatrixTimes multiplies the GF(2) matrix mat by the vector vec.
+func gf2MatrixTimes(mat *[64]uint64, vec uint64) uint64 {
+	var sum uint64
+	for i := 0; vec != 0; i++ {
+		if vec&1 != 0 {
+			sum ^= mat[i]
+		}
+		vec >>= 1
+	}
+	return sum
+}
+
+// gf2MatrixSquare squares the GF(2) matrix mat into square.
+func gf2MatrixSquare(square, mat *[64]uint64) {
+	for i := range mat {
+		square[i] = gf2MatrixTimes(mat, mat[i])
+	}
+}
+
+// ChecksumCRC64NVMECombine combines two CRC-64/NVME checksums, where crc2
+// summarizes the len2 bytes immediately following the content summarized by
+// crc1. CRCs are linear, so the checksum of a full object can be built from
+// its part checksums without rereading the content.
+func ChecksumCRC64NVMECombine(crc1, crc2 uint64, len2 int64) uint64 {
+	if len2 <= 0 {
+		return crc1
+	}
+
+	var even, odd [64]uint64
+
+	// Put the operator for one zero bit in odd.
+	odd[0] = crc64NVMEPolynomial
+	var row uint64 = 1
+	for i := 1; i < 64; i++ {
+		odd[i] = row
+		row <<= 1
+	}
+	// Put the operator for two zero bits in even, four zero bits back in odd.
+	gf2MatrixSquare(&even, &odd)
+	gf2MatrixSquare(&odd, &even)
+
+	// Apply len2 zero bytes to crc1, squaring the operator for each bit.
+	for {
+		gf2MatrixSquare(&even, &odd)
+		if len2&1 != 0 {
+			crc1 = gf2MatrixTimes(&even, crc1)
+		}
+		len2 >>= 1
+		if len2 == 0 {
+			break
+		}
+		gf2MatrixSquare(&odd, &even)
+		if len2&1 != 0 {
+			crc1 = gf2MatrixTimes(&odd, crc1)
+		}
+		len2 >>= 1
+		if len2 == 0 {
+			break
+		}
+	}
+	return crc1 ^ crc2
+}
+
 // NewChecksumWithType is similar to NewChecksumString but expects input algo of ChecksumType.
 func NewChecksumWithType(alg ChecksumType, value string) *Checksum {
 	if !alg.IsSet() {
@@ -387,6 +473,9 @@ func GetContentChecksum(h http.Header) (*Checksum, error) {
 			case strings.EqualFold(header, ChecksumCRC32.Key()):
 				duplicates = res != nil
 				res = NewChecksumWithType(ChecksumCRC32|ChecksumTrailing, "")
+			case strings.EqualFold(header, ChecksumCRC64NVME.Key()):
+				duplicates = res != nil
+				res = NewChecksumWithType(ChecksumCRC64NVME|ChecksumTrailing, "")
 			case strings.EqualFold(header, ChecksumSHA256.Key()):
 				duplicates = res != nil
 				res = NewChecksumWithType(ChecksumSHA256|ChecksumTrailing, "")
@@ -446,6 +535,7 @@ func getContentChecksum(h http.Header) (t ChecksumType, s string) {
 	}
 	checkType(ChecksumCRC32)
 	checkType(ChecksumCRC32C)
+	checkType(ChecksumCRC64NVME)
 	checkType(ChecksumSHA1)
 	checkType(ChecksumSHA256)
 	return t, s
@@ -0,0 +1,102 @@
+// Copyright (c) 2015-2023 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package hash
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"testing"
+)
+
+// TestChecksumCRC64NVME verifies the CRC-64/NVME known-answer values.
+func TestChecksumCRC64NVME(t *testing.T) {
+	// The standard CRC check input, check value from the CRC-64/NVME
+	// specification (NVM Express 1.4, also the AWS SDK test vector).
+	const check = uint64(0xae8b14860a799888)
+	h := ChecksumCRC64NVME.Hasher()
+	h.Write([]byte("123456789"))
+	if got := binary.BigEndian.Uint64(h.Sum(nil)); got != check {
+		t.Errorf("CRC-64/NVME check value mismatch: got %#x, want %#x", got, check)
+	}
+
+	// The value AWS documents for the string "Hello world".
+	cs := NewChecksumFromData(ChecksumCRC64NVME, []byte("Hello world"))
+	if cs == nil {
+		t.Fatal("expected a checksum, got nil")
+	}
+	if want := "OOJZ0D8xKts="; cs.Encoded != want {
+		t.Errorf("CRC-64/NVME encoded value mismatch: got %s, want %s", cs.Encoded, want)
+	}
+	if err := cs.Matches([]byte("Hello world")); err != nil {
+		t.Errorf("expected checksum to match its own content: %v", err)
+	}
+}
+
+// TestChecksumCRC64NVMECombine verifies combining split checksums yields the
+// checksum of the concatenated content.
+func TestChecksumCRC64NVMECombine(t *testing.T) {
+	content := make([]byte, 1<<20+13)
+	for i := range content {
+		content[i] = byte(i * 7)
+	}
+	full := ChecksumCRC64NVME.Hasher()
+	full.Write(content)
+	want := binary.BigEndian.Uint64(full.Sum(nil))
+
+	for _, split := range []int{0, 1, 13, 1 << 10, 1 << 20, len(content)} {
+		first := ChecksumCRC64NVME.Hasher()
+		first.Write(content[:split])
+		second := ChecksumCRC64NVME.Hasher()
+		second.Write(content[split:])
+		got := ChecksumCRC64NVMECombine(
+			binary.BigEndian.Uint64(first.Sum(nil)),
+			binary.BigEndian.Uint64(second.Sum(nil)),
+			int64(len(content)-split))
+		if got != want {
+			t.Errorf("split %d: combined checksum mismatch: got %#x, want %#x", split, got, want)
+		}
+	}
+}
+
+// TestGetContentChecksumCRC64NVME ensures header and trailer negotiation
+// accepts the CRC-64/NVME algorithm.
+func TestGetContentChecksumCRC64NVME(t *testing.T) {
+	raw := binary.BigEndian.AppendUint64(nil, 0xae8b14860a799888)
+	encoded := base64.StdEncoding.EncodeToString(raw)
+
+	h := http.Header{}
+	h.Set("X-Amz-Checksum-Crc64nvme", encoded)
+	cs, err := GetContentChecksum(h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cs == nil || !cs.Type.Is(ChecksumCRC64NVME) || cs.Encoded != encoded {
+		t.Errorf("unexpected checksum from header: %+v", cs)
+	}
+
+	h = http.Header{}
+	h.Set("X-Amz-Trailer", "x-amz-checksum-crc64nvme")
+	cs, err = GetContentChecksum(h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cs == nil || !cs.Type.Is(ChecksumCRC64NVME|ChecksumTrailing) {
+		t.Errorf("unexpected checksum from trailer: %+v", cs)
+	}
+}
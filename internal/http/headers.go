@@ -99,6 +99,10 @@ const (
 	// MinIOSnowballPrefix will apply this prefix (plus / at end) to all extracted objects
 	MinIOSnowballPrefix = "X-Amz-Meta-Minio-Snowball-Prefix"
 
+	// Skip the connectivity check of notification targets referenced by a
+	// PUT bucket notification configuration.
+	AmzSkipDestinationValidation = "x-amz-skip-destination-validation"
+
 	// Object lock enabled
 	AmzObjectLockEnabled = "x-amz-bucket-object-lock-enabled"
 
@@ -155,12 +159,13 @@ const (
 	MinIOServerStatus = "x-minio-server-status"
 
 	// Content Checksums
-	AmzChecksumAlgo   = "x-amz-checksum-algorithm"
-	AmzChecksumCRC32  = "x-amz-checksum-crc32"
-	AmzChecksumCRC32C = "x-amz-checksum-crc32c"
-	AmzChecksumSHA1   = "x-amz-checksum-sha1"
-	AmzChecksumSHA256 = "x-amz-checksum-sha256"
-	AmzChecksumMode   = "x-amz-checksum-mode"
+	AmzChecksumAlgo      = "x-amz-checksum-algorithm"
+	AmzChecksumCRC32     = "x-amz-checksum-crc32"
+	AmzChecksumCRC32C    = "x-amz-checksum-crc32c"
+	AmzChecksumCRC64NVME = "x-amz-checksum-crc64nvme"
+	AmzChecksumSHA1      = "x-amz-checksum-sha1"
+	AmzChecksumSHA256    = "x-amz-checksum-sha256"
+	AmzChecksumMode      = "x-amz-checksum-mode"
 
 	// Delete special flag to force delete a bucket or a prefix
 	MinIOForceDelete = "x-minio-force-delete"
@@ -168,6 +173,81 @@ const (
 	// Create special flag to force create a bucket
 	MinIOForceCreate = "x-minio-force-create"
 
+	// Create special flag to enable versioning as part of bucket creation
+	MinIOVersioning = "x-minio-versioning"
+
+	// Delete special flag to also remove the bucket's remote targets,
+	// notification, quota and lifecycle configuration along with the bucket
+	MinIOCascadeConfig = "x-minio-cascade-config"
+
+	// Header requesting multi-delete results to be streamed incrementally
+	// as deletions complete instead of the buffered S3 response
+	MinIOStreamingDelete = "x-minio-streaming-delete"
+
+	// Header requesting each object's etag, size and encryption mode at
+	// the moment of deletion to be recorded on the multi-delete response
+	// and the emitted removal events
+	MinIODeleteAudit = "x-minio-delete-audit"
+
+	// Key carrying the SSE domain of an audited deleted object in the
+	// removal event's user metadata
+	MinIODeleteAuditSSE = "x-minio-delete-audit-sse"
+
+	// Header requesting the number of versions left behind by each
+	// version specific delete to be reported on the multi-delete
+	// response
+	MinIODeleteRemainingVersions = "x-minio-delete-remaining-versions"
+
+	// Header requesting a multi-delete simulation, the projected per
+	// object outcomes are returned but nothing is deleted. Echoed on
+	// the response to mark it as such
+	MinIODryRun = "x-minio-dry-run"
+
+	// Response header carrying the number of delete markers matched by a
+	// DeleteMarkersOnly multi-delete request
+	MinIODeleteMarkersMatched = "x-minio-delete-markers-matched"
+
+	// Response header set to "true" when a DeleteMarkersOnly multi-delete
+	// stopped before covering the whole prefix
+	MinIODeleteMarkersTruncated = "x-minio-delete-markers-truncated"
+
+	// Response headers carrying the continuation markers for the next
+	// DeleteMarkersOnly multi-delete request over the same prefix
+	MinIODeleteMarkersNextKeyMarker       = "x-minio-delete-markers-next-key-marker"
+	MinIODeleteMarkersNextVersionIDMarker = "x-minio-delete-markers-next-version-id-marker"
+
+	// Header requesting the new default object lock retention to also be
+	// applied, in the background, to existing objects that carry none
+	MinIOObjectLockApplyExisting = "x-minio-object-lock-apply-existing"
+
+	// Response header carrying the job ID under which the background
+	// retention application reports its progress
+	MinIOObjectLockApplyJobID = "x-minio-object-lock-apply-job-id"
+
+	// Header requesting the deployment's predefined lifecycle template
+	// to be applied to the bucket being created
+	MinIOLifecycleTemplate = "x-minio-lifecycle-template"
+
+	// Header requesting structured machine-readable error details
+	MinIOVerboseErrors = "x-minio-verbose-errors"
+
+	// Header requesting per-bucket usage extension elements in the
+	// ListBuckets response
+	MinIOUsage = "x-minio-usage"
+
+	// Header carrying a millisecond granularity backoff hint on
+	// throttling-class error responses
+	MinIOBackoffHint = "x-minio-backoff-hint-ms"
+
+	// Header carrying a compact JSON description of the error response
+	MinIOErrorDetail = "x-minio-error-detail"
+
+	// Default object lock retention of the bucket, returned by HeadBucket
+	// when configured and the caller may read the lock configuration
+	MinIOObjectLockDefaultMode  = "x-minio-object-lock-default-retention-mode"
+	MinIOObjectLockDefaultDays  = "x-minio-object-lock-default-retention-days"
+	MinIOObjectLockDefaultYears = "x-minio-object-lock-default-retention-years"
+
 	// Header indicates if the mtime should be preserved by client
 	MinIOSourceMTime = "x-minio-source-mtime"
 
@@ -184,6 +264,10 @@ const (
 	// Reports number of drives currently healing
 	MinIOHealingDrives = "x-minio-healing-drives"
 
+	// Reports progress of the initial bucket metadata load as
+	// "<loaded>/<total>" while the subsystem is still warming up
+	MinIOBucketsWarming = "x-minio-buckets-warming"
+
 	// Header indicates if the delete marker should be preserved by client
 	MinIOSourceDeleteMarker = "x-minio-source-deletemarker"
 
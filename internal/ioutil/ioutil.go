@@ -118,6 +118,102 @@ func (w *DeadlineWriter) Close() error {
 	return w.WriteCloser.Close()
 }
 
+// DeadlineReader deadline reader with context
+type DeadlineReader struct {
+	io.ReadCloser
+	timeout time.Duration
+	err     error
+}
+
+// NewDeadlineReader wraps a reader to make it respect given deadline
+// value per Read(). If there is a blocking read, the returned Reader
+// will return whenever the timer hits (the return values are n=0
+// and err=context.DeadlineExceeded.)
+func NewDeadlineReader(r io.ReadCloser, timeout time.Duration) io.ReadCloser {
+	return &DeadlineReader{ReadCloser: r, timeout: timeout}
+}
+
+func (r *DeadlineReader) Read(buf []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	c := make(chan ioret, 1)
+	t := time.NewTimer(r.timeout)
+	defer t.Stop()
+
+	// Read into a private buffer so a read completing after the
+	// timeout cannot scribble on buf once we have returned.
+	tmp := make([]byte, len(buf))
+	go func() {
+		n, err := r.ReadCloser.Read(tmp)
+		c <- ioret{n, err}
+		close(c)
+	}()
+
+	select {
+	case ret := <-c:
+		copy(buf, tmp[:ret.n])
+		r.err = ret.err
+		return ret.n, ret.err
+	case <-t.C:
+		r.err = context.DeadlineExceeded
+		return 0, context.DeadlineExceeded
+	}
+}
+
+// Close closer interface to close the underlying closer
+func (r *DeadlineReader) Close() error {
+	return r.ReadCloser.Close()
+}
+
+// CancelReader aborts reads as soon as the given context is cancelled,
+// closing the wrapped ReadCloser so a read blocked on the network
+// returns promptly with the context error.
+type CancelReader struct {
+	rc   io.ReadCloser
+	ctx  context.Context
+	once sync.Once
+	done chan struct{}
+}
+
+// NewCancelReader wraps a reader so reads abort with ctx.Err() when
+// the context is cancelled. The underlying reader is closed on
+// cancellation, Close must still be called to release the watcher.
+func NewCancelReader(ctx context.Context, rc io.ReadCloser) *CancelReader {
+	r := &CancelReader{rc: rc, ctx: ctx, done: make(chan struct{})}
+	go func() {
+		select {
+		case <-ctx.Done():
+			rc.Close()
+		case <-r.done:
+		}
+	}()
+	return r
+}
+
+func (r *CancelReader) Read(buf []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := r.rc.Read(buf)
+	if cerr := r.ctx.Err(); cerr != nil {
+		// The read failed because cancellation closed the
+		// underlying reader, report the cause.
+		return n, cerr
+	}
+	return n, err
+}
+
+// Close stops the cancellation watcher and closes the underlying
+// reader.
+func (r *CancelReader) Close() error {
+	r.once.Do(func() {
+		close(r.done)
+	})
+	return r.rc.Close()
+}
+
 // LimitWriter implements io.WriteCloser.
 //
 // This is implemented such that we want to restrict
@@ -61,6 +61,89 @@ func TestDeadlineWriter(t *testing.T) {
 	}
 }
 
+type sleepReader struct {
+	timeout time.Duration
+}
+
+func (r *sleepReader) Read(p []byte) (n int, err error) {
+	time.Sleep(r.timeout)
+	copy(p, "abcd")
+	return 4, nil
+}
+
+func (r *sleepReader) Close() error {
+	return nil
+}
+
+func TestDeadlineReader(t *testing.T) {
+	r := NewDeadlineReader(&sleepReader{timeout: 500 * time.Millisecond}, 450*time.Millisecond)
+	_, err := r.Read(make([]byte, 4))
+	r.Close()
+	if err != context.DeadlineExceeded {
+		t.Error("DeadlineReader shouldn't be successful - should return context.DeadlineExceeded")
+	}
+	_, err = r.Read(make([]byte, 4))
+	if err != context.DeadlineExceeded {
+		t.Error("DeadlineReader shouldn't be successful - should return context.DeadlineExceeded")
+	}
+	r = NewDeadlineReader(&sleepReader{timeout: 100 * time.Millisecond}, 600*time.Millisecond)
+	b := make([]byte, 4)
+	n, err := r.Read(b)
+	r.Close()
+	if err != nil {
+		t.Errorf("DeadlineReader should succeed but failed with %s", err)
+	}
+	if n != 4 || string(b) != "abcd" {
+		t.Errorf("DeadlineReader should have read 4 bytes, but returned %d instead", n)
+	}
+}
+
+func TestCancelReader(t *testing.T) {
+	// A read blocked on the underlying reader must abort promptly
+	// with the context error once the context is cancelled.
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	r := NewCancelReader(ctx, pr)
+	defer r.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := r.Read(make([]byte, 4))
+		errCh <- err
+	}()
+	time.Sleep(10 * time.Millisecond) // let the read block.
+	cancel()
+
+	select {
+	case err := <-errCh:
+		if err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read did not abort after cancellation")
+	}
+
+	// Further reads keep returning the context error.
+	if _, err := r.Read(make([]byte, 4)); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	// An uncancelled context passes reads through unchanged.
+	pr, pw = io.Pipe()
+	r = NewCancelReader(context.Background(), pr)
+	go func() {
+		pw.Write([]byte("abcd"))
+		pw.Close()
+	}()
+	b := make([]byte, 4)
+	n, err := r.Read(b)
+	r.Close()
+	if err != nil || n != 4 || string(b) != "abcd" {
+		t.Errorf("expected clean 4 byte read, got n=%d err=%v", n, err)
+	}
+}
+
 func TestCloseOnWriter(t *testing.T) {
 	writer := WriteOnClose(io.Discard)
 	if writer.HasWritten() {
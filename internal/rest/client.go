@@ -20,11 +20,13 @@ package rest
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
 	"path"
 	"strings"
@@ -45,8 +47,15 @@ const (
 	offline = iota
 	online
 	closed
+	// maintenance is entered via PauseHealthCheck, health probes are
+	// suspended until ResumeHealthCheck is called.
+	maintenance
 )
 
+// errPausedForMaintenance is reported as the last error while health
+// checking is paused.
+var errPausedForMaintenance = errors.New("health checking paused for maintenance")
+
 // NetworkError - error type in case of errors related to http/transport
 // for ex. connection refused, connection reset, dns resolution failure etc.
 // All errors returned by storage-rest-server (ex errFileNotFound, errDiskNotFound) are not considered to be network errors.
@@ -63,6 +72,102 @@ func (n *NetworkError) Unwrap() error {
 	return n.Err
 }
 
+// TLS handshake failure classifications, surfaced so operators see the
+// actual certificate problem instead of a generic network error.
+const (
+	TLSErrCertificateExpired = "certificate-expired"
+	TLSErrUnknownAuthority   = "unknown-authority"
+	TLSErrHostnameMismatch   = "hostname-mismatch"
+)
+
+// TLSHandshakeError - a TLS handshake against a peer failed, carries the
+// classification and the subject of the offending certificate when the
+// verification got far enough to see one.
+type TLSHandshakeError struct {
+	Endpoint string
+	Kind     string
+	Subject  string
+	Err      error
+}
+
+func (t *TLSHandshakeError) Error() string {
+	if t.Subject != "" {
+		return fmt.Sprintf("TLS handshake with %s failed (%s): certificate %q: %v", t.Endpoint, t.Kind, t.Subject, t.Err)
+	}
+	return fmt.Sprintf("TLS handshake with %s failed (%s): %v", t.Endpoint, t.Kind, t.Err)
+}
+
+// Unwrap returns the underlying x509 verification error.
+func (t *TLSHandshakeError) Unwrap() error {
+	return t.Err
+}
+
+// classifyTLSError inspects err for the certificate verification
+// failures worth telling apart, returns ok as false for everything else.
+func classifyTLSError(err error) (kind, subject string, ok bool) {
+	var invalidErr x509.CertificateInvalidError
+	if errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired {
+		if invalidErr.Cert != nil {
+			subject = invalidErr.Cert.Subject.String()
+		}
+		return TLSErrCertificateExpired, subject, true
+	}
+	var authorityErr x509.UnknownAuthorityError
+	if errors.As(err, &authorityErr) {
+		if authorityErr.Cert != nil {
+			subject = authorityErr.Cert.Subject.String()
+		}
+		return TLSErrUnknownAuthority, subject, true
+	}
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &hostnameErr) {
+		if hostnameErr.Certificate != nil {
+			subject = hostnameErr.Certificate.Subject.String()
+		}
+		return TLSErrHostnameMismatch, subject, true
+	}
+	return "", "", false
+}
+
+// wrapTLSError classifies err and wraps it into a TLSHandshakeError,
+// unclassified errors are returned untouched.
+func (c *Client) wrapTLSError(ctx context.Context, err error) error {
+	kind, subject, ok := classifyTLSError(err)
+	if !ok {
+		return err
+	}
+	terr := &TLSHandshakeError{
+		Endpoint: c.url.Host,
+		Kind:     kind,
+		Subject:  subject,
+		Err:      err,
+	}
+	c.Lock()
+	c.lastTLSKind = terr.Kind
+	c.Unlock()
+	logger.LogOnceIf(ctx, terr, c.url.Host+"-tls")
+	return terr
+}
+
+// LastTLSIssue returns the classification of the most recent TLS
+// handshake failure, empty once a call succeeded again.
+func (c *Client) LastTLSIssue() string {
+	c.RLock()
+	defer c.RUnlock()
+	return c.lastTLSKind
+}
+
+// PeerCertExpiry returns the NotAfter of the leaf certificate the peer
+// served on the most recent successful call, the zero time when the
+// peer is not using TLS or no call completed yet.
+func (c *Client) PeerCertExpiry() time.Time {
+	v := atomic.LoadInt64(&c.peerCertExpiry)
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(v, 0)
+}
+
 // Client - http based RPC client.
 type Client struct {
 	connected int32 // ref: https://golang.org/pkg/sync/atomic/#pkg-note-BUG
@@ -93,13 +198,31 @@ type Client struct {
 	// Avoid metrics update if set to true
 	NoMetrics bool
 
+	// RespHeaderFn when set is called with the response headers of
+	// every completed call, so callers can observe server advertised
+	// state such as the current handler queue depth.
+	RespHeaderFn func(http.Header)
+
 	httpClient   *http.Client
 	url          *url.URL
 	newAuthToken func(audience string) string
 
+	// peerCertExpiry is the NotAfter of the leaf certificate served by
+	// the peer on the last successful call, unix seconds, 0 if unknown.
+	peerCertExpiry int64
+
+	// Connection reuse accounting for calls made by this client,
+	// connsDialed counts calls that needed a fresh TCP connection,
+	// connsReused counts calls served over a kept-alive one. Not
+	// tracked when NoMetrics is set.
+	connsDialed uint64
+	connsReused uint64
+	connTrace   *httptrace.ClientTrace
+
 	sync.RWMutex // mutex for lastErr
 	lastErr      error
 	lastErrTime  time.Time
+	lastTLSKind  string
 }
 
 type restError string
@@ -228,14 +351,32 @@ func (r *respBodyMonitor) errorStatus(err error) {
 
 // Call - make a REST call with context.
 func (c *Client) Call(ctx context.Context, method string, values url.Values, body io.Reader, length int64) (reply io.ReadCloser, err error) {
+	reply, _, err = c.callWithHeaders(ctx, method, values, body, length, nil)
+	return reply, err
+}
+
+// CallWithHeaders - make a REST call with context, additionally returning
+// the response headers for callers interested in server advertised state.
+func (c *Client) CallWithHeaders(ctx context.Context, method string, values url.Values, body io.Reader, length int64) (reply io.ReadCloser, hdr http.Header, err error) {
+	return c.callWithHeaders(ctx, method, values, body, length, nil)
+}
+
+// CallWithRequestHeaders - like Call but sets the given headers on the
+// outgoing request, callers use it to declare how the body is encoded.
+func (c *Client) CallWithRequestHeaders(ctx context.Context, method string, values url.Values, body io.Reader, length int64, reqHdr http.Header) (reply io.ReadCloser, err error) {
+	reply, _, err = c.callWithHeaders(ctx, method, values, body, length, reqHdr)
+	return reply, err
+}
+
+func (c *Client) callWithHeaders(ctx context.Context, method string, values url.Values, body io.Reader, length int64, reqHdr http.Header) (reply io.ReadCloser, hdr http.Header, err error) {
 	urlStr := c.url.String()
 	if !c.IsOnline() {
-		return nil, &NetworkError{c.LastError()}
+		return nil, nil, &NetworkError{c.LastError()}
 	}
 
 	u, err := url.Parse(urlStr)
 	if err != nil {
-		return nil, &NetworkError{Err: &url.Error{Op: method, URL: urlStr, Err: err}}
+		return nil, nil, &NetworkError{Err: &url.Error{Op: method, URL: urlStr, Err: err}}
 	}
 
 	u.Path = path.Join(u.Path, method)
@@ -243,7 +384,10 @@ func (c *Client) Call(ctx context.Context, method string, values url.Values, bod
 
 	req, err := c.newRequest(ctx, u, body)
 	if err != nil {
-		return nil, &NetworkError{err}
+		return nil, nil, &NetworkError{err}
+	}
+	for k, v := range reqHdr {
+		req.Header[k] = v
 	}
 	if length > 0 {
 		req.ContentLength = length
@@ -252,8 +396,13 @@ func (c *Client) Call(ctx context.Context, method string, values url.Values, bod
 	req, update := setupReqStatsUpdate(req)
 	defer update()
 
+	if !c.NoMetrics && c.connTrace != nil {
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), c.connTrace))
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		err = c.wrapTLSError(ctx, err)
 		if xnet.IsNetworkOrHostDown(err, c.ExpectTimeouts) {
 			if !c.NoMetrics {
 				atomic.AddUint64(&globalStats.errs, 1)
@@ -262,7 +411,20 @@ func (c *Client) Call(ctx context.Context, method string, values url.Values, bod
 				logger.LogOnceIf(ctx, fmt.Errorf("Marking %s offline temporarily; caused by %w", c.url.Host, err), c.url.Host)
 			}
 		}
-		return nil, &NetworkError{err}
+		return nil, nil, &NetworkError{err}
+	}
+
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		atomic.StoreInt64(&c.peerCertExpiry, resp.TLS.PeerCertificates[0].NotAfter.Unix())
+	}
+	if c.LastTLSIssue() != "" {
+		c.Lock()
+		c.lastTLSKind = ""
+		c.Unlock()
+	}
+
+	if c.RespHeaderFn != nil {
+		c.RespHeaderFn(resp.Header)
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -292,17 +454,17 @@ func (c *Client) Call(ctx context.Context, method string, values url.Values, bod
 					logger.LogOnceIf(ctx, fmt.Errorf("Marking %s offline temporarily; caused by %w", c.url.Host, err), c.url.Host)
 				}
 			}
-			return nil, err
+			return nil, nil, err
 		}
 		if len(b) > 0 {
-			return nil, errors.New(string(b))
+			return nil, nil, errors.New(string(b))
 		}
-		return nil, errors.New(resp.Status)
+		return nil, nil, errors.New(resp.Status)
 	}
 	if !c.NoMetrics && !c.ExpectTimeouts {
 		resp.Body = &respBodyMonitor{ReadCloser: resp.Body, expectTimeouts: c.ExpectTimeouts}
 	}
-	return resp.Body, nil
+	return resp.Body, resp.Header, nil
 }
 
 // Close closes all idle connections of the underlying http client
@@ -314,7 +476,7 @@ func (c *Client) Close() {
 func NewClient(url *url.URL, tr http.RoundTripper, newAuthToken func(aud string) string) *Client {
 	// Transport is exactly same as Go default in https://golang.org/pkg/net/http/#RoundTripper
 	// except custom DialContext and TLSClientConfig.
-	return &Client{
+	c := &Client{
 		httpClient:               &http.Client{Transport: tr},
 		url:                      url,
 		newAuthToken:             newAuthToken,
@@ -324,6 +486,24 @@ func NewClient(url *url.URL, tr http.RoundTripper, newAuthToken func(aud string)
 		HealthCheckReconnectUnit: 200 * time.Millisecond,
 		HealthCheckTimeout:       time.Second,
 	}
+	c.connTrace = &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Reused {
+				atomic.AddUint64(&c.connsReused, 1)
+			} else {
+				atomic.AddUint64(&c.connsDialed, 1)
+			}
+		},
+	}
+	return c
+}
+
+// ConnStats returns how many of this client's calls needed a freshly
+// dialed connection and how many were served over a reused keep-alive
+// connection, since the client was created. Always zero when NoMetrics
+// is set, as it is on health probe clients.
+func (c *Client) ConnStats() (dialed, reused uint64) {
+	return atomic.LoadUint64(&c.connsDialed), atomic.LoadUint64(&c.connsReused)
 }
 
 // IsOnline returns whether the client is likely to be online.
@@ -331,6 +511,40 @@ func (c *Client) IsOnline() bool {
 	return atomic.LoadInt32(&c.connected) == online
 }
 
+// IsInMaintenance returns true while health checking is paused via
+// PauseHealthCheck, distinguishing planned maintenance from an outage.
+func (c *Client) IsInMaintenance() bool {
+	return atomic.LoadInt32(&c.connected) == maintenance
+}
+
+// PauseHealthCheck suspends health checking and moves the client into
+// a maintenance state, stopping reconnect probes against the peer.
+// IsOnline reports false until ResumeHealthCheck is called.
+func (c *Client) PauseHealthCheck() {
+	for {
+		s := atomic.LoadInt32(&c.connected)
+		if s == closed || s == maintenance {
+			return
+		}
+		if atomic.CompareAndSwapInt32(&c.connected, s, maintenance) {
+			c.Lock()
+			c.lastErr = errPausedForMaintenance
+			c.lastErrTime = time.Now()
+			c.Unlock()
+			return
+		}
+	}
+}
+
+// ResumeHealthCheck leaves the maintenance state. The client is not
+// trusted to be healthy right away, the regular reconnect probe brings
+// it back online once the peer responds.
+func (c *Client) ResumeHealthCheck() {
+	if atomic.CompareAndSwapInt32(&c.connected, maintenance, online) {
+		c.MarkOffline(fmt.Errorf("reconnecting %s after maintenance", c.url.Host))
+	}
+}
+
 // LastConn returns when the disk was (re-)connected
 func (c *Client) LastConn() time.Time {
 	return time.Unix(0, atomic.LoadInt64(&c.lastConn))
@@ -385,7 +599,12 @@ func (c *Client) MarkOffline(err error) bool {
 
 			attempt := uint(0)
 			for {
-				if atomic.LoadInt32(&c.connected) == closed {
+				switch atomic.LoadInt32(&c.connected) {
+				case closed:
+					return
+				case maintenance:
+					// Paused for planned maintenance, a fresh
+					// probe is spawned by ResumeHealthCheck.
 					return
 				}
 				if c.HealthCheckFn() {
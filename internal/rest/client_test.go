@@ -20,8 +20,10 @@ package rest
 import (
 	"errors"
 	"net"
+	"net/http"
 	"net/url"
 	"testing"
+	"time"
 )
 
 func TestNetworkError_Unwrap(t *testing.T) {
@@ -68,3 +70,55 @@ func TestNetworkError_Unwrap(t *testing.T) {
 		})
 	}
 }
+
+func TestClientPauseResumeHealthCheck(t *testing.T) {
+	u, err := url.Parse("http://localhost:9876")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(u, http.DefaultTransport, nil)
+	c.HealthCheckFn = func() bool { return true }
+	c.HealthCheckReconnectUnit = time.Millisecond
+
+	if !c.IsOnline() || c.IsInMaintenance() {
+		t.Fatal("expected a fresh client to be online and not in maintenance")
+	}
+
+	c.PauseHealthCheck()
+	if c.IsOnline() {
+		t.Fatal("expected IsOnline() to be false while in maintenance")
+	}
+	if !c.IsInMaintenance() {
+		t.Fatal("expected IsInMaintenance() to be true after PauseHealthCheck")
+	}
+
+	// Marking offline during maintenance must not spawn a reconnect
+	// probe nor change the reported state.
+	if c.MarkOffline(errors.New("unreachable during maintenance")) {
+		t.Fatal("MarkOffline must not transition a paused client")
+	}
+	if !c.IsInMaintenance() {
+		t.Fatal("expected the client to stay in maintenance")
+	}
+
+	// Pausing twice is a no-op.
+	c.PauseHealthCheck()
+	if !c.IsInMaintenance() {
+		t.Fatal("expected the client to stay in maintenance after a second pause")
+	}
+
+	c.ResumeHealthCheck()
+	if c.IsInMaintenance() {
+		t.Fatal("expected IsInMaintenance() to be false after ResumeHealthCheck")
+	}
+
+	// The reconnect probe runs with a healthy HealthCheckFn, the client
+	// must come back online shortly.
+	deadline := time.Now().Add(5 * time.Second)
+	for !c.IsOnline() {
+		if time.Now().After(deadline) {
+			t.Fatal("client did not come back online after ResumeHealthCheck")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}